@@ -0,0 +1,86 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"github.com/wuc656/win"
+)
+
+// AnchorLayout places each child by attaching its edges to edges of the
+// container or of sibling widgets, as described by Anchors.
+type AnchorLayout struct {
+	LayoutBase
+	hwnd2Anchors map[win.HWND]Anchors
+}
+
+func NewAnchorLayout() *AnchorLayout {
+	l := &AnchorLayout{
+		LayoutBase: LayoutBase{
+			margins96dpi: Margins{9, 9, 9, 9},
+		},
+		hwnd2Anchors: make(map[win.HWND]Anchors),
+	}
+	l.layout = l
+
+	return l
+}
+
+func (l *AnchorLayout) Anchors(widget Widget) Anchors {
+	return l.hwnd2Anchors[widget.Handle()]
+}
+
+func (l *AnchorLayout) SetAnchors(widget Widget, anchors Anchors) error {
+	if l.container == nil {
+		return newError("container required")
+	}
+
+	handle := widget.Handle()
+
+	if !l.container.Children().containsHandle(handle) {
+		return newError("unknown widget")
+	}
+
+	for _, a := range []Anchor{anchors.Left, anchors.Top, anchors.Right, anchors.Bottom, anchors.Baseline} {
+		if a.Target == nil {
+			continue
+		}
+
+		if target := a.Target.Handle(); target == handle || !l.container.Children().containsHandle(target) {
+			return newError("anchor target must be a sibling")
+		}
+	}
+
+	l.hwnd2Anchors[handle] = anchors
+
+	l.container.RequestLayout()
+
+	return nil
+}
+
+// onRemovedWidget forgets the anchors of widget, when it is removed from the
+// container or disposed.
+func (l *AnchorLayout) onRemovedWidget(widget Widget) {
+	delete(l.hwnd2Anchors, widget.Handle())
+}
+
+func (l *AnchorLayout) onClearedWidgets() {
+	clear(l.hwnd2Anchors)
+}
+
+func (l *AnchorLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
+	li := &anchorLayoutItem{
+		size2MinSize: make(map[Size]Size),
+		hwnd2Anchors: make(map[win.HWND]anchorsInfo, len(l.hwnd2Anchors)),
+	}
+
+	for handle, anchors := range l.hwnd2Anchors {
+		li.hwnd2Anchors[handle] = anchors.info()
+	}
+
+	return li
+}
@@ -0,0 +1,424 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"sync"
+)
+
+// AnchorEdge identifies an edge of an item in an AnchorLayout, or of the
+// container itself.
+type AnchorEdge int
+
+const (
+	AnchorNone AnchorEdge = iota
+	AnchorLeft
+	AnchorTop
+	AnchorRight
+	AnchorBottom
+	AnchorBaseline
+)
+
+// AnchorTarget is implemented by Widget and LayoutItem.
+type AnchorTarget interface {
+	Handle() layoutHandle
+}
+
+// Anchor attaches an edge of an item to Edge of Target.
+type Anchor struct {
+	// Target is a sibling of the anchored item. If nil, the anchor refers to
+	// the client area of the container, inside its margins.
+	Target AnchorTarget
+
+	// Edge is the edge of Target to attach to. AnchorNone leaves the
+	// anchored edge unattached.
+	Edge AnchorEdge
+
+	// Offset is added to the position of Edge, in 1/96" units.
+	Offset int
+}
+
+// Anchors describes how an item is placed by an AnchorLayout.
+//
+// If both Left and Right are attached, the item is stretched between them, but
+// never made narrower than its minimum width. If only one of them is attached,
+// the item gets its ideal width. If neither is attached, the item is placed at
+// the left margin. The vertical edges work the same way, with Baseline used in
+// place of Top if Top is not attached.
+//
+// Items that do not report a baseline are treated as having their baseline at
+// their vertical center.
+type Anchors struct {
+	Left     Anchor
+	Top      Anchor
+	Right    Anchor
+	Bottom   Anchor
+	Baseline Anchor
+}
+
+type anchorInfo struct {
+	target      layoutHandle // zero for the container
+	edge        AnchorEdge
+	offset96dpi int
+}
+
+type anchorsInfo struct {
+	left     anchorInfo
+	top      anchorInfo
+	right    anchorInfo
+	bottom   anchorInfo
+	baseline anchorInfo
+}
+
+func (a Anchor) info() anchorInfo {
+	var target layoutHandle
+	if a.Target != nil {
+		target = a.Target.Handle()
+	}
+
+	return anchorInfo{target: target, edge: a.Edge, offset96dpi: a.Offset}
+}
+
+func (a Anchors) info() anchorsInfo {
+	return anchorsInfo{
+		left:     a.Left.info(),
+		top:      a.Top.info(),
+		right:    a.Right.info(),
+		bottom:   a.Bottom.info(),
+		baseline: a.Baseline.info(),
+	}
+}
+
+type anchorLayoutItem struct {
+	ContainerLayoutItemBase
+	mutex        sync.Mutex
+	size2MinSize map[Size]Size // in native pixels
+	hwnd2Anchors map[layoutHandle]anchorsInfo
+}
+
+func (li *anchorLayoutItem) LayoutFlags() LayoutFlags {
+	return boxLayoutFlags(Horizontal, li.children)
+}
+
+func (li *anchorLayoutItem) IdealSize() Size {
+	return li.MinSize()
+}
+
+func (li *anchorLayoutItem) MinSize() Size {
+	return li.MinSizeForSize(li.geometry.ClientSize)
+}
+
+func (li *anchorLayoutItem) HeightForWidth(width int) int {
+	return li.MinSizeForSize(Size{width, li.geometry.ClientSize.Height}).Height
+}
+
+func (li *anchorLayoutItem) MinSizeForSize(size Size) Size {
+	li.mutex.Lock()
+	defer li.mutex.Unlock()
+
+	if min, ok := li.size2MinSize[size]; ok {
+		return min
+	}
+
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	s := Size{margins.HNear + margins.HFar, margins.VNear + margins.VFar}
+
+	// Items attached to the far edges move and stretch with the size of the
+	// container, so grow it until nothing sticks out anymore. Outward pointing
+	// anchors could make this go on forever, hence the limit.
+	maxIterations := len(li.children) + 2
+
+	for range maxIterations {
+		items := li.solve(Size{s.Width, size.Height})
+		probe := li.solve(Size{s.Width + 1, size.Height})
+
+		required := s.Width
+		for i, item := range items {
+			b := item.Bounds
+
+			required = maxi(required, b.X+b.Width+margins.HFar)
+			if b.X < margins.HNear && probe[i].Bounds.X > b.X {
+				required = maxi(required, s.Width+margins.HNear-b.X)
+			}
+		}
+
+		if required == s.Width {
+			break
+		}
+		s.Width = required
+	}
+
+	width := maxi(s.Width, size.Width)
+
+	for range maxIterations {
+		items := li.solve(Size{width, s.Height})
+		probe := li.solve(Size{width, s.Height + 1})
+
+		required := s.Height
+		for i, item := range items {
+			b := item.Bounds
+
+			required = maxi(required, b.Y+b.Height+margins.VFar)
+			if b.Y < margins.VNear && probe[i].Bounds.Y > b.Y {
+				required = maxi(required, s.Height+margins.VNear-b.Y)
+			}
+		}
+
+		if required == s.Height {
+			break
+		}
+		s.Height = required
+	}
+
+	if s.Width > 0 && s.Height > 0 {
+		li.size2MinSize[size] = s
+	}
+
+	return s
+}
+
+func (li *anchorLayoutItem) PerformLayout() []LayoutResultItem {
+	return li.solve(li.geometry.ClientSize)
+}
+
+// solve lays out the children of li for the client size size. All values are
+// in native pixels.
+func (li *anchorLayoutItem) solve(size Size) []LayoutResultItem {
+	items := itemsToLayout(li.children)
+	if len(items) == 0 {
+		return nil
+	}
+
+	s := &anchorLayoutSolver{
+		container:   li,
+		size:        size,
+		margins:     MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi),
+		handle2Item: make(map[layoutHandle]*anchorLayoutSolverItem, len(li.children)),
+	}
+
+	for _, child := range li.children {
+		s.handle2Item[child.Handle()] = &anchorLayoutSolverItem{
+			item:    child,
+			anchors: li.hwnd2Anchors[child.Handle()],
+		}
+	}
+
+	resultItems := make([]LayoutResultItem, 0, len(items))
+
+	for _, item := range items {
+		si := s.handle2Item[item.Handle()]
+
+		s.resolveVert(si)
+
+		resultItems = append(resultItems, LayoutResultItem{Item: item, Bounds: si.bounds})
+	}
+
+	return resultItems
+}
+
+type anchorLayoutSolver struct {
+	container   *anchorLayoutItem
+	size        Size    // in native pixels
+	margins     Margins // in native pixels
+	handle2Item map[layoutHandle]*anchorLayoutSolverItem
+}
+
+type anchorResolveState byte
+
+const (
+	anchorUnresolved anchorResolveState = iota
+	anchorResolving
+	anchorResolved
+)
+
+type anchorLayoutSolverItem struct {
+	item      LayoutItem
+	anchors   anchorsInfo
+	bounds    Rectangle // in native pixels
+	horzState anchorResolveState
+	vertState anchorResolveState
+}
+
+func (s *anchorLayoutSolver) sizes(si *anchorLayoutSolverItem) (min, ideal Size) {
+	min = s.container.MinSizeEffectiveForChild(si.item)
+
+	if is, ok := si.item.(IdealSizer); ok {
+		ideal = maxSize(is.IdealSize(), min)
+	} else {
+		ideal = min
+	}
+
+	if max := si.item.Geometry().MaxSize; max.Width > 0 || max.Height > 0 {
+		if max.Width > 0 && ideal.Width > max.Width {
+			ideal.Width = max.Width
+		}
+		if max.Height > 0 && ideal.Height > max.Height {
+			ideal.Height = max.Height
+		}
+	}
+
+	return
+}
+
+// edge returns the position of the edge a refers to, or false if a is not
+// attached or refers to an item that is unknown or part of a cycle.
+func (s *anchorLayoutSolver) edge(a anchorInfo) (int, bool) {
+	if a.edge == AnchorNone {
+		return 0, false
+	}
+
+	offset := IntFrom96DPI(a.offset96dpi, s.container.ctx.dpi)
+
+	if a.target == 0 {
+		switch a.edge {
+		case AnchorLeft:
+			return s.margins.HNear + offset, true
+
+		case AnchorRight:
+			return s.size.Width - s.margins.HFar + offset, true
+
+		case AnchorTop, AnchorBaseline:
+			return s.margins.VNear + offset, true
+
+		case AnchorBottom:
+			return s.size.Height - s.margins.VFar + offset, true
+		}
+
+		return 0, false
+	}
+
+	target, ok := s.handle2Item[a.target]
+	if !ok {
+		return 0, false
+	}
+
+	switch a.edge {
+	case AnchorLeft, AnchorRight:
+		if !s.resolveHorz(target) {
+			return 0, false
+		}
+
+		if a.edge == AnchorLeft {
+			return target.bounds.X + offset, true
+		}
+		return target.bounds.X + target.bounds.Width + offset, true
+
+	case AnchorTop, AnchorBottom, AnchorBaseline:
+		if !s.resolveVert(target) {
+			return 0, false
+		}
+
+		switch a.edge {
+		case AnchorTop:
+			return target.bounds.Y + offset, true
+
+		case AnchorBottom:
+			return target.bounds.Y + target.bounds.Height + offset, true
+		}
+		return target.bounds.Y + baselineOf(target.item, target.bounds.Height) + offset, true
+	}
+
+	return 0, false
+}
+
+func (s *anchorLayoutSolver) resolveHorz(si *anchorLayoutSolverItem) bool {
+	switch si.horzState {
+	case anchorResolved:
+		return true
+
+	case anchorResolving:
+		return false
+	}
+
+	si.horzState = anchorResolving
+
+	min, ideal := s.sizes(si)
+
+	left, hasLeft := s.edge(si.anchors.left)
+	right, hasRight := s.edge(si.anchors.right)
+
+	b := &si.bounds
+
+	switch {
+	case hasLeft && hasRight:
+		b.X = left
+		b.Width = maxi(right-left, min.Width)
+		if max := si.item.Geometry().MaxSize.Width; max > 0 && b.Width > max {
+			b.Width = max
+		}
+
+	case hasLeft:
+		b.X = left
+		b.Width = ideal.Width
+
+	case hasRight:
+		b.Width = ideal.Width
+		b.X = right - b.Width
+
+	default:
+		b.X = s.margins.HNear
+		b.Width = ideal.Width
+	}
+
+	si.horzState = anchorResolved
+
+	return true
+}
+
+func (s *anchorLayoutSolver) resolveVert(si *anchorLayoutSolverItem) bool {
+	switch si.vertState {
+	case anchorResolved:
+		return true
+
+	case anchorResolving:
+		return false
+	}
+
+	if !s.resolveHorz(si) {
+		return false
+	}
+
+	si.vertState = anchorResolving
+
+	min, ideal := s.sizes(si)
+
+	height := ideal.Height
+	if hfw, ok := si.item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+		height = maxi(hfw.HeightForWidth(si.bounds.Width), min.Height)
+	}
+
+	top, hasTop := s.edge(si.anchors.top)
+	bottom, hasBottom := s.edge(si.anchors.bottom)
+
+	b := &si.bounds
+
+	switch {
+	case hasTop && hasBottom:
+		b.Y = top
+		b.Height = maxi(bottom-top, min.Height)
+		if max := si.item.Geometry().MaxSize.Height; max > 0 && b.Height > max {
+			b.Height = max
+		}
+
+	case hasTop:
+		b.Y = top
+		b.Height = height
+
+	default:
+		b.Height = height
+
+		if baseline, ok := s.edge(si.anchors.baseline); ok {
+			b.Y = baseline - baselineOf(si.item, height)
+		} else if hasBottom {
+			b.Y = bottom - height
+		} else {
+			b.Y = s.margins.VNear
+		}
+	}
+
+	si.vertState = anchorResolved
+
+	return true
+}
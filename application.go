@@ -18,7 +18,6 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
-	"time"
 	"unsafe"
 
 	"github.com/wuc656/win"
@@ -41,18 +40,6 @@ const (
 	firstRegisteredMessage = 0xC000
 )
 
-type Settings interface {
-	Get(key string) (string, bool)
-	Timestamp(key string) (time.Time, bool)
-	Put(key, value string) error
-	PutExpiring(key, value string) error
-	Remove(key string) error
-	ExpireDuration() time.Duration
-	SetExpireDuration(expireDuration time.Duration)
-	Load() error
-	Save() error
-}
-
 type Persistable interface {
 	Persistent() bool
 	SetPersistent(value bool)
@@ -0,0 +1,212 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bindexpr compiles and evaluates the expressions used with
+// declarative.Bind.
+//
+// An expression is parsed and type checked once by Compile. Names are resolved
+// through a Scope, either as variables or as fields, methods and map entries of
+// a root value, usually the DataSource of a DataBinder. Types are checked using
+// reflection. Values of interface type are checked when the expression is
+// evaluated.
+//
+// The syntax is a small subset of Go:
+//
+//	Literals       12  3.5  "text"  'text'  true  false  nil
+//	Paths          Customer.Address.City  Items[0].Name  Prices["EUR"]
+//	Calls          Customer.FullName()  format("%s (%d)", Name, Age)  len(Items)
+//	Unary          !Done  -Amount
+//	Arithmetic     *  /  %  +  -
+//	Comparison     ==  !=  <  <=  >  >=
+//	Logical        &&  ||
+//	Null-coalesce  Nickname ?? Name
+//	Conditional    Count == 1 ? "item" : "items"
+//
+// Number literals and the results of arithmetic are float64. + concatenates if
+// either operand is a string. A path through a nil pointer, a missing map key
+// or an index out of range yields nil instead of failing, so ?? can supply a
+// default. Methods and func fields that take no arguments may be used without
+// parentheses.
+//
+// Unlike govaluate, which was used before, there are no bitwise, regular
+// expression or in operators, which Compile reports as errors, and quoted dates
+// are plain strings.
+package bindexpr
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Error is an error at a position in an expression.
+type Error struct {
+	Column int // 1-based, in runes
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("col %d: %s", e.Column, e.Msg)
+}
+
+// ErrorList is the list of errors Compile returns.
+type ErrorList []*Error
+
+func (l ErrorList) Error() string {
+	msgs := make([]string, len(l))
+	for i, e := range l {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Scope declares the names an expression may refer to.
+type Scope interface {
+	// Root returns the type of the value names are looked up in if they are
+	// not variables, or nil if there is none.
+	Root() reflect.Type
+
+	// Var returns the type of the variable name, which may also be a dotted
+	// path like "nameEdit.Text", or false if there is no such variable.
+	Var(name string) (reflect.Type, bool)
+
+	// Func returns the Go function called name, or false if there is none.
+	Func(name string) (any, bool)
+}
+
+// Env is a Scope backed by maps.
+type Env struct {
+	RootType reflect.Type
+	Vars     map[string]reflect.Type
+	Funcs    map[string]any
+}
+
+func (e *Env) Root() reflect.Type {
+	return e.RootType
+}
+
+func (e *Env) Var(name string) (reflect.Type, bool) {
+	t, ok := e.Vars[name]
+	return t, ok
+}
+
+func (e *Env) Func(name string) (any, bool) {
+	fn, ok := e.Funcs[name]
+	return fn, ok
+}
+
+// Values provides the values of variables when evaluating a Program.
+type Values interface {
+	Get(name string) (any, error)
+}
+
+// Vars is a Values backed by a map.
+type Vars map[string]any
+
+func (v Vars) Get(name string) (any, error) {
+	if val, ok := v[name]; ok {
+		return val, nil
+	}
+
+	return nil, fmt.Errorf("no value for variable %s", name)
+}
+
+// AnyType is the type of values that are only checked at run time. Use it for
+// variables or roots of unknown type.
+var AnyType = reflect.TypeFor[any]()
+
+// Program is a compiled expression.
+type Program struct {
+	src      string
+	eval     evalFunc
+	typ      reflect.Type
+	vars     []string
+	usesRoot bool
+	path     string
+	isPath   bool
+}
+
+// Compile parses src and checks it against scope. If there are errors, the
+// returned error is either a single *Error for a syntax error, or an ErrorList
+// with all errors found by type checking.
+func Compile(src string, scope Scope) (*Program, error) {
+	n, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &checker{scope: scope, rootIdents: make(map[*identNode]bool)}
+
+	op := c.check(n)
+	if len(c.errs) > 0 {
+		return nil, c.errs
+	}
+
+	p := &Program{
+		src:      src,
+		eval:     op.eval,
+		typ:      op.typ,
+		vars:     c.vars,
+		usesRoot: c.usesRoot,
+	}
+
+	if p.typ == nilType {
+		p.typ = AnyType
+	}
+
+	p.path, p.isPath = c.rootPath(n)
+
+	return p, nil
+}
+
+// MustCompile is like Compile but panics if there are errors.
+func MustCompile(src string, scope Scope) *Program {
+	p, err := Compile(src, scope)
+	if err != nil {
+		panic(fmt.Sprintf("bindexpr: %q: %s", src, err))
+	}
+
+	return p
+}
+
+func (p *Program) String() string {
+	return p.src
+}
+
+// Type returns the type of the values p evaluates to. It is AnyType if that is
+// only known at run time.
+func (p *Program) Type() reflect.Type {
+	return p.typ
+}
+
+// Vars returns the names of the variables p refers to, in order of their first
+// appearance.
+func (p *Program) Vars() []string {
+	return p.vars
+}
+
+// UsesRoot returns if p looks up names in the root value.
+func (p *Program) UsesRoot() bool {
+	return p.usesRoot
+}
+
+// Path returns the path, like `Orders[2].Customer.Name`, if p consists of
+// nothing but a path into the root value with constant indexes and keys. It is
+// in the syntax DataBinder uses for paths.
+func (p *Program) Path() (string, bool) {
+	return p.path, p.isPath
+}
+
+// Eval evaluates p, looking up names in root and vars.
+func (p *Program) Eval(root any, vars Values) (any, error) {
+	ctx := &evalContext{root: reflect.ValueOf(root), vars: vars}
+
+	v, err := p.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return interfaceOf(v), nil
+}
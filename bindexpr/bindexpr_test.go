@@ -0,0 +1,232 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bindexpr
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testAddress struct {
+	City string
+}
+
+type testCustomer struct {
+	First    string
+	Last     string
+	Nickname *string
+	Age      int
+	Address  *testAddress
+	Tags     []string
+	Prices   map[string]float64
+	Greeting func() string
+}
+
+func (c *testCustomer) FullName() string {
+	return c.First + " " + c.Last
+}
+
+func (c *testCustomer) Initials(sep string) (string, error) {
+	if sep == "" {
+		return "", errors.New("empty separator")
+	}
+
+	return c.First[:1] + sep + c.Last[:1], nil
+}
+
+func testScope() *Env {
+	return &Env{
+		RootType: reflect.TypeFor[*testCustomer](),
+		Vars: map[string]reflect.Type{
+			"limit":             reflect.TypeFor[int](),
+			"nameEdit.Text":     AnyType,
+			"addressEdit.Value": AnyType,
+		},
+		Funcs: map[string]any{
+			"upper": strings.ToUpper,
+		},
+	}
+}
+
+func TestEval(t *testing.T) {
+	nick := "Bob"
+
+	customer := &testCustomer{
+		First:    "Robert",
+		Last:     "Smith",
+		Age:      42,
+		Tags:     []string{"vip", "new"},
+		Prices:   map[string]float64{"EUR": 9.5},
+		Greeting: func() string { return "Hi" },
+	}
+	withNick := *customer
+	withNick.Nickname = &nick
+
+	vars := Vars{"limit": 40, "nameEdit.Text": "typed", "addressEdit.Value": &testAddress{City: "Berlin"}}
+
+	testCases := []struct {
+		expr string
+		root *testCustomer
+		want any
+	}{
+		{"First", customer, "Robert"},
+		{"Age", customer, 42},
+		{"Age + 1", customer, 43.0},
+		{"Age > limit && !(Age > 50)", customer, true},
+		{"7 % 4 * 2 - -1", customer, 7.0},
+		{"First + ' ' + Last", customer, "Robert Smith"},
+		{`"n=" + Age`, customer, "n=42"},
+		{"FullName", customer, "Robert Smith"},
+		{"FullName()", customer, "Robert Smith"},
+		{"Initials('.')", customer, "R.S"},
+		{"Greeting", customer, "Hi"},
+		{"upper(Last)", customer, "SMITH"},
+		{`format("%s is %d", First, Age)`, customer, "Robert is 42"},
+		{"len(Tags)", customer, 2},
+		{"Tags[1]", customer, "new"},
+		{"Tags[5] ?? 'none'", customer, "none"},
+		{`Prices["EUR"]`, customer, 9.5},
+		{`Prices["USD"] ?? 0`, customer, 0.0},
+		{"Address.City ?? 'unknown'", customer, "unknown"},
+		{"Nickname ?? First", customer, "Robert"},
+		{"Nickname ?? First", &withNick, "Bob"},
+		{"Age == 42 ? 'yes' : 'no'", customer, "yes"},
+		{"nameEdit.Text + '!'", customer, "typed!"},
+		{"addressEdit.Value.City", customer, "Berlin"},
+		{"Address == nil", customer, true},
+		{"First < Last", customer, true},
+	}
+
+	for _, tc := range testCases {
+		p, err := Compile(tc.expr, testScope())
+		if err != nil {
+			t.Errorf("%s: Compile: %v", tc.expr, err)
+			continue
+		}
+
+		got, err := p.Eval(tc.root, vars)
+		if err != nil {
+			t.Errorf("%s: Eval: %v", tc.expr, err)
+			continue
+		}
+
+		if got != tc.want {
+			t.Errorf("%s: got %#v, want %#v", tc.expr, got, tc.want)
+		}
+	}
+}
+
+func TestEvalError(t *testing.T) {
+	p := MustCompile("Initials('')", testScope())
+
+	if _, err := p.Eval(&testCustomer{First: "A", Last: "B"}, nil); err == nil || err.Error() != "empty separator" {
+		t.Errorf("got error %v, want empty separator", err)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	testCases := []struct {
+		expr string
+		want []string
+	}{
+		{"Frist", []string{"col 1: undefined: Frist"}},
+		{"Address.Ctiy + Lats", []string{
+			"col 9: *bindexpr.testAddress has no field or method Ctiy",
+			"col 16: undefined: Lats",
+		}},
+		{"Age && true", []string{"col 5: operator && not defined on int"}},
+		{"First == 1", []string{"col 7: mismatched types string and float64"}},
+		{"Initials()", []string{"col 9: wrong number of arguments to Initials: have 0, want 1"}},
+		{"Initials", []string{"col 1: Initials needs arguments"}},
+		{"upper(Age)", []string{"col 7: cannot use int as string in argument 1 to upper"}},
+		{"Age ? 1 : 2", []string{"col 1: non-boolean condition of type int"}},
+		{"(First", []string{"col 7: expected ), found end of expression"}},
+		{"First +", []string{"col 8: unexpected end of expression"}},
+		{"'abc", []string{"col 1: string literal not terminated"}},
+		{"First # Last", []string{"col 7: unexpected character '#'"}},
+		{"First =~ 'R.*'", []string{"col 7: unsupported operator =~"}},
+		{"Age & 1", []string{"col 5: unsupported operator &"}},
+		{"Age in (1, 2)", []string{"col 5: unexpected in"}},
+	}
+
+	for _, tc := range testCases {
+		_, err := Compile(tc.expr, testScope())
+		if err == nil {
+			t.Errorf("%s: got no error", tc.expr)
+			continue
+		}
+
+		var got []string
+		var list ErrorList
+		if errors.As(err, &list) {
+			for _, e := range list {
+				got = append(got, e.Error())
+			}
+		} else {
+			got = []string{err.Error()}
+		}
+
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %q, want %q", tc.expr, got, tc.want)
+		}
+	}
+}
+
+func TestProgramInfo(t *testing.T) {
+	testCases := []struct {
+		expr     string
+		typ      reflect.Type
+		vars     []string
+		usesRoot bool
+		path     string
+	}{
+		{"Address.City", reflect.TypeFor[string](), nil, true, "Address.City"},
+		{"Age > limit", reflect.TypeFor[bool](), []string{"limit"}, true, ""},
+		{"nameEdit.Text", AnyType, []string{"nameEdit.Text"}, false, ""},
+		{"FullName()", reflect.TypeFor[string](), nil, true, ""},
+		{`Tags[1]`, reflect.TypeFor[string](), nil, true, "Tags[1]"},
+		{`Prices['EUR']`, reflect.TypeFor[float64](), nil, true, `Prices["EUR"]`},
+		{`Tags[limit]`, reflect.TypeFor[string](), []string{"limit"}, true, ""},
+	}
+
+	for _, tc := range testCases {
+		p := MustCompile(tc.expr, testScope())
+
+		if p.Type() != tc.typ {
+			t.Errorf("%s: type: got %v, want %v", tc.expr, p.Type(), tc.typ)
+		}
+		if !reflect.DeepEqual(p.Vars(), tc.vars) {
+			t.Errorf("%s: vars: got %q, want %q", tc.expr, p.Vars(), tc.vars)
+		}
+		if p.UsesRoot() != tc.usesRoot {
+			t.Errorf("%s: uses root: got %t, want %t", tc.expr, p.UsesRoot(), tc.usesRoot)
+		}
+		if path, ok := p.Path(); path != tc.path || ok != (tc.path != "") {
+			t.Errorf("%s: path: got %q, %t, want %q", tc.expr, path, ok, tc.path)
+		}
+	}
+}
+
+func TestDynamicRoot(t *testing.T) {
+	p, err := Compile("Name + ' (' + Count + ')'", &Env{RootType: reflect.TypeFor[map[string]any]()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := p.Eval(map[string]any{"Name": "apples", "Count": 3}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "apples (3)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	p = MustCompile("Value - 1", &Env{RootType: AnyType})
+	if _, err := p.Eval(map[string]any{"Value": "x"}, nil); err == nil || !strings.HasPrefix(err.Error(), "col 7: ") {
+		t.Errorf("got error %v, want error at col 7", err)
+	}
+}
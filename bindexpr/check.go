@@ -0,0 +1,963 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bindexpr
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// operand is the result of checking a node. If typ and fn are both nil, the
+// node had errors.
+type operand struct {
+	typ  reflect.Type // type of the value, or nil if the operand is a function
+	fn   reflect.Type // type of the function, if the operand is one
+	eval evalFunc
+}
+
+func (op operand) invalid() bool {
+	return op.typ == nil && op.fn == nil
+}
+
+type memberKind int
+
+const (
+	memberField memberKind = iota
+	memberMapEntry
+	memberMethod
+	memberDynamic
+)
+
+type checker struct {
+	scope      Scope
+	errs       ErrorList
+	vars       []string
+	usesRoot   bool
+	rootIdents map[*identNode]bool
+}
+
+func (c *checker) errorf(col int, format string, args ...any) operand {
+	c.errs = append(c.errs, &Error{Column: col, Msg: fmt.Sprintf(format, args...)})
+	return operand{}
+}
+
+func (c *checker) addVar(name string) {
+	for _, v := range c.vars {
+		if v == name {
+			return
+		}
+	}
+
+	c.vars = append(c.vars, name)
+}
+
+// rootPath returns the path n is made of, if it starts at the root and only
+// has constant indexes.
+func (c *checker) rootPath(n node) (string, bool) {
+	switch n := n.(type) {
+	case *identNode:
+		return n.name, c.rootIdents[n]
+
+	case *selectorNode:
+		if path, ok := c.rootPath(n.x); ok {
+			return path + "." + n.name, true
+		}
+
+	case *indexNode:
+		path, ok := c.rootPath(n.x)
+		if !ok {
+			break
+		}
+
+		if lit, ok := n.index.(*literalNode); ok {
+			switch index := lit.value.(type) {
+			case float64:
+				if index >= 0 && index == math.Trunc(index) {
+					return fmt.Sprintf("%s[%d]", path, int(index)), true
+				}
+
+			case string:
+				return path + "[" + strconv.Quote(index) + "]", true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func dottedName(n node) (string, bool) {
+	switch n := n.(type) {
+	case *identNode:
+		return n.name, true
+
+	case *selectorNode:
+		if name, ok := dottedName(n.x); ok {
+			return name + "." + n.name, true
+		}
+	}
+
+	return "", false
+}
+
+func typeString(t reflect.Type) string {
+	switch {
+	case t == nilType:
+		return "nil"
+
+	case t == AnyType:
+		return "any"
+	}
+
+	return t.String()
+}
+
+func isDynamic(t reflect.Type) bool {
+	return t.Kind() == reflect.Interface
+}
+
+func isNumber(t reflect.Type) bool {
+	return isNumberKind(t.Kind())
+}
+
+func isString(t reflect.Type) bool {
+	return t.Kind() == reflect.String
+}
+
+func isBool(t reflect.Type) bool {
+	return t.Kind() == reflect.Bool
+}
+
+// assignable reports if a value of type from may be usable as a t. Dynamic
+// values are checked at run time.
+func assignable(from, to reflect.Type) bool {
+	switch {
+	case from == nilType:
+		return isNillable(to)
+
+	case isDynamic(from),
+		from.AssignableTo(to),
+		isNumber(from) && isNumber(to),
+		from.Kind() == to.Kind() && from.ConvertibleTo(to):
+		return true
+	}
+
+	return false
+}
+
+// lookupMember returns the type of the member name of a value of type t.
+func lookupMember(t reflect.Type, name string) (reflect.Type, memberKind, bool) {
+	if isDynamic(t) {
+		if m, ok := t.MethodByName(name); ok {
+			return m.Type, memberMethod, true
+		}
+
+		return AnyType, memberDynamic, true
+	}
+
+	base := t
+	for base.Kind() == reflect.Pointer {
+		base = base.Elem()
+	}
+
+	switch base.Kind() {
+	case reflect.Interface:
+		return AnyType, memberDynamic, true
+
+	case reflect.Struct:
+		if sf, ok := base.FieldByName(name); ok && sf.IsExported() {
+			return sf.Type, memberField, true
+		}
+
+	case reflect.Map:
+		if base.Key().Kind() == reflect.String {
+			return base.Elem(), memberMapEntry, true
+		}
+	}
+
+	if m, ok := reflect.PointerTo(base).MethodByName(name); ok {
+		// Drop the receiver.
+		in := make([]reflect.Type, m.Type.NumIn()-1)
+		for i := range in {
+			in[i] = m.Type.In(i + 1)
+		}
+		out := make([]reflect.Type, m.Type.NumOut())
+		for i := range out {
+			out[i] = m.Type.Out(i)
+		}
+
+		return reflect.FuncOf(in, out, m.Type.IsVariadic()), memberMethod, true
+	}
+
+	return nil, 0, false
+}
+
+// valueOrFunc returns an operand for a value of type t.
+func valueOrFunc(t reflect.Type, eval evalFunc) operand {
+	if t.Kind() == reflect.Func {
+		return operand{fn: t, eval: eval}
+	}
+
+	return operand{typ: t, eval: eval}
+}
+
+// check checks n, which must be a value.
+func (c *checker) check(n node) operand {
+	op := c.operand(n, false)
+
+	if op.fn != nil {
+		return c.autoCall(n, op)
+	}
+
+	return op
+}
+
+// autoCall turns op, a function used without parentheses, into the value it
+// returns.
+func (c *checker) autoCall(n node, op operand) operand {
+	ft := op.fn
+
+	if ft.NumIn() > 1 || ft.NumIn() == 1 && !ft.IsVariadic() {
+		return c.errorf(n.column(), "%s needs arguments", describe(n))
+	}
+
+	rt, ok := resultType(ft)
+	if !ok {
+		return c.errorf(n.column(), "cannot use result of %s (%s)", describe(n), ft)
+	}
+
+	return operand{
+		typ: rt,
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			fn, err := op.eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			return callFunc(fn, nil, n.column())
+		},
+	}
+}
+
+func describe(n node) string {
+	if name, ok := dottedName(n); ok {
+		return name
+	}
+
+	return "expression"
+}
+
+// operand checks n. If callee is true, n is called with arguments.
+func (c *checker) operand(n node, callee bool) operand {
+	switch n := n.(type) {
+	case *literalNode:
+		return c.literal(n)
+
+	case *identNode:
+		return c.ident(n, callee)
+
+	case *selectorNode:
+		return c.selector(n, callee)
+
+	case *indexNode:
+		return c.index(n)
+
+	case *callNode:
+		return c.call(n)
+
+	case *unaryNode:
+		return c.unary(n)
+
+	case *binaryNode:
+		return c.binary(n)
+
+	case *condNode:
+		return c.cond(n)
+	}
+
+	panic("unexpected node")
+}
+
+func (c *checker) literal(n *literalNode) operand {
+	if n.value == nil {
+		return operand{
+			typ: nilType,
+			eval: func(ctx *evalContext) (reflect.Value, error) {
+				return reflect.Value{}, nil
+			},
+		}
+	}
+
+	v := reflect.ValueOf(n.value)
+
+	return operand{
+		typ: v.Type(),
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			return v, nil
+		},
+	}
+}
+
+func (c *checker) variable(name string, t reflect.Type, col int) operand {
+	c.addVar(name)
+
+	if t == nil {
+		t = AnyType
+	}
+
+	return valueOrFunc(t, func(ctx *evalContext) (reflect.Value, error) {
+		if ctx.vars == nil {
+			return reflect.Value{}, runtimeErrorf(col, "no value for variable %s", name)
+		}
+
+		v, err := ctx.vars.Get(name)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return unwrap(reflect.ValueOf(v)), nil
+	})
+}
+
+// member returns an operand for the member name of the value x evaluates to.
+func (c *checker) member(t reflect.Type, kind memberKind, x evalFunc, name string, col int, callee bool) operand {
+	eval := func(ctx *evalContext) (reflect.Value, error) {
+		xv, err := x(ctx)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		v, ok := selectMember(xv, name)
+		if !ok {
+			return reflect.Value{}, runtimeErrorf(col, "%s has no field or method %s", kindName(xv), name)
+		}
+
+		return v, nil
+	}
+
+	switch kind {
+	case memberMethod:
+		return operand{fn: t, eval: eval}
+
+	case memberDynamic:
+		if callee {
+			return operand{typ: AnyType, eval: eval}
+		}
+
+		// Call functions without parentheses, like known ones.
+		return operand{
+			typ: AnyType,
+			eval: func(ctx *evalContext) (reflect.Value, error) {
+				v, err := eval(ctx)
+				if err != nil || v.Kind() != reflect.Func {
+					return v, err
+				}
+
+				return callFunc(v, nil, col)
+			},
+		}
+	}
+
+	return valueOrFunc(t, eval)
+}
+
+func (c *checker) ident(n *identNode, callee bool) operand {
+	if t, ok := c.scope.Var(n.name); ok {
+		return c.variable(n.name, t, n.col)
+	}
+
+	if fn, ok := c.scope.Func(n.name); ok {
+		fv := reflect.ValueOf(fn)
+		if fv.Kind() != reflect.Func {
+			return c.errorf(n.col, "%s is not a function", n.name)
+		}
+
+		return operand{
+			fn: fv.Type(),
+			eval: func(ctx *evalContext) (reflect.Value, error) {
+				return fv, nil
+			},
+		}
+	}
+
+	rt := c.scope.Root()
+	if rt == nil {
+		return c.errorf(n.col, "undefined: %s", n.name)
+	}
+
+	t, kind, ok := lookupMember(rt, n.name)
+	if !ok {
+		return c.errorf(n.col, "undefined: %s", n.name)
+	}
+
+	c.usesRoot = true
+	c.rootIdents[n] = true
+
+	return c.member(t, kind, func(ctx *evalContext) (reflect.Value, error) {
+		return unwrap(ctx.root), nil
+	}, n.name, n.col, callee)
+}
+
+func (c *checker) selector(n *selectorNode, callee bool) operand {
+	if name, ok := dottedName(n); ok {
+		if t, ok := c.scope.Var(name); ok {
+			return c.variable(name, t, n.col)
+		}
+	}
+
+	x := c.check(n.x)
+	if x.invalid() {
+		return x
+	}
+
+	if x.typ == nilType {
+		return c.errorf(n.col, "nil has no field or method %s", n.name)
+	}
+
+	t, kind, ok := lookupMember(x.typ, n.name)
+	if !ok {
+		return c.errorf(n.col, "%s has no field or method %s", typeString(x.typ), n.name)
+	}
+
+	return c.member(t, kind, x.eval, n.name, n.col, callee)
+}
+
+func (c *checker) index(n *indexNode) operand {
+	x := c.check(n.x)
+	index := c.check(n.index)
+	if x.invalid() || index.invalid() {
+		return operand{}
+	}
+
+	t := x.typ
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var elem reflect.Type
+
+	switch t.Kind() {
+	case reflect.Interface:
+		elem = AnyType
+
+	case reflect.Array, reflect.Slice:
+		if !isDynamic(index.typ) && !isNumber(index.typ) {
+			return c.errorf(n.index.column(), "invalid index of type %s", typeString(index.typ))
+		}
+		elem = t.Elem()
+
+	case reflect.Map:
+		if !assignable(index.typ, t.Key()) {
+			return c.errorf(n.index.column(), "cannot use %s as map key of type %s", typeString(index.typ), t.Key())
+		}
+		elem = t.Elem()
+
+	default:
+		return c.errorf(n.col, "cannot index %s", typeString(x.typ))
+	}
+
+	return valueOrFunc(elem, func(ctx *evalContext) (reflect.Value, error) {
+		xv, err := x.eval(ctx)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		iv, err := index.eval(ctx)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return selectIndex(xv, iv, n.col)
+	})
+}
+
+func (c *checker) call(n *callNode) operand {
+	args := make([]operand, len(n.args))
+	argsValid := true
+	for i, arg := range n.args {
+		args[i] = c.check(arg)
+		if args[i].invalid() {
+			argsValid = false
+		}
+	}
+
+	if ident, ok := n.fun.(*identNode); ok {
+		if _, ok := c.scope.Var(ident.name); !ok {
+			if _, ok := c.scope.Func(ident.name); !ok {
+				if builtin, ok := builtins[ident.name]; ok {
+					if !argsValid {
+						return operand{}
+					}
+
+					return builtin(c, n, args)
+				}
+			}
+		}
+	}
+
+	fun := c.operand(n.fun, true)
+	if fun.invalid() || !argsValid {
+		return operand{}
+	}
+
+	var rt reflect.Type
+
+	switch {
+	case fun.fn != nil:
+		ft := fun.fn
+
+		numIn := ft.NumIn()
+		if ft.IsVariadic() && len(args) < numIn-1 || !ft.IsVariadic() && len(args) != numIn {
+			want := fmt.Sprint(numIn)
+			if ft.IsVariadic() {
+				want = fmt.Sprintf("at least %d", numIn-1)
+			}
+
+			return c.errorf(n.col, "wrong number of arguments to %s: have %d, want %s", describe(n.fun), len(args), want)
+		}
+
+		for i, arg := range args {
+			var pt reflect.Type
+			if ft.IsVariadic() && i >= numIn-1 {
+				pt = ft.In(numIn - 1).Elem()
+			} else {
+				pt = ft.In(i)
+			}
+
+			if !assignable(arg.typ, pt) {
+				c.errorf(n.args[i].column(), "cannot use %s as %s in argument %d to %s", typeString(arg.typ), pt, i+1, describe(n.fun))
+			}
+		}
+
+		var ok bool
+		if rt, ok = resultType(ft); !ok {
+			return c.errorf(n.col, "cannot use result of %s (%s)", describe(n.fun), ft)
+		}
+
+	case isDynamic(fun.typ):
+		rt = AnyType
+
+	default:
+		return c.errorf(n.col, "cannot call %s of type %s", describe(n.fun), typeString(fun.typ))
+	}
+
+	return valueOrFunc(rt, func(ctx *evalContext) (reflect.Value, error) {
+		fn, err := fun.eval(ctx)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		argValues := make([]reflect.Value, len(args))
+		for i, arg := range args {
+			if argValues[i], err = arg.eval(ctx); err != nil {
+				return reflect.Value{}, err
+			}
+		}
+
+		return callFunc(fn, argValues, n.col)
+	})
+}
+
+func (c *checker) unary(n *unaryNode) operand {
+	x := c.check(n.x)
+	if x.invalid() {
+		return x
+	}
+
+	if n.op == "!" {
+		if !isDynamic(x.typ) && !isBool(x.typ) {
+			return c.errorf(n.col, "operator ! not defined on %s", typeString(x.typ))
+		}
+
+		return operand{
+			typ: boolType,
+			eval: func(ctx *evalContext) (reflect.Value, error) {
+				v, err := x.eval(ctx)
+				if err != nil {
+					return reflect.Value{}, err
+				}
+				if v.Kind() != reflect.Bool {
+					return reflect.Value{}, runtimeErrorf(n.col, "operator ! not defined on %s", kindName(v))
+				}
+
+				return reflect.ValueOf(!v.Bool()), nil
+			},
+		}
+	}
+
+	if !isDynamic(x.typ) && !isNumber(x.typ) {
+		return c.errorf(n.col, "operator - not defined on %s", typeString(x.typ))
+	}
+
+	return operand{
+		typ: float64Type,
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			v, err := x.eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			f, ok := toFloat(v)
+			if !ok {
+				return reflect.Value{}, runtimeErrorf(n.col, "operator - not defined on %s", kindName(v))
+			}
+
+			return reflect.ValueOf(-f), nil
+		},
+	}
+}
+
+func (c *checker) binary(n *binaryNode) operand {
+	x := c.check(n.x)
+	y := c.check(n.y)
+	if x.invalid() || y.invalid() {
+		return operand{}
+	}
+
+	switch n.op {
+	case "&&", "||":
+		return c.logical(n, x, y)
+
+	case "??":
+		return c.coalesce(x, y)
+
+	case "==", "!=":
+		return c.equality(n, x, y)
+
+	case "<", "<=", ">", ">=":
+		return c.comparison(n, x, y)
+	}
+
+	return c.arithmetic(n, x, y)
+}
+
+func (c *checker) logical(n *binaryNode, x, y operand) operand {
+	for _, op := range []operand{x, y} {
+		if !isDynamic(op.typ) && !isBool(op.typ) {
+			return c.errorf(n.col, "operator %s not defined on %s", n.op, typeString(op.typ))
+		}
+	}
+
+	toBool := func(ctx *evalContext, op operand) (bool, error) {
+		v, err := op.eval(ctx)
+		if err != nil {
+			return false, err
+		}
+		if v.Kind() != reflect.Bool {
+			return false, runtimeErrorf(n.col, "operator %s not defined on %s", n.op, kindName(v))
+		}
+
+		return v.Bool(), nil
+	}
+
+	return operand{
+		typ: boolType,
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			a, err := toBool(ctx, x)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			if n.op == "&&" && !a || n.op == "||" && a {
+				return reflect.ValueOf(a), nil
+			}
+
+			b, err := toBool(ctx, y)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			return reflect.ValueOf(b), nil
+		},
+	}
+}
+
+func (c *checker) coalesce(x, y operand) operand {
+	var t reflect.Type
+	var deref bool
+
+	switch {
+	case x.typ == nilType:
+		t = y.typ
+
+	case x.typ.Kind() == reflect.Pointer && x.typ.Elem() == y.typ:
+		t = y.typ
+		deref = true
+
+	case x.typ == y.typ, y.typ == nilType:
+		t = x.typ
+
+	default:
+		t = AnyType
+	}
+
+	return operand{
+		typ: t,
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			v, err := x.eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			if isNil(v) {
+				return y.eval(ctx)
+			}
+
+			if deref {
+				return v.Elem(), nil
+			}
+
+			return v, nil
+		},
+	}
+}
+
+// canCompare reports if values of types a and b may be compared using ==.
+func canCompare(a, b reflect.Type) bool {
+	switch {
+	case isDynamic(a), isDynamic(b):
+		return true
+
+	case a == nilType || b == nilType:
+		return isNillable(a) && isNillable(b)
+
+	case isNumber(a) && isNumber(b),
+		isString(a) && isString(b),
+		isBool(a) && isBool(b):
+		return true
+	}
+
+	return a == b || a.AssignableTo(b) || b.AssignableTo(a)
+}
+
+func (c *checker) equality(n *binaryNode, x, y operand) operand {
+	if !canCompare(x.typ, y.typ) {
+		return c.errorf(n.col, "mismatched types %s and %s", typeString(x.typ), typeString(y.typ))
+	}
+
+	return operand{
+		typ: boolType,
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			a, err := x.eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			b, err := y.eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			return reflect.ValueOf(equal(a, b) == (n.op == "==")), nil
+		},
+	}
+}
+
+func (c *checker) comparison(n *binaryNode, x, y operand) operand {
+	a, b := x.typ, y.typ
+
+	switch {
+	case isDynamic(a), isDynamic(b),
+		isNumber(a) && isNumber(b),
+		isString(a) && isString(b),
+		a == timeType && b == timeType:
+
+	default:
+		return c.errorf(n.col, "operator %s not defined on %s and %s", n.op, typeString(a), typeString(b))
+	}
+
+	return operand{
+		typ: boolType,
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			a, err := x.eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			b, err := y.eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			result, err := compare(a, b, n.op, n.col)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			return reflect.ValueOf(result), nil
+		},
+	}
+}
+
+func (c *checker) arithmetic(n *binaryNode, x, y operand) operand {
+	a, b := x.typ, y.typ
+
+	var t reflect.Type
+
+	switch {
+	case n.op == "+" && (isString(a) || isString(b)):
+		t = stringType
+
+	case n.op == "+" && (isDynamic(a) || isDynamic(b)) && (isDynamic(a) || isNumber(a)) && (isDynamic(b) || isNumber(b)):
+		// Might still be a concatenation.
+		t = AnyType
+
+	case (isDynamic(a) || isNumber(a)) && (isDynamic(b) || isNumber(b)):
+		t = float64Type
+
+	default:
+		return c.errorf(n.col, "operator %s not defined on %s and %s", n.op, typeString(a), typeString(b))
+	}
+
+	return operand{
+		typ: t,
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			a, err := x.eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			b, err := y.eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			return arithmetic(a, b, n.op, n.col)
+		},
+	}
+}
+
+func (c *checker) cond(n *condNode) operand {
+	cond := c.check(n.cond)
+	x := c.check(n.x)
+	y := c.check(n.y)
+	if cond.invalid() || x.invalid() || y.invalid() {
+		return operand{}
+	}
+
+	if !isDynamic(cond.typ) && !isBool(cond.typ) {
+		return c.errorf(n.cond.column(), "non-boolean condition of type %s", typeString(cond.typ))
+	}
+
+	var t reflect.Type
+	switch {
+	case x.typ == y.typ:
+		t = x.typ
+
+	case x.typ == nilType && isNillable(y.typ):
+		t = y.typ
+
+	case y.typ == nilType && isNillable(x.typ):
+		t = x.typ
+
+	default:
+		t = AnyType
+	}
+
+	return operand{
+		typ: t,
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			v, err := cond.eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			if v.Kind() != reflect.Bool {
+				return reflect.Value{}, runtimeErrorf(n.cond.column(), "non-boolean condition of type %s", kindName(v))
+			}
+
+			if v.Bool() {
+				return x.eval(ctx)
+			}
+			return y.eval(ctx)
+		},
+	}
+}
+
+var builtins map[string]func(c *checker, n *callNode, args []operand) operand
+
+func init() {
+	builtins = map[string]func(c *checker, n *callNode, args []operand) operand{
+		"format": (*checker).builtinFormat,
+		"len":    (*checker).builtinLen,
+	}
+}
+
+// builtinFormat checks format(f, args...), which formats like fmt.Sprintf.
+func (c *checker) builtinFormat(n *callNode, args []operand) operand {
+	if len(args) == 0 {
+		return c.errorf(n.col, "not enough arguments to format")
+	}
+	if !isDynamic(args[0].typ) && !isString(args[0].typ) {
+		return c.errorf(n.args[0].column(), "cannot use %s as format string", typeString(args[0].typ))
+	}
+
+	return operand{
+		typ: stringType,
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			values := make([]any, len(args))
+			for i, arg := range args {
+				v, err := arg.eval(ctx)
+				if err != nil {
+					return reflect.Value{}, err
+				}
+				values[i] = interfaceOf(v)
+			}
+
+			format, ok := values[0].(string)
+			if !ok {
+				return reflect.Value{}, runtimeErrorf(n.args[0].column(), "cannot use %s as format string", kindName(reflect.ValueOf(values[0])))
+			}
+
+			return reflect.ValueOf(fmt.Sprintf(format, values[1:]...)), nil
+		},
+	}
+}
+
+// builtinLen checks len(x).
+func (c *checker) builtinLen(n *callNode, args []operand) operand {
+	if len(args) != 1 {
+		return c.errorf(n.col, "wrong number of arguments to len: have %d, want 1", len(args))
+	}
+
+	t := args[0].typ
+	if t.Kind() == reflect.Pointer && t.Elem().Kind() == reflect.Array {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Interface, reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+
+	default:
+		return c.errorf(n.args[0].column(), "invalid argument %s for len", typeString(args[0].typ))
+	}
+
+	return operand{
+		typ: intType,
+		eval: func(ctx *evalContext) (reflect.Value, error) {
+			v, err := args[0].eval(ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			if v.Kind() == reflect.Pointer {
+				if v.IsNil() {
+					return reflect.ValueOf(0), nil
+				}
+				v = v.Elem()
+			}
+
+			switch v.Kind() {
+			case reflect.Invalid:
+				return reflect.ValueOf(0), nil
+
+			case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+				return reflect.ValueOf(v.Len()), nil
+			}
+
+			return reflect.Value{}, runtimeErrorf(n.args[0].column(), "invalid argument %s for len", kindName(v))
+		},
+	}
+}
@@ -0,0 +1,397 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bindexpr
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// An invalid reflect.Value stands for nil during evaluation. Values are never
+// of kind reflect.Interface, see unwrap.
+type evalFunc func(ctx *evalContext) (reflect.Value, error)
+
+type evalContext struct {
+	root reflect.Value
+	vars Values
+}
+
+var (
+	boolType    = reflect.TypeFor[bool]()
+	errorType   = reflect.TypeFor[error]()
+	float64Type = reflect.TypeFor[float64]()
+	intType     = reflect.TypeFor[int]()
+	stringType  = reflect.TypeFor[string]()
+	timeType    = reflect.TypeFor[time.Time]()
+)
+
+// untypedNil is the type of the nil literal.
+type untypedNil struct{}
+
+var nilType = reflect.TypeFor[untypedNil]()
+
+func runtimeErrorf(col int, format string, args ...any) error {
+	return &Error{Column: col, Msg: fmt.Sprintf(format, args...)}
+}
+
+func unwrap(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+
+	return v
+}
+
+func interfaceOf(v reflect.Value) any {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil
+	}
+
+	return v.Interface()
+}
+
+func isNil(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+func isNillable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return true
+	}
+
+	return t == nilType
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
+
+func toFloat(v reflect.Value) (float64, bool) {
+	switch {
+	case v.CanInt():
+		return float64(v.Int()), true
+
+	case v.CanUint():
+		return float64(v.Uint()), true
+
+	case v.CanFloat():
+		return v.Float(), true
+	}
+
+	return 0, false
+}
+
+func toString(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	if v.Kind() == reflect.String {
+		return v.String()
+	}
+
+	return fmt.Sprint(interfaceOf(v))
+}
+
+func kindName(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+
+	return v.Type().String()
+}
+
+// selectMember returns the field, map entry or method name of v. It returns
+// false if v has no such member. Selecting from nil yields nil.
+func selectMember(v reflect.Value, name string) (reflect.Value, bool) {
+	var ptr reflect.Value
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, true
+		}
+		if v.Kind() == reflect.Pointer {
+			ptr = v
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Invalid:
+		return reflect.Value{}, true
+
+	case reflect.Struct:
+		if sf, ok := v.Type().FieldByName(name); ok && sf.IsExported() {
+			f, err := v.FieldByIndexErr(sf.Index)
+			if err != nil {
+				// Nil embedded pointer
+				return reflect.Value{}, true
+			}
+
+			return unwrap(f), true
+		}
+
+	case reflect.Map:
+		if key := v.Type().Key(); key.Kind() == reflect.String {
+			return unwrap(v.MapIndex(reflect.ValueOf(name).Convert(key))), true
+		}
+	}
+
+	if ptr.IsValid() {
+		if m := ptr.MethodByName(name); m.IsValid() {
+			return m, true
+		}
+	}
+	if v.CanAddr() {
+		if m := v.Addr().MethodByName(name); m.IsValid() {
+			return m, true
+		}
+	}
+	if m := v.MethodByName(name); m.IsValid() {
+		return m, true
+	}
+
+	// Pointer receiver on a value we cannot take the address of
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	if m := p.MethodByName(name); m.IsValid() {
+		return m, true
+	}
+
+	return reflect.Value{}, false
+}
+
+// selectIndex returns the element index of v. Out of range indexes and missing
+// map keys yield nil.
+func selectIndex(v, index reflect.Value, col int) (reflect.Value, error) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Invalid:
+		return reflect.Value{}, nil
+
+	case reflect.Array, reflect.Slice:
+		f, ok := toFloat(index)
+		if !ok {
+			return reflect.Value{}, runtimeErrorf(col, "invalid index of type %s", kindName(index))
+		}
+
+		i := int(f)
+		if i < 0 || i >= v.Len() {
+			return reflect.Value{}, nil
+		}
+
+		return unwrap(v.Index(i)), nil
+
+	case reflect.Map:
+		key, err := convert(index, v.Type().Key())
+		if err != nil {
+			return reflect.Value{}, runtimeErrorf(col, "invalid map key: %s", err)
+		}
+
+		return unwrap(v.MapIndex(key)), nil
+	}
+
+	return reflect.Value{}, runtimeErrorf(col, "cannot index %s", kindName(v))
+}
+
+// convert converts v to type t, as needed for arguments and map keys.
+func convert(v reflect.Value, t reflect.Type) (reflect.Value, error) {
+	if !v.IsValid() {
+		if isNillable(t) {
+			return reflect.Zero(t), nil
+		}
+
+		return reflect.Value{}, fmt.Errorf("cannot use nil as %s", t)
+	}
+
+	vt := v.Type()
+
+	switch {
+	case vt.AssignableTo(t):
+		return v, nil
+
+	case isNumberKind(vt.Kind()) && isNumberKind(t.Kind()),
+		vt.Kind() == t.Kind() && vt.ConvertibleTo(t):
+		return v.Convert(t), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot use %s as %s", vt, t)
+}
+
+// resultType returns the type of the value a function of type ft returns. The
+// function may also return an error as second result.
+func resultType(ft reflect.Type) (reflect.Type, bool) {
+	switch {
+	case ft.NumOut() == 1:
+		return ft.Out(0), true
+
+	case ft.NumOut() == 2 && ft.Out(1) == errorType:
+		return ft.Out(0), true
+	}
+
+	return nil, false
+}
+
+func callFunc(fn reflect.Value, args []reflect.Value, col int) (reflect.Value, error) {
+	if isNil(fn) {
+		return reflect.Value{}, nil
+	}
+	if fn.Kind() != reflect.Func {
+		return reflect.Value{}, runtimeErrorf(col, "cannot call %s", kindName(fn))
+	}
+
+	ft := fn.Type()
+
+	if _, ok := resultType(ft); !ok {
+		return reflect.Value{}, runtimeErrorf(col, "cannot use result of %s", ft)
+	}
+
+	numIn := ft.NumIn()
+	if ft.IsVariadic() && len(args) < numIn-1 || !ft.IsVariadic() && len(args) != numIn {
+		return reflect.Value{}, runtimeErrorf(col, "wrong number of arguments to %s: have %d", ft, len(args))
+	}
+
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		var pt reflect.Type
+		if ft.IsVariadic() && i >= numIn-1 {
+			pt = ft.In(numIn - 1).Elem()
+		} else {
+			pt = ft.In(i)
+		}
+
+		v, err := convert(arg, pt)
+		if err != nil {
+			return reflect.Value{}, runtimeErrorf(col, "argument %d: %s", i+1, err)
+		}
+		in[i] = v
+	}
+
+	out := fn.Call(in)
+
+	if len(out) == 2 && !out[1].IsNil() {
+		return reflect.Value{}, out[1].Interface().(error)
+	}
+
+	return unwrap(out[0]), nil
+}
+
+func equal(a, b reflect.Value) bool {
+	if isNil(a) || isNil(b) {
+		return isNil(a) && isNil(b)
+	}
+
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			return fa == fb
+		}
+	}
+
+	if a.Kind() == reflect.String && b.Kind() == reflect.String {
+		return a.String() == b.String()
+	}
+
+	if a.Type() == b.Type() && a.Type().Comparable() {
+		return a.Equal(b)
+	}
+
+	return reflect.DeepEqual(interfaceOf(a), interfaceOf(b))
+}
+
+func compare(a, b reflect.Value, op string, col int) (bool, error) {
+	var c int
+
+	fa, aIsNum := toFloat(a)
+	fb, bIsNum := toFloat(b)
+
+	switch {
+	case aIsNum && bIsNum:
+		c = compareOrdered(fa, fb)
+
+	case a.Kind() == reflect.String && b.Kind() == reflect.String:
+		c = strings.Compare(a.String(), b.String())
+
+	case a.IsValid() && b.IsValid() && a.Type() == timeType && b.Type() == timeType:
+		c = a.Interface().(time.Time).Compare(b.Interface().(time.Time))
+
+	default:
+		return false, runtimeErrorf(col, "cannot compare %s and %s", kindName(a), kindName(b))
+	}
+
+	switch op {
+	case "<":
+		return c < 0, nil
+
+	case "<=":
+		return c <= 0, nil
+
+	case ">":
+		return c > 0, nil
+	}
+	return c >= 0, nil
+}
+
+func compareOrdered(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func arithmetic(a, b reflect.Value, op string, col int) (reflect.Value, error) {
+	if op == "+" && (a.Kind() == reflect.String || b.Kind() == reflect.String) {
+		return reflect.ValueOf(toString(a) + toString(b)), nil
+	}
+
+	fa, aIsNum := toFloat(a)
+	fb, bIsNum := toFloat(b)
+	if !aIsNum || !bIsNum {
+		return reflect.Value{}, runtimeErrorf(col, "operator %s not defined on %s and %s", op, kindName(a), kindName(b))
+	}
+
+	var f float64
+	switch op {
+	case "+":
+		f = fa + fb
+
+	case "-":
+		f = fa - fb
+
+	case "*":
+		f = fa * fb
+
+	case "/":
+		f = fa / fb
+
+	case "%":
+		f = math.Mod(fa, fb)
+	}
+
+	return reflect.ValueOf(f), nil
+}
@@ -0,0 +1,319 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bindexpr
+
+import (
+	"fmt"
+)
+
+type node interface {
+	column() int
+}
+
+type literalNode struct {
+	col   int
+	value any // nil, bool, float64 or string
+}
+
+type identNode struct {
+	col  int
+	name string
+}
+
+type selectorNode struct {
+	col  int // column of name
+	x    node
+	name string
+}
+
+type indexNode struct {
+	col   int // column of '['
+	x     node
+	index node
+}
+
+type callNode struct {
+	col  int // column of '('
+	fun  node
+	args []node
+}
+
+type unaryNode struct {
+	col int
+	op  string
+	x   node
+}
+
+type binaryNode struct {
+	col  int // column of op
+	op   string
+	x, y node
+}
+
+type condNode struct {
+	col  int // column of '?'
+	cond node
+	x, y node
+}
+
+func (n *literalNode) column() int  { return n.col }
+func (n *identNode) column() int    { return n.col }
+func (n *selectorNode) column() int { return n.col }
+func (n *indexNode) column() int    { return n.col }
+func (n *callNode) column() int     { return n.col }
+func (n *unaryNode) column() int    { return n.col }
+func (n *binaryNode) column() int   { return n.col }
+func (n *condNode) column() int     { return n.col }
+
+// binaryPrecedence lists binary operators from lowest to highest precedence.
+var binaryPrecedence = [][]string{
+	{"??"},
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func parse(src string) (node, error) {
+	tokens, err := scan(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.unexpected(t)
+	}
+
+	return n, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOperator(ops ...string) bool {
+	t := p.peek()
+	if t.kind != tokenOperator {
+		return false
+	}
+
+	for _, op := range ops {
+		if t.text == op {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *parser) expect(op string) (token, error) {
+	if !p.isOperator(op) {
+		t := p.peek()
+		if t.kind == tokenEOF {
+			return t, &Error{Column: t.col, Msg: fmt.Sprintf("expected %s, found end of expression", op)}
+		}
+		return t, &Error{Column: t.col, Msg: fmt.Sprintf("expected %s, found %s", op, t.text)}
+	}
+
+	return p.next(), nil
+}
+
+func (p *parser) unexpected(t token) error {
+	if t.kind == tokenEOF {
+		return &Error{Column: t.col, Msg: "unexpected end of expression"}
+	}
+
+	return &Error{Column: t.col, Msg: "unexpected " + t.text}
+}
+
+func (p *parser) expr() (node, error) {
+	cond, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isOperator("?") {
+		return cond, nil
+	}
+
+	q := p.next()
+
+	x, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.expect(":"); err != nil {
+		return nil, err
+	}
+
+	y, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+
+	return &condNode{col: q.col, cond: cond, x: x, y: y}, nil
+}
+
+func (p *parser) binary(level int) (node, error) {
+	if level == len(binaryPrecedence) {
+		return p.unary()
+	}
+
+	x, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator(binaryPrecedence[level]...) {
+		op := p.next()
+
+		y, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		x = &binaryNode{col: op.col, op: op.text, x: x, y: y}
+	}
+
+	return x, nil
+}
+
+func (p *parser) unary() (node, error) {
+	if p.isOperator("!", "-") {
+		op := p.next()
+
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &unaryNode{col: op.col, op: op.text, x: x}, nil
+	}
+
+	return p.postfix()
+}
+
+func (p *parser) postfix() (node, error) {
+	x, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch {
+		case p.isOperator("."):
+			p.next()
+
+			t := p.next()
+			if t.kind != tokenIdent {
+				return nil, &Error{Column: t.col, Msg: "expected name after ."}
+			}
+
+			x = &selectorNode{col: t.col, x: x, name: t.text}
+
+		case p.isOperator("["):
+			open := p.next()
+
+			index, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := p.expect("]"); err != nil {
+				return nil, err
+			}
+
+			x = &indexNode{col: open.col, x: x, index: index}
+
+		case p.isOperator("("):
+			open := p.next()
+
+			var args []node
+			for !p.isOperator(")") {
+				arg, err := p.expr()
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+
+				if !p.isOperator(",") {
+					break
+				}
+				p.next()
+			}
+
+			if _, err := p.expect(")"); err != nil {
+				return nil, err
+			}
+
+			x = &callNode{col: open.col, fun: x, args: args}
+
+		default:
+			return x, nil
+		}
+	}
+}
+
+func (p *parser) primary() (node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenNumber, tokenString:
+		return &literalNode{col: t.col, value: t.value}, nil
+
+	case tokenIdent:
+		switch t.text {
+		case "true":
+			return &literalNode{col: t.col, value: true}, nil
+
+		case "false":
+			return &literalNode{col: t.col, value: false}, nil
+
+		case "nil":
+			return &literalNode{col: t.col}, nil
+		}
+
+		return &identNode{col: t.col, name: t.text}, nil
+
+	case tokenOperator:
+		if t.text == "(" {
+			x, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := p.expect(")"); err != nil {
+				return nil, err
+			}
+
+			return x, nil
+		}
+	}
+
+	return nil, p.unexpected(t)
+}
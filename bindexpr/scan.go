@@ -0,0 +1,167 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bindexpr
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string // identifier, operator or source text of a literal
+	value any    // float64 or string for literals
+	col   int
+}
+
+// operators lists all operators and punctuation, longest first.
+var operators = []string{
+	"??", "==", "!=", "<=", ">=", "&&", "||", "=~", "!~", "<<", ">>",
+	"+", "-", "*", "/", "%", "!", "<", ">", "?", ":", "(", ")", "[", "]", ",", ".", "&", "|", "^", "~",
+}
+
+// unsupportedOperators lists the operators of govaluate, which was used to
+// evaluate expressions before, that are not supported.
+var unsupportedOperators = map[string]bool{
+	"=~": true, "!~": true, "<<": true, ">>": true, "&": true, "|": true, "^": true, "~": true,
+}
+
+// scan splits src into tokens. The last token is always of kind tokenEOF.
+func scan(src string) ([]token, error) {
+	var tokens []token
+
+	col := 1
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+			col++
+			continue
+
+		case r == '_' || unicode.IsLetter(r):
+			start, startCol := i, col
+			for i < len(src) {
+				r, size := utf8.DecodeRuneInString(src[i:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				i += size
+				col++
+			}
+
+			tokens = append(tokens, token{kind: tokenIdent, text: src[start:i], col: startCol})
+			continue
+
+		case r >= '0' && r <= '9':
+			start, startCol := i, col
+			for i < len(src) && (src[i] >= '0' && src[i] <= '9' || src[i] == '.' || src[i] == 'e' || src[i] == 'E' ||
+				(src[i] == '+' || src[i] == '-') && (src[i-1] == 'e' || src[i-1] == 'E')) {
+				i++
+				col++
+			}
+
+			text := src[start:i]
+			f, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, &Error{Column: startCol, Msg: "invalid number " + text}
+			}
+
+			tokens = append(tokens, token{kind: tokenNumber, text: text, value: f, col: startCol})
+			continue
+
+		case r == '"' || r == '\'':
+			s, n, runes, err := scanString(src[i:], col)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: src[i : i+n], value: s, col: col})
+			i += n
+			col += runes
+			continue
+		}
+
+		var op string
+		for _, o := range operators {
+			if strings.HasPrefix(src[i:], o) {
+				op = o
+				break
+			}
+		}
+		if op == "" {
+			return nil, &Error{Column: col, Msg: "unexpected character " + strconv.QuoteRune(r)}
+		}
+		if unsupportedOperators[op] {
+			return nil, &Error{Column: col, Msg: "unsupported operator " + op}
+		}
+
+		tokens = append(tokens, token{kind: tokenOperator, text: op, col: col})
+		i += len(op)
+		col += len(op)
+	}
+
+	return append(tokens, token{kind: tokenEOF, col: col}), nil
+}
+
+// scanString scans the quoted string at the start of src, which starts at
+// column col. It returns the unquoted string, its length in bytes and in runes.
+func scanString(src string, col int) (string, int, int, error) {
+	quote := src[0]
+
+	var sb strings.Builder
+	runes := 1
+
+	for i := 1; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		i += size
+		runes++
+
+		switch {
+		case r == rune(quote):
+			return sb.String(), i, runes, nil
+
+		case r == '\\':
+			if i >= len(src) {
+				break
+			}
+
+			e, size := utf8.DecodeRuneInString(src[i:])
+			i += size
+			runes++
+
+			switch e {
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case '\\', '"', '\'':
+				sb.WriteRune(e)
+			default:
+				return "", 0, 0, &Error{Column: col + runes - 2, Msg: "unknown escape sequence \\" + string(e)}
+			}
+
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	return "", 0, 0, &Error{Column: col, Msg: "string literal not terminated"}
+}
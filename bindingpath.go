@@ -0,0 +1,506 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// bindingPath is a parsed DataBinder path like `Orders[2].Customer.Name` or
+// `Attrs["color"]`.
+type bindingPath []bindingPathStep
+
+type bindingPathStep struct {
+	name  string // field, method or map key, if index is nil
+	index any    // int or string, for a step in brackets
+}
+
+func parseBindingPath(path string) (bindingPath, error) {
+	var p bindingPath
+
+	rest := path
+	for rest != "" {
+		if rest[0] == '[' {
+			end, index, err := parseBindingPathIndex(rest)
+			if err != nil {
+				return nil, fmt.Errorf("path '%s': %w", path, err)
+			}
+
+			p = append(p, bindingPathStep{index: index})
+			rest = rest[end:]
+		} else {
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("path '%s': missing name", path)
+			}
+
+			p = append(p, bindingPathStep{name: rest[:end]})
+			rest = rest[end:]
+		}
+
+		if strings.HasPrefix(rest, ".") {
+			rest = rest[1:]
+			if rest == "" || rest[0] == '[' {
+				return nil, fmt.Errorf("path '%s': missing name", path)
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// parseBindingPathIndex parses the step in brackets at the start of s and
+// returns its length.
+func parseBindingPathIndex(s string) (int, any, error) {
+	end := strings.IndexByte(s, ']')
+
+	if len(s) > 1 && (s[1] == '"' || s[1] == '\'') {
+		// The key may contain ']', so look for the closing quote first.
+		quote := s[1]
+
+		var sb strings.Builder
+		for i := 2; i < len(s); i++ {
+			switch c := s[i]; {
+			case c == '\\' && i+1 < len(s):
+				i++
+				sb.WriteByte(s[i])
+
+			case c == quote:
+				if i+1 >= len(s) || s[i+1] != ']' {
+					return 0, nil, errors.New("missing ]")
+				}
+				return i + 2, sb.String(), nil
+
+			default:
+				sb.WriteByte(c)
+			}
+		}
+
+		return 0, nil, errors.New("unterminated key")
+	}
+
+	if end == -1 {
+		return 0, nil, errors.New("missing ]")
+	}
+
+	i, err := strconv.Atoi(strings.TrimSpace(s[1:end]))
+	if err != nil || i < 0 {
+		return 0, nil, fmt.Errorf("invalid index '%s'", s[1:end])
+	}
+
+	return end + 1, i, nil
+}
+
+func (p bindingPath) String() string {
+	var sb strings.Builder
+
+	for i, step := range p {
+		switch index := step.index.(type) {
+		case int:
+			fmt.Fprintf(&sb, "[%d]", index)
+
+		case string:
+			fmt.Fprintf(&sb, "[%s]", strconv.Quote(index))
+
+		default:
+			if i > 0 {
+				sb.WriteByte('.')
+			}
+			sb.WriteString(step.name)
+		}
+	}
+
+	return sb.String()
+}
+
+// hasPrefix returns if p is prefix or starts with it.
+func (p bindingPath) hasPrefix(prefix bindingPath) bool {
+	if len(prefix) > len(p) {
+		return false
+	}
+
+	for i, step := range prefix {
+		if p[i] != step {
+			return false
+		}
+	}
+
+	return true
+}
+
+// overlaps returns if one of p and q is a prefix of the other, i.e. if changing
+// the value at one of them may change the value at the other.
+func (p bindingPath) overlaps(q bindingPath) bool {
+	return p.hasPrefix(q) || q.hasPrefix(p)
+}
+
+// bindingPathLocation is where a bindingPath leads to in a data source.
+type bindingPathLocation struct {
+	parent reflect.Value // map, struct, slice or array value was found in
+	value  reflect.Value // invalid if missing
+	typ    reflect.Type  // nil if not known, because the path runs into nil
+	set    func(v reflect.Value) error
+
+	// ids identifies the elements found under the steps in brackets. Pointer,
+	// map and slice elements are identified by what they point to, other
+	// elements by their address, if they have one.
+	ids []uintptr
+}
+
+// locate follows p starting at root. Running into a nil pointer, a missing map
+// key or an index out of range is not an error. It results in an invalid
+// value, which can only be set for a missing map key.
+func (p bindingPath) locate(root reflect.Value) (*bindingPathLocation, error) {
+	loc := &bindingPathLocation{value: root}
+	if root.IsValid() {
+		loc.typ = root.Type()
+	}
+
+	for i, step := range p {
+		if !loc.value.IsValid() {
+			return &bindingPathLocation{ids: loc.ids}, nil
+		}
+
+		v, set := loc.value, loc.set
+
+		var ptr reflect.Value
+		for v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return &bindingPathLocation{ids: loc.ids}, nil
+			}
+
+			if v.Kind() == reflect.Pointer {
+				// Whatever the pointer points to can be set directly.
+				ptr, set = v, nil
+			}
+			v = v.Elem()
+		}
+
+		var err error
+		if step.index == nil {
+			err = loc.member(v, ptr, set, step.name)
+		} else {
+			err = loc.element(v, set, step.index)
+			loc.ids = append(loc.ids, elementID(loc.value))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("path '%s', at '%s': %w", p, p[:i+1], err)
+		}
+	}
+
+	return loc, nil
+}
+
+func (loc *bindingPathLocation) member(v, ptr reflect.Value, set func(reflect.Value) error, name string) error {
+	switch v.Kind() {
+	case reflect.Map:
+		return loc.element(v, set, name)
+
+	case reflect.Struct:
+		loc.parent = v
+
+		var fun reflect.Value
+
+		// Try as field first.
+		if sf, ok := v.Type().FieldByName(name); ok && sf.IsExported() {
+			f, err := v.FieldByIndexErr(sf.Index)
+			if err != nil {
+				// Nil embedded pointer
+				loc.value, loc.typ, loc.set = reflect.Value{}, nil, nil
+				return nil
+			}
+
+			switch {
+			case f.Kind() == reflect.Func:
+				fun = f
+
+			case f.Kind() == reflect.Interface && f.Elem().Kind() == reflect.Func:
+				fun = f.Elem()
+
+			default:
+				loc.value, loc.typ = f, sf.Type
+
+				switch {
+				case f.CanSet():
+					loc.set = func(x reflect.Value) error {
+						f.Set(x)
+						return nil
+					}
+
+				case set != nil:
+					// Not addressable, e.g. a struct in a map, so modify a copy
+					// and write that back.
+					loc.set = func(x reflect.Value) error {
+						c := reflect.New(v.Type()).Elem()
+						c.Set(v)
+						c.FieldByIndex(sf.Index).Set(x)
+						return set(c)
+					}
+
+				default:
+					loc.set = nil
+				}
+
+				return nil
+			}
+		} else {
+			// No field, so let's see if we got a method.
+			if ptr.IsValid() {
+				// Try pointer receiver first.
+				fun = ptr.MethodByName(name)
+			}
+
+			if !fun.IsValid() {
+				// No pointer, try directly.
+				fun = v.MethodByName(name)
+			}
+			if !fun.IsValid() {
+				return fmt.Errorf("bad member: '%s'", name)
+			}
+		}
+
+		value, err := callBindingPathFunc(fun, name)
+		if err != nil {
+			return err
+		}
+
+		loc.value, loc.typ, loc.set = value, fun.Type().Out(0), nil
+		return nil
+	}
+
+	return fmt.Errorf("bad member: '%s' of %s", name, v.Type())
+}
+
+// callBindingPathFunc calls fun, which must take no arguments and return a
+// value plus maybe an error.
+func callBindingPathFunc(fun reflect.Value, name string) (reflect.Value, error) {
+	if fun.Type().NumIn() != 0 {
+		return reflect.Value{}, fmt.Errorf("method must not take arguments: %s", name)
+	}
+
+	rvs := fun.Call(nil)
+	switch len(rvs) {
+	case 1:
+		return rvs[0], nil
+
+	case 2:
+		rv2 := rvs[1].Interface()
+		if err, ok := rv2.(error); ok {
+			return reflect.Value{}, err
+		} else if rv2 != nil {
+			return reflect.Value{}, errors.New("second method return value must implement error")
+		}
+
+		return rvs[0], nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("method must return a value plus optionally an error: %s", name)
+}
+
+func (loc *bindingPathLocation) element(v reflect.Value, set func(reflect.Value) error, index any) error {
+	loc.parent = v
+
+	switch v.Kind() {
+	case reflect.Map:
+		key := reflect.ValueOf(index)
+		if !key.Type().ConvertibleTo(v.Type().Key()) {
+			return fmt.Errorf("cannot use %#v as key of %s", index, v.Type())
+		}
+		key = key.Convert(v.Type().Key())
+
+		loc.value, loc.typ = v.MapIndex(key), v.Type().Elem()
+
+		switch {
+		case !v.IsNil():
+			loc.set = func(x reflect.Value) error {
+				v.SetMapIndex(key, x)
+				return nil
+			}
+
+		case set != nil:
+			loc.set = func(x reflect.Value) error {
+				m := reflect.MakeMap(v.Type())
+				m.SetMapIndex(key, x)
+				return set(m)
+			}
+
+		default:
+			loc.set = nil
+		}
+
+		return nil
+
+	case reflect.Array, reflect.Slice:
+		i, ok := index.(int)
+		if !ok {
+			return fmt.Errorf("cannot index %s with %#v", v.Type(), index)
+		}
+
+		if i >= v.Len() {
+			loc.value, loc.typ, loc.set = reflect.Value{}, nil, nil
+			return nil
+		}
+
+		e := v.Index(i)
+		loc.value, loc.typ = e, v.Type().Elem()
+
+		switch {
+		case e.CanSet():
+			loc.set = func(x reflect.Value) error {
+				e.Set(x)
+				return nil
+			}
+
+		case set != nil:
+			loc.set = func(x reflect.Value) error {
+				c := reflect.New(v.Type()).Elem()
+				c.Set(v)
+				c.Index(i).Set(x)
+				return set(c)
+			}
+
+		default:
+			loc.set = nil
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("cannot index %s", v.Type())
+}
+
+func elementID(v reflect.Value) uintptr {
+	for v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Map, reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		return v.Pointer()
+	}
+
+	if v.CanAddr() {
+		return v.UnsafeAddr()
+	}
+
+	return 0
+}
+
+// replacedElement returns the path of the first element on path that has been
+// replaced, according to the element ids before and after.
+func replacedElement(path bindingPath, before, after []uintptr) (bindingPath, bool) {
+	if before == nil {
+		return nil, false
+	}
+
+	var element int
+	for i, step := range path {
+		if step.index == nil {
+			continue
+		}
+
+		if element >= len(before) || element >= len(after) {
+			if len(before) != len(after) {
+				return path[:i+1], true
+			}
+			break
+		}
+
+		if before[element] != after[element] {
+			return path[:i+1], true
+		}
+
+		element++
+	}
+
+	return nil, false
+}
+
+func reflectValueFromPath(root reflect.Value, path string) (parent, value reflect.Value, err error) {
+	p, err := parseBindingPath(path)
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, err
+	}
+
+	loc, err := p.locate(root)
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, err
+	}
+
+	return loc.parent, loc.value, nil
+}
+
+// pathField is a DataField for a location in a data source.
+type pathField struct {
+	loc *bindingPathLocation
+}
+
+func (f *pathField) CanSet() bool {
+	return f.loc.set != nil
+}
+
+func (f *pathField) Get() any {
+	if !f.loc.value.IsValid() {
+		return f.Zero()
+	}
+
+	return f.loc.value.Interface()
+}
+
+func (f *pathField) Set(value any) error {
+	if f.loc.set == nil {
+		return errors.New("field cannot be set")
+	}
+
+	typ := f.loc.typ
+
+	if value == nil {
+		return f.loc.set(reflect.Zero(typ))
+	}
+
+	v := reflect.ValueOf(value)
+
+	if f64, ok := value.(float64); ok && typ.Kind() != reflect.Interface {
+		switch typ.Kind() {
+		case reflect.Float32, reflect.Float64:
+			v = reflect.New(typ).Elem()
+			v.SetFloat(f64)
+
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v = reflect.New(typ).Elem()
+			v.SetInt(int64(f64))
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			v = reflect.New(typ).Elem()
+			v.SetUint(uint64(f64))
+
+		default:
+			return fmt.Errorf("Can't convert float64 to %s.", typ.Name())
+		}
+	} else if !v.Type().AssignableTo(typ) {
+		if !v.Type().ConvertibleTo(typ) {
+			return fmt.Errorf("Can't convert %s to %s.", v.Type(), typ)
+		}
+
+		v = v.Convert(typ)
+	}
+
+	return f.loc.set(v)
+}
+
+func (f *pathField) Zero() any {
+	if f.loc.typ == nil {
+		return nil
+	}
+
+	return reflect.Zero(f.loc.typ).Interface()
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"reflect"
+	"testing"
+)
+
+type bindingPathTestCustomer struct {
+	Name string
+}
+
+type bindingPathTestOrder struct {
+	Customer *bindingPathTestCustomer
+	Qty      int
+}
+
+type bindingPathTestLine struct {
+	Text string
+}
+
+type bindingPathTestDoc struct {
+	Orders []*bindingPathTestOrder
+	Lines  [2]bindingPathTestLine
+	Attrs  map[string]string
+	ByCode map[string]bindingPathTestLine
+	Any    map[string]any
+}
+
+func TestParseBindingPath(t *testing.T) {
+	testCases := []struct {
+		path string
+		want string
+		err  bool
+	}{
+		{path: "Name", want: "Name"},
+		{path: "Orders[2].Customer.Name", want: "Orders[2].Customer.Name"},
+		{path: "Attrs['co]lor']", want: `Attrs["co]lor"]`},
+		{path: `Attrs["a\"b"][0]`, want: `Attrs["a\"b"][0]`},
+		{path: "Orders[x]", err: true},
+		{path: "Orders[1", err: true},
+		{path: "Orders.[1]", err: true},
+		{path: "Orders..Name", err: true},
+	}
+
+	for _, tc := range testCases {
+		p, err := parseBindingPath(tc.path)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: got no error", tc.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tc.path, err)
+			continue
+		}
+
+		if got := p.String(); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.path, got, tc.want)
+		}
+	}
+}
+
+func bindingPathField(t *testing.T, root any, path string) *pathField {
+	t.Helper()
+
+	p, err := parseBindingPath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc, err := p.locate(reflect.ValueOf(root))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &pathField{loc: loc}
+}
+
+func TestPathFieldSet(t *testing.T) {
+	doc := &bindingPathTestDoc{
+		Orders: []*bindingPathTestOrder{{Customer: &bindingPathTestCustomer{Name: "a"}}},
+		Attrs:  map[string]string{"color": "red"},
+		ByCode: map[string]bindingPathTestLine{"x": {Text: "old"}},
+		Any:    map[string]any{"line": bindingPathTestLine{Text: "old"}},
+	}
+
+	testCases := []struct {
+		path  string
+		value any
+		get   func() any
+	}{
+		{"Orders[0].Customer.Name", "b", func() any { return doc.Orders[0].Customer.Name }},
+		{"Orders[0].Qty", 3.0, func() any { return doc.Orders[0].Qty }},
+		{"Lines[1].Text", "second", func() any { return doc.Lines[1].Text }},
+		{`Attrs["color"]`, "blue", func() any { return doc.Attrs["color"] }},
+		{`Attrs["size"]`, "XL", func() any { return doc.Attrs["size"] }},
+		{"Attrs.shape", "round", func() any { return doc.Attrs["shape"] }},
+		{`ByCode["x"].Text`, "new", func() any { return doc.ByCode["x"].Text }},
+		{`Any["line"].Text`, "new", func() any { return doc.Any["line"].(bindingPathTestLine).Text }},
+	}
+
+	for _, tc := range testCases {
+		f := bindingPathField(t, doc, tc.path)
+
+		if !f.CanSet() {
+			t.Errorf("%s: cannot set", tc.path)
+			continue
+		}
+		if err := f.Set(tc.value); err != nil {
+			t.Errorf("%s: %v", tc.path, err)
+			continue
+		}
+
+		want := tc.value
+		if f64, ok := want.(float64); ok {
+			want = int(f64)
+		}
+		if got := tc.get(); got != want {
+			t.Errorf("%s: got %v, want %v", tc.path, got, want)
+		}
+	}
+
+	// Missing elements read as zero and cannot be set.
+	f := bindingPathField(t, doc, "Orders[5].Customer.Name")
+	if f.CanSet() || f.Get() != nil {
+		t.Errorf("out of range: got settable %t, value %v", f.CanSet(), f.Get())
+	}
+
+	if f := bindingPathField(t, doc, `Attrs["none"]`); f.Get() != "" {
+		t.Errorf("missing key: got %v, want empty string", f.Get())
+	}
+}
+
+func TestReplacedElement(t *testing.T) {
+	doc := &bindingPathTestDoc{
+		Orders: []*bindingPathTestOrder{
+			{Customer: &bindingPathTestCustomer{Name: "a"}},
+			{Customer: &bindingPathTestCustomer{Name: "b"}},
+		},
+	}
+
+	path, _ := parseBindingPath("Orders[1].Customer.Name")
+
+	locate := func() []uintptr {
+		loc, err := path.locate(reflect.ValueOf(doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return loc.ids
+	}
+
+	before := locate()
+
+	doc.Orders[1].Customer.Name = "changed"
+	if _, ok := replacedElement(path, before, locate()); ok {
+		t.Errorf("changing a field replaced the element")
+	}
+
+	doc.Orders[0], doc.Orders[1] = doc.Orders[1], doc.Orders[0]
+	prefix, ok := replacedElement(path, before, locate())
+	if !ok || prefix.String() != "Orders[1]" {
+		t.Errorf("after swap: got %v, %t, want Orders[1]", prefix, ok)
+	}
+
+	doc.Orders = doc.Orders[:1]
+	if _, ok := replacedElement(path, before, locate()); !ok {
+		t.Errorf("removing the element did not replace it")
+	}
+}
+
+func TestBindingPathOverlaps(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want bool
+	}{
+		{"Orders[1].Customer.Name", "Orders[1].Customer.Name", true},
+		{"Orders[1].Customer.Name", "Orders", true},
+		{"Orders", "Orders[1].Qty", true},
+		{"Orders[1].Qty", "Orders[0].Qty", false},
+		{"Orders[1].Qty", "Orders[1].Customer", false},
+		{"Name", "NameSuffix", false},
+		{"Name", "", true},
+	}
+
+	for _, tc := range testCases {
+		a, _ := parseBindingPath(tc.a)
+		b, _ := parseBindingPath(tc.b)
+
+		if got := a.overlaps(b); got != tc.want {
+			t.Errorf("%q overlaps %q: got %t, want %t", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
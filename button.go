@@ -235,12 +235,14 @@ func (b *Button) idealSize() Size {
 
 func (b *Button) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	return &buttonLayoutItem{
-		idealSize: b.idealSize(),
+		textBaseline: b.newTextBaseline(0, AlignCenter),
+		idealSize:    b.idealSize(),
 	}
 }
 
 type buttonLayoutItem struct {
 	LayoutItemBase
+	textBaseline
 	idealSize Size // in native pixels
 }
 
@@ -63,6 +63,7 @@ type Canvas struct {
 	measureTextMetafile *Metafile
 	dpi                 int
 	doNotDisposeDC      bool // When true, the Canvas does not own its hdc and should not free it
+	rightToLeftReading  bool
 }
 
 func NewCanvasFromImage(image Image) (*Canvas, error) {
@@ -109,7 +110,7 @@ func newCanvasFromWindow(window Window) (*Canvas, error) {
 		return nil, newError("GetDC failed")
 	}
 
-	return (&Canvas{hdc: hdc, window: window}).init()
+	return (&Canvas{hdc: hdc, window: window, rightToLeftReading: windowRightToLeft(window)}).init()
 }
 
 func newCanvasFromHDC(hdc win.HDC) (*Canvas, error) {
@@ -571,6 +572,21 @@ func (c *Canvas) GradientFillRectanglePixels(color1, color2 Color, orientation O
 	return nil
 }
 
+// RightToLeftReading returns whether the Canvas draws text with right to left
+// reading order, as if TextRTLReading was passed to DrawTextPixels.
+//
+// For the Canvas of a Window this is initialized from its RightToLeft method,
+// see RightToLefter.
+func (c *Canvas) RightToLeftReading() bool {
+	return c.rightToLeftReading
+}
+
+// SetRightToLeftReading sets whether the Canvas draws text with right to left
+// reading order.
+func (c *Canvas) SetRightToLeftReading(value bool) {
+	c.rightToLeftReading = value
+}
+
 // DrawText draws text at given location in 1/96" units.
 //
 // Deprecated: Newer applications should use DrawTextPixels.
@@ -580,6 +596,10 @@ func (c *Canvas) DrawText(text string, font *Font, color Color, bounds Rectangle
 
 // DrawTextPixels draws text at given location in native pixels.
 func (c *Canvas) DrawTextPixels(text string, font *Font, color Color, bounds Rectangle, format DrawTextFormat) error {
+	if c.rightToLeftReading {
+		format |= TextRTLReading
+	}
+
 	return c.withFontAndTextColor(font, color, func() error {
 		rect := bounds.toRECT()
 		ret := win.DrawTextEx(
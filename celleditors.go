@@ -0,0 +1,244 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// CellEditor creates the widgets a TableView edits the cells of a column with.
+type CellEditor interface {
+	// CreateEditor creates a widget in parent, that shows value for editing.
+	CreateEditor(parent Container, value any) (Widget, error)
+
+	// EditorValue returns the value of widget, that was created by
+	// CreateEditor. It is passed to the Validator of the column and then to
+	// EditableTableModel.SetValue.
+	EditorValue(widget Widget) (any, error)
+}
+
+// LineEditCellEditor edits cells with a LineEdit. The value is a string.
+type LineEditCellEditor struct {
+	MaxLength int
+}
+
+func (e *LineEditCellEditor) CreateEditor(parent Container, value any) (Widget, error) {
+	le, err := NewLineEdit(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.MaxLength > 0 {
+		le.SetMaxLength(e.MaxLength)
+	}
+
+	if value != nil {
+		if err := le.SetText(fmt.Sprint(value)); err != nil {
+			le.Dispose()
+			return nil, err
+		}
+	}
+
+	le.SetTextSelection(0, -1)
+
+	return le, nil
+}
+
+func (*LineEditCellEditor) EditorValue(widget Widget) (any, error) {
+	return widget.(*LineEdit).Text(), nil
+}
+
+// NumberEditCellEditor edits cells with a NumberEdit. The value is a float64.
+type NumberEditCellEditor struct {
+	Decimals  int
+	MinValue  float64
+	MaxValue  float64
+	Increment float64
+}
+
+func (e *NumberEditCellEditor) CreateEditor(parent Container, value any) (Widget, error) {
+	ne, err := NewNumberEdit(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			ne.Dispose()
+		}
+	}()
+
+	if err := ne.SetDecimals(e.Decimals); err != nil {
+		return nil, err
+	}
+
+	if e.MinValue != 0 || e.MaxValue != 0 {
+		if err := ne.SetRange(e.MinValue, e.MaxValue); err != nil {
+			return nil, err
+		}
+	}
+
+	if e.Increment > 0 {
+		if err := ne.SetIncrement(e.Increment); err != nil {
+			return nil, err
+		}
+	}
+
+	if f, ok := float64From(value); ok {
+		if err := ne.SetValue(f); err != nil {
+			return nil, err
+		}
+	}
+
+	succeeded = true
+
+	return ne, nil
+}
+
+func (*NumberEditCellEditor) EditorValue(widget Widget) (any, error) {
+	return widget.(*NumberEdit).Value(), nil
+}
+
+// float64From converts value of any integer or floating point type to a float64.
+func float64From(value any) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+
+	return 0, false
+}
+
+// ComboBoxCellEditor edits cells with a ComboBox.
+//
+// Model, BindingMember and DisplayMember are set on the ComboBox. If Editable
+// is true, the value is the text of the ComboBox, otherwise it is the binding
+// value of the selected item, like with data binding.
+type ComboBoxCellEditor struct {
+	Model         any
+	BindingMember string
+	DisplayMember string
+	Editable      bool
+}
+
+func (e *ComboBoxCellEditor) CreateEditor(parent Container, value any) (Widget, error) {
+	var cb *ComboBox
+	var err error
+	if e.Editable {
+		cb, err = NewComboBox(parent)
+	} else {
+		cb, err = NewDropDownBox(parent)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			cb.Dispose()
+		}
+	}()
+
+	if err := cb.SetBindingMember(e.BindingMember); err != nil {
+		return nil, err
+	}
+	if err := cb.SetDisplayMember(e.DisplayMember); err != nil {
+		return nil, err
+	}
+	if err := cb.SetModel(e.Model); err != nil {
+		return nil, err
+	}
+
+	if e.Editable {
+		if value != nil {
+			if err := cb.SetText(fmt.Sprint(value)); err != nil {
+				return nil, err
+			}
+		}
+	} else if err := cb.Property("Value").Set(value); err != nil {
+		return nil, err
+	}
+
+	succeeded = true
+
+	return cb, nil
+}
+
+func (*ComboBoxCellEditor) EditorValue(widget Widget) (any, error) {
+	return widget.(*ComboBox).Property("Value").Get(), nil
+}
+
+// DateEditCellEditor edits cells with a DateEdit. The value is a time.Time.
+type DateEditCellEditor struct {
+	Format string
+}
+
+func (e *DateEditCellEditor) CreateEditor(parent Container, value any) (Widget, error) {
+	de, err := NewDateEdit(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			de.Dispose()
+		}
+	}()
+
+	if e.Format != "" {
+		if err := de.SetFormat(e.Format); err != nil {
+			return nil, err
+		}
+	}
+
+	if t, ok := value.(time.Time); ok && !t.IsZero() {
+		if err := de.SetDate(t); err != nil {
+			return nil, err
+		}
+	}
+
+	succeeded = true
+
+	return de, nil
+}
+
+func (*DateEditCellEditor) EditorValue(widget Widget) (any, error) {
+	return widget.(*DateEdit).Date(), nil
+}
+
+// CellEditorFuncs is a CellEditor, that calls a func to create custom widgets
+// and another one to get their values.
+type CellEditorFuncs struct {
+	Create func(parent Container, value any) (Widget, error)
+	Value  func(widget Widget) (any, error)
+}
+
+func (e *CellEditorFuncs) CreateEditor(parent Container, value any) (Widget, error) {
+	return e.Create(parent, value)
+}
+
+func (e *CellEditorFuncs) EditorValue(widget Widget) (any, error) {
+	return e.Value(widget)
+}
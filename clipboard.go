@@ -8,10 +8,17 @@
 package walk
 
 import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"maps"
+	"slices"
 	"syscall"
 	"unsafe"
 
 	"github.com/wuc656/win"
+	"golang.org/x/sys/windows"
 )
 
 const clipboardWindowClass = `\o/ Walk_Clipboard_Class \o/`
@@ -122,36 +129,464 @@ func (c *ClipboardService) Text() (text string, err error) {
 // SetText sets the current text data of the clipboard.
 func (c *ClipboardService) SetText(s string) error {
 	return c.withOpenClipboard(func() error {
-		utf16, err := syscall.UTF16FromString(s)
+		return setClipboardText(s)
+	})
+}
+
+// ClipboardFormat identifies a format of clipboard data. Besides the predefined
+// formats, like win.CF_UNICODETEXT, there are the ones applications register
+// by name with RegisterClipboardFormat.
+type ClipboardFormat uint32
+
+const (
+	htmlClipboardFormatName = "HTML Format"
+	rtfClipboardFormatName  = "Rich Text Format"
+	pngClipboardFormatName  = "PNG"
+)
+
+var (
+	registerClipboardFormat = modUser32.NewProc("RegisterClipboardFormatW")
+	globalSize              = windows.NewLazySystemDLL("kernel32.dll").NewProc("GlobalSize")
+)
+
+// RegisterClipboardFormat returns the clipboard format registered under name.
+// All applications that register the same name get the same format.
+func RegisterClipboardFormat(name string) (ClipboardFormat, error) {
+	name16, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return 0, err
+	}
+
+	format, _, err := registerClipboardFormat.Call(uintptr(unsafe.Pointer(name16)))
+	if format == 0 {
+		return 0, newError(fmt.Sprintf("RegisterClipboardFormat: %v", err))
+	}
+
+	return ClipboardFormat(format), nil
+}
+
+// ClipboardContent is data in several formats, that ClipboardService.SetContent
+// puts on the clipboard at once, so other applications can paste the format
+// they understand best. Empty fields are left out.
+type ClipboardContent struct {
+	// Text is put on the clipboard as CF_UNICODETEXT.
+	Text string
+
+	// HTML is a fragment of HTML, like "<b>bold</b>", that is put on the
+	// clipboard in the CF_HTML format.
+	HTML string
+
+	// RTF is a Rich Text Format document.
+	RTF string
+
+	// Image is put on the clipboard as PNG, which keeps the alpha channel, and
+	// as CF_DIB.
+	Image *Bitmap
+
+	// Files are paths put on the clipboard as CF_HDROP, like Explorer does when
+	// copying files.
+	Files []string
+
+	// Data maps formats, e.g. ones registered with RegisterClipboardFormat, to
+	// their data.
+	Data map[ClipboardFormat][]byte
+}
+
+// clipboardItem is data in a clipboard format.
+type clipboardItem struct {
+	format uint32
+	data   []byte
+}
+
+// items encodes the content in all its formats.
+func (cc *ClipboardContent) items() ([]clipboardItem, error) {
+	var items []clipboardItem
+
+	addRegistered := func(name string, data []byte) error {
+		format, err := RegisterClipboardFormat(name)
 		if err != nil {
 			return err
 		}
 
-		hMem := win.GlobalAlloc(win.GMEM_MOVEABLE, uintptr(len(utf16)*2))
-		if hMem == 0 {
-			return lastError("GlobalAlloc")
+		items = append(items, clipboardItem{uint32(format), data})
+
+		return nil
+	}
+
+	if cc.Text != "" {
+		utf16, err := syscall.UTF16FromString(cc.Text)
+		if err != nil {
+			return nil, err
 		}
 
-		p := win.GlobalLock(hMem)
-		if p == nil {
-			return lastError("GlobalLock()")
+		items = append(items, clipboardItem{win.CF_UNICODETEXT, unsafe.Slice((*byte)(unsafe.Pointer(&utf16[0])), len(utf16)*2)})
+	}
+
+	if cc.HTML != "" {
+		if err := addRegistered(htmlClipboardFormatName, append(htmlClipboardData(cc.HTML), 0)); err != nil {
+			return nil, err
 		}
+	}
 
-		win.MoveMemory(p, unsafe.Pointer(&utf16[0]), uintptr(len(utf16)*2))
+	if cc.RTF != "" {
+		if err := addRegistered(rtfClipboardFormatName, append([]byte(cc.RTF), 0)); err != nil {
+			return nil, err
+		}
+	}
 
-		win.GlobalUnlock(hMem)
+	if cc.Image != nil {
+		im, err := cc.Image.ToImage()
+		if err != nil {
+			return nil, err
+		}
 
-		if win.SetClipboardData(win.CF_UNICODETEXT, win.HANDLE(hMem)) == 0 {
-			// We need to free hMem.
-			defer win.GlobalFree(hMem)
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, im); err != nil {
+			return nil, err
+		}
 
-			return lastError("SetClipboardData")
+		if err := addRegistered(pngClipboardFormatName, buf.Bytes()); err != nil {
+			return nil, err
 		}
 
-		// The system now owns the memory referred to by hMem.
+		items = append(items, clipboardItem{win.CF_DIB, dibFromImage(im)})
+	}
+
+	if len(cc.Files) > 0 {
+		items = append(items, clipboardItem{win.CF_HDROP, dropFilesData(cc.Files)})
+	}
+
+	for _, format := range slices.Sorted(maps.Keys(cc.Data)) {
+		items = append(items, clipboardItem{uint32(format), cc.Data[format]})
+	}
+
+	return items, nil
+}
+
+// SetContent replaces the contents of the clipboard with content, opening the
+// clipboard only once for all its formats.
+func (c *ClipboardService) SetContent(content ClipboardContent) error {
+	items, err := content.items()
+	if err != nil {
+		return err
+	}
+
+	return c.withOpenClipboard(func() error {
+		if !win.EmptyClipboard() {
+			return lastError("EmptyClipboard")
+		}
+
+		for _, item := range items {
+			if err := setClipboardData(item.format, item.data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ContainsFormat returns whether the clipboard currently contains data in
+// format.
+func (c *ClipboardService) ContainsFormat(format ClipboardFormat) (bool, error) {
+	return c.containsAny(uint32(format))
+}
+
+// Data returns the current data of the clipboard in format.
+func (c *ClipboardService) Data(format ClipboardFormat) (data []byte, err error) {
+	err = c.withOpenClipboard(func() error {
+		data, err = openClipboard{}.data(uint32(format))
+
+		return err
+	})
+
+	return
+}
+
+// SetData replaces the contents of the clipboard with data in format.
+func (c *ClipboardService) SetData(format ClipboardFormat, data []byte) error {
+	return c.SetContent(ClipboardContent{Data: map[ClipboardFormat][]byte{format: data}})
+}
+
+// ContainsHTML returns whether the clipboard currently contains HTML in the
+// CF_HTML format.
+func (c *ClipboardService) ContainsHTML() (bool, error) {
+	return c.containsRegistered(htmlClipboardFormatName)
+}
+
+// HTML returns the HTML fragment the clipboard currently contains.
+func (c *ClipboardService) HTML() (html string, err error) {
+	err = c.withOpenClipboard(func() error {
+		html, err = readHTML(openClipboard{})
+
+		return err
+	})
+
+	return
+}
+
+// SetHTML replaces the contents of the clipboard with the HTML fragment html.
+// Use SetContent to provide a plain text version too.
+func (c *ClipboardService) SetHTML(html string) error {
+	return c.SetContent(ClipboardContent{HTML: html})
+}
+
+// ContainsRTF returns whether the clipboard currently contains a Rich Text
+// Format document.
+func (c *ClipboardService) ContainsRTF() (bool, error) {
+	return c.containsRegistered(rtfClipboardFormatName)
+}
+
+// RTF returns the Rich Text Format document the clipboard currently contains.
+func (c *ClipboardService) RTF() (rtf string, err error) {
+	err = c.withOpenClipboard(func() error {
+		rtf, err = readRTF(openClipboard{})
+
+		return err
+	})
+
+	return
+}
+
+// SetRTF replaces the contents of the clipboard with the Rich Text Format
+// document rtf.
+func (c *ClipboardService) SetRTF(rtf string) error {
+	return c.SetContent(ClipboardContent{RTF: rtf})
+}
+
+// ContainsImage returns whether the clipboard currently contains an image.
+func (c *ClipboardService) ContainsImage() (available bool, err error) {
+	err = c.withOpenClipboard(func() error {
+		available = containsImage(openClipboard{})
+
+		return nil
+	})
+
+	return
+}
+
+// Image returns the image the clipboard currently contains as a Bitmap at
+// 96dpi. PNG data is preferred over CF_DIB, because it keeps the alpha channel.
+func (c *ClipboardService) Image() (bmp *Bitmap, err error) {
+	err = c.withOpenClipboard(func() error {
+		bmp, err = readImage(openClipboard{})
+
+		return err
+	})
+
+	return
+}
+
+// SetImage replaces the contents of the clipboard with the image bmp.
+func (c *ClipboardService) SetImage(bmp *Bitmap) error {
+	return c.SetContent(ClipboardContent{Image: bmp})
+}
+
+// ContainsFiles returns whether the clipboard currently contains a list of
+// files, like after copying files in Explorer.
+func (c *ClipboardService) ContainsFiles() (bool, error) {
+	return c.containsAny(win.CF_HDROP)
+}
+
+// Files returns the paths of the files the clipboard currently contains.
+func (c *ClipboardService) Files() (files []string, err error) {
+	err = c.withOpenClipboard(func() error {
+		files, err = readFiles(openClipboard{})
+
+		return err
+	})
+
+	return
+}
+
+// SetFiles replaces the contents of the clipboard with the list of files
+// paths.
+func (c *ClipboardService) SetFiles(paths []string) error {
+	return c.SetContent(ClipboardContent{Files: paths})
+}
+
+func (c *ClipboardService) containsAny(formats ...uint32) (available bool, err error) {
+	err = c.withOpenClipboard(func() error {
+		available = slices.ContainsFunc(formats, win.IsClipboardFormatAvailable)
 
 		return nil
 	})
+
+	return
+}
+
+func (c *ClipboardService) containsRegistered(name string) (bool, error) {
+	format, err := RegisterClipboardFormat(name)
+	if err != nil {
+		return false, err
+	}
+
+	return c.ContainsFormat(format)
+}
+
+// dataReader reads data in clipboard formats, from the open clipboard or from
+// a drag and drop operation.
+type dataReader interface {
+	contains(format uint32) bool
+	data(format uint32) ([]byte, error)
+}
+
+// openClipboard is the dataReader of the clipboard, while it is open.
+type openClipboard struct{}
+
+func (openClipboard) contains(format uint32) bool {
+	return win.IsClipboardFormatAvailable(format)
+}
+
+func (openClipboard) data(format uint32) ([]byte, error) {
+	hMem := win.HGLOBAL(win.GetClipboardData(format))
+	if hMem == 0 {
+		return nil, lastError("GetClipboardData")
+	}
+
+	return globalData(hMem)
+}
+
+func containsRegistered(r dataReader, name string) bool {
+	format, err := RegisterClipboardFormat(name)
+
+	return err == nil && r.contains(uint32(format))
+}
+
+func readRegistered(r dataReader, name string) ([]byte, error) {
+	format, err := RegisterClipboardFormat(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.data(uint32(format))
+}
+
+func readHTML(r dataReader) (string, error) {
+	data, err := readRegistered(r, htmlClipboardFormatName)
+	if err != nil {
+		return "", err
+	}
+
+	return htmlFragment(data)
+}
+
+func readRTF(r dataReader) (string, error) {
+	data, err := readRegistered(r, rtfClipboardFormatName)
+	if err != nil {
+		return "", err
+	}
+
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		data = data[:i]
+	}
+
+	return string(data), nil
+}
+
+func containsImage(r dataReader) bool {
+	// The system provides CF_DIB for CF_BITMAP and CF_DIBV5 too.
+	return containsRegistered(r, pngClipboardFormatName) || r.contains(win.CF_DIB)
+}
+
+func readImage(r dataReader) (*Bitmap, error) {
+	var im image.Image
+
+	if containsRegistered(r, pngClipboardFormatName) {
+		data, err := readRegistered(r, pngClipboardFormatName)
+		if err != nil {
+			return nil, err
+		}
+
+		if im, err = png.Decode(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+	} else {
+		data, err := r.data(win.CF_DIB)
+		if err != nil {
+			return nil, err
+		}
+
+		if im, err = imageFromDIB(data); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewBitmapFromImageForDPI(im, 96)
+}
+
+func readFiles(r dataReader) ([]string, error) {
+	data, err := r.data(win.CF_HDROP)
+	if err != nil {
+		return nil, err
+	}
+
+	return filesFromDropFilesData(data)
+}
+
+// globalData returns a copy of the data of hMem.
+func globalData(hMem win.HGLOBAL) ([]byte, error) {
+	p := win.GlobalLock(hMem)
+	if p == nil {
+		return nil, lastError("GlobalLock()")
+	}
+	defer win.GlobalUnlock(hMem)
+
+	size, _, _ := globalSize.Call(uintptr(hMem))
+
+	return bytes.Clone(unsafe.Slice((*byte)(p), size)), nil
+}
+
+// globalAllocData returns a new HGLOBAL with a copy of data, that the caller
+// must free or pass on.
+func globalAllocData(data []byte) (win.HGLOBAL, error) {
+	hMem := win.GlobalAlloc(win.GMEM_MOVEABLE, uintptr(len(data)))
+	if hMem == 0 {
+		return 0, lastError("GlobalAlloc")
+	}
+
+	p := win.GlobalLock(hMem)
+	if p == nil {
+		win.GlobalFree(hMem)
+
+		return 0, lastError("GlobalLock()")
+	}
+
+	if len(data) > 0 {
+		win.MoveMemory(p, unsafe.Pointer(&data[0]), uintptr(len(data)))
+	}
+
+	win.GlobalUnlock(hMem)
+
+	return hMem, nil
+}
+
+func setClipboardText(s string) error {
+	utf16, err := syscall.UTF16FromString(s)
+	if err != nil {
+		return err
+	}
+
+	return setClipboardData(win.CF_UNICODETEXT, unsafe.Slice((*byte)(unsafe.Pointer(&utf16[0])), len(utf16)*2))
+}
+
+// setClipboardData puts a copy of data on the open clipboard in format.
+func setClipboardData(format uint32, data []byte) error {
+	hMem, err := globalAllocData(data)
+	if err != nil {
+		return err
+	}
+
+	if win.SetClipboardData(format, win.HANDLE(hMem)) == 0 {
+		// We need to free hMem.
+		defer win.GlobalFree(hMem)
+
+		return lastError("SetClipboardData")
+	}
+
+	// The system now owns the memory referred to by hMem.
+
+	return nil
 }
 
 func (c *ClipboardService) withOpenClipboard(f func() error) error {
@@ -0,0 +1,311 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	cfHTMLHeader = "Version:0.9\r\nStartHTML:%010d\r\nEndHTML:%010d\r\nStartFragment:%010d\r\nEndFragment:%010d\r\n"
+	cfHTMLPrefix = "<html>\r\n<body>\r\n<!--StartFragment-->"
+	cfHTMLSuffix = "<!--EndFragment-->\r\n</body>\r\n</html>"
+)
+
+// htmlClipboardData returns the HTML fragment in the CF_HTML clipboard format.
+// Its header gives the byte offsets of the document and of fragment in it.
+func htmlClipboardData(fragment string) []byte {
+	startHTML := len(fmt.Sprintf(cfHTMLHeader, 0, 0, 0, 0))
+	startFragment := startHTML + len(cfHTMLPrefix)
+	endFragment := startFragment + len(fragment)
+	endHTML := endFragment + len(cfHTMLSuffix)
+
+	header := fmt.Sprintf(cfHTMLHeader, startHTML, endHTML, startFragment, endFragment)
+
+	return []byte(header + cfHTMLPrefix + fragment + cfHTMLSuffix)
+}
+
+var cfHTMLOffsetRE = regexp.MustCompile(`(?m)^(StartFragment|EndFragment):(-?\d+)\r?$`)
+
+// htmlFragment returns the fragment of the CF_HTML clipboard data data, as
+// written by htmlClipboardData or any other application.
+func htmlFragment(data []byte) (string, error) {
+	// The header ends where the document starts.
+	header := data
+	if i := bytes.IndexByte(data, '<'); i >= 0 {
+		header = data[:i]
+	}
+
+	start, end := -1, -1
+	for _, m := range cfHTMLOffsetRE.FindAllSubmatch(header, -1) {
+		n, err := strconv.Atoi(string(m[2]))
+		if err != nil {
+			return "", err
+		}
+
+		if string(m[1]) == "StartFragment" {
+			start = n
+		} else {
+			end = n
+		}
+	}
+
+	// Some writers include the terminating NUL in the offsets or leave it out
+	// of the data, so only insist on a sane range.
+	if end > len(data) {
+		end = len(data)
+	}
+	if start < 0 || end < start {
+		return "", errors.New("invalid CF_HTML data")
+	}
+
+	return string(data[start:end]), nil
+}
+
+const (
+	dibHeaderSize  = 40 // sizeof(BITMAPINFOHEADER)
+	dibBIRGB       = 0
+	dibBIBitfields = 3
+)
+
+// dibFromImage returns im as a device independent bitmap like the CF_DIB
+// clipboard format wants it: a BITMAPINFOHEADER followed by 32 bit BGRA pixels,
+// bottom row first. Colors are not premultiplied with alpha.
+func dibFromImage(im image.Image) []byte {
+	bounds := im.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	data := make([]byte, dibHeaderSize+width*height*4)
+
+	le := binary.LittleEndian
+	le.PutUint32(data[0:], dibHeaderSize)
+	le.PutUint32(data[4:], uint32(int32(width)))
+	le.PutUint32(data[8:], uint32(int32(height)))
+	le.PutUint16(data[12:], 1)  // biPlanes
+	le.PutUint16(data[14:], 32) // biBitCount
+	le.PutUint32(data[16:], dibBIRGB)
+	le.PutUint32(data[20:], uint32(width*height*4))
+
+	p := data[dibHeaderSize:]
+	for y := bounds.Max.Y - 1; y >= bounds.Min.Y; y-- {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(im.At(x, y)).(color.NRGBA)
+			p[0], p[1], p[2], p[3] = c.B, c.G, c.R, c.A
+			p = p[4:]
+		}
+	}
+
+	return data
+}
+
+// imageFromDIB returns the image of the device independent bitmap data, as
+// found in the CF_DIB and CF_DIBV5 clipboard formats. Uncompressed 24 and 32 bit
+// bitmaps are supported. 32 bit bitmaps without any alpha are taken as opaque.
+func imageFromDIB(data []byte) (*image.NRGBA, error) {
+	if len(data) < dibHeaderSize {
+		return nil, errors.New("invalid DIB: header too short")
+	}
+
+	le := binary.LittleEndian
+	headerSize := int(le.Uint32(data[0:]))
+	width := int(int32(le.Uint32(data[4:])))
+	height := int(int32(le.Uint32(data[8:])))
+	bitCount := int(le.Uint16(data[14:]))
+	compression := le.Uint32(data[16:])
+
+	if headerSize < dibHeaderSize || headerSize > len(data) {
+		return nil, errors.New("invalid DIB: bad header size")
+	}
+
+	bottomUp := height > 0
+	if !bottomUp {
+		height = -height
+	}
+	if width <= 0 || height == 0 {
+		return nil, errors.New("invalid DIB: bad size")
+	}
+
+	// The channels are where BI_RGB puts them, unless masks say otherwise.
+	masks := [4]uint32{0x00ff0000, 0x0000ff00, 0x000000ff, 0xff000000}
+	offset := headerSize
+
+	switch {
+	case compression == dibBIRGB && (bitCount == 24 || bitCount == 32):
+
+	case compression == dibBIBitfields && bitCount == 32:
+		if headerSize == dibHeaderSize {
+			// The masks follow the header, there is none for alpha.
+			offset += 12
+			if offset > len(data) {
+				return nil, errors.New("invalid DIB: masks missing")
+			}
+			masks[3] = 0
+		} else if headerSize < dibHeaderSize+16 {
+			return nil, errors.New("invalid DIB: masks missing")
+		}
+		for i := range 3 {
+			masks[i] = le.Uint32(data[dibHeaderSize+4*i:])
+		}
+		if headerSize > dibHeaderSize {
+			masks[3] = le.Uint32(data[dibHeaderSize+12:])
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported DIB: %d bits per pixel, compression %d", bitCount, compression)
+	}
+
+	bytesPerPixel := bitCount / 8
+	// Computed in uint64 and compared by dividing, so bogus sizes can not
+	// overflow.
+	stride64 := (uint64(width)*uint64(bitCount) + 31) / 32 * 4
+	if uint64(height) > uint64(len(data)-offset)/stride64 {
+		return nil, errors.New("invalid DIB: pixels missing")
+	}
+	stride := int(stride64)
+
+	channel := func(v, mask uint32) uint8 {
+		if mask == 0 {
+			return 0
+		}
+
+		v &= mask
+		for mask&1 == 0 {
+			mask >>= 1
+			v >>= 1
+		}
+
+		return uint8(v * 255 / mask)
+	}
+
+	im := image.NewNRGBA(image.Rect(0, 0, width, height))
+	hasAlpha := false
+
+	for row := range height {
+		y := row
+		if bottomUp {
+			y = height - 1 - row
+		}
+
+		src := data[offset+row*stride:]
+		dst := im.Pix[y*im.Stride:]
+
+		for x := range width {
+			var v uint32
+			if bytesPerPixel == 4 {
+				v = le.Uint32(src[x*4:])
+			} else {
+				v = uint32(src[x*3]) | uint32(src[x*3+1])<<8 | uint32(src[x*3+2])<<16
+			}
+
+			a := uint8(0xff)
+			if bytesPerPixel == 4 {
+				a = channel(v, masks[3])
+				if a != 0 {
+					hasAlpha = true
+				}
+			}
+
+			dst[x*4+0] = channel(v, masks[0])
+			dst[x*4+1] = channel(v, masks[1])
+			dst[x*4+2] = channel(v, masks[2])
+			dst[x*4+3] = a
+		}
+	}
+
+	if bytesPerPixel == 4 && !hasAlpha {
+		for i := 3; i < len(im.Pix); i += 4 {
+			im.Pix[i] = 0xff
+		}
+	}
+
+	return im, nil
+}
+
+const dropFilesSize = 20 // sizeof(DROPFILES)
+
+// dropFilesData returns paths in the CF_HDROP clipboard format: a DROPFILES
+// structure followed by the paths in UTF-16, each NUL terminated, and another
+// NUL.
+func dropFilesData(paths []string) []byte {
+	var chars []uint16
+	for _, path := range paths {
+		chars = append(chars, utf16.Encode([]rune(path))...)
+		chars = append(chars, 0)
+	}
+	chars = append(chars, 0)
+
+	data := make([]byte, dropFilesSize+len(chars)*2)
+
+	le := binary.LittleEndian
+	le.PutUint32(data[0:], dropFilesSize) // pFiles
+	le.PutUint32(data[16:], 1)            // fWide
+
+	for i, c := range chars {
+		le.PutUint16(data[dropFilesSize+i*2:], c)
+	}
+
+	return data
+}
+
+// filesFromDropFilesData returns the paths in the CF_HDROP clipboard data data.
+func filesFromDropFilesData(data []byte) ([]string, error) {
+	if len(data) < dropFilesSize {
+		return nil, errors.New("invalid DROPFILES data")
+	}
+
+	le := binary.LittleEndian
+	offset := int(le.Uint32(data[0:]))
+	wide := le.Uint32(data[16:]) != 0
+
+	if offset < dropFilesSize || offset > len(data) {
+		return nil, errors.New("invalid DROPFILES data")
+	}
+
+	var paths []string
+	if wide {
+		for _, path := range strings.Split(textFromUTF16Data(data[offset:], false), "\x00") {
+			if path == "" {
+				break
+			}
+			paths = append(paths, path)
+		}
+	} else {
+		for _, path := range bytes.Split(data[offset:], []byte{0}) {
+			if len(path) == 0 {
+				break
+			}
+			paths = append(paths, string(path))
+		}
+	}
+
+	return paths, nil
+}
+
+// textFromUTF16Data returns the UTF-16 text in data, up to the first NUL if
+// nulTerminated.
+func textFromUTF16Data(data []byte, nulTerminated bool) string {
+	chars := make([]uint16, len(data)/2)
+	for i := range chars {
+		chars[i] = binary.LittleEndian.Uint16(data[i*2:])
+	}
+
+	if nulTerminated {
+		if i := slices.Index(chars, 0); i >= 0 {
+			chars = chars[:i]
+		}
+	}
+
+	return string(utf16.Decode(chars))
+}
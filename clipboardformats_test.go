@@ -0,0 +1,202 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func TestHTMLClipboardData(t *testing.T) {
+	fragment := "<table><tr><td>Grüße &amp; €</td></tr></table>"
+
+	data := string(htmlClipboardData(fragment))
+
+	offset := func(name string) int {
+		m := regexp.MustCompile(`(?m)^` + name + `:(\d{10})\r$`).FindStringSubmatch(data)
+		if m == nil {
+			t.Fatalf("no %s in header:\n%s", name, data)
+		}
+
+		n, _ := strconv.Atoi(m[1])
+		return n
+	}
+
+	startHTML, endHTML := offset("StartHTML"), offset("EndHTML")
+	startFragment, endFragment := offset("StartFragment"), offset("EndFragment")
+
+	if !strings.HasPrefix(data, "Version:0.9\r\n") {
+		t.Errorf("data does not start with the version")
+	}
+	if got := data[startHTML:endHTML]; !strings.HasPrefix(got, "<html>") || !strings.HasSuffix(got, "</html>") {
+		t.Errorf("data[StartHTML:EndHTML] = %q, want an HTML document", got)
+	}
+	if endHTML != len(data) {
+		t.Errorf("EndHTML = %d, want %d", endHTML, len(data))
+	}
+	if got := data[startFragment:endFragment]; got != fragment {
+		t.Errorf("data[StartFragment:EndFragment] = %q, want %q", got, fragment)
+	}
+}
+
+func TestHTMLFragment(t *testing.T) {
+	fragment := "<b>Grüße</b>"
+
+	got, err := htmlFragment(append(htmlClipboardData(fragment), 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != fragment {
+		t.Errorf("htmlFragment(htmlClipboardData(%q)) = %q", fragment, got)
+	}
+
+	if _, err := htmlFragment([]byte("Version:0.9\r\n<html></html>")); err == nil {
+		t.Errorf("htmlFragment without offsets succeeded")
+	}
+}
+
+func TestDIBFromImage(t *testing.T) {
+	im := image.NewNRGBA(image.Rect(0, 0, 2, 3))
+	im.SetNRGBA(0, 0, color.NRGBA{R: 1, G: 2, B: 3, A: 4})
+	im.SetNRGBA(1, 2, color.NRGBA{R: 5, G: 6, B: 7, A: 255})
+
+	data := dibFromImage(im)
+
+	le := binary.LittleEndian
+	if got, want := len(data), 40+2*3*4; got != want {
+		t.Fatalf("len(data) = %d, want %d", got, want)
+	}
+	if got := le.Uint32(data[4:]); got != 2 {
+		t.Errorf("biWidth = %d, want 2", got)
+	}
+	if got := int32(le.Uint32(data[8:])); got != 3 {
+		t.Errorf("biHeight = %d, want 3 (bottom-up)", got)
+	}
+	if got := le.Uint16(data[14:]); got != 32 {
+		t.Errorf("biBitCount = %d, want 32", got)
+	}
+
+	// The top left pixel starts the last row, the bottom right one ends the first.
+	if got, want := data[40+2*2*4:40+2*2*4+4], []byte{3, 2, 1, 4}; !slices.Equal(got, want) {
+		t.Errorf("top left pixel = %v, want %v", got, want)
+	}
+	if got, want := data[40+4:40+8], []byte{7, 6, 5, 255}; !slices.Equal(got, want) {
+		t.Errorf("bottom right pixel = %v, want %v", got, want)
+	}
+
+	back, err := imageFromDIB(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(back.Pix, im.Pix) {
+		t.Errorf("imageFromDIB(dibFromImage(im)).Pix = %v, want %v", back.Pix, im.Pix)
+	}
+}
+
+func TestImageFromDIB24(t *testing.T) {
+	// 1x2, top-down, rows padded to 4 bytes.
+	data := make([]byte, 40+2*4)
+	le := binary.LittleEndian
+	le.PutUint32(data[0:], 40)
+	le.PutUint32(data[4:], 1)
+	le.PutUint32(data[8:], uint32(0xffffffff-1)) // -2
+	le.PutUint16(data[12:], 1)
+	le.PutUint16(data[14:], 24)
+	copy(data[40:], []byte{3, 2, 1, 0, 6, 5, 4, 0})
+
+	im, err := imageFromDIB(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := im.Pix, []byte{1, 2, 3, 255, 4, 5, 6, 255}; !slices.Equal(got, want) {
+		t.Errorf("Pix = %v, want %v", got, want)
+	}
+
+	le.PutUint16(data[14:], 8)
+	if _, err := imageFromDIB(data); err == nil {
+		t.Errorf("imageFromDIB of an 8 bit DIB succeeded")
+	}
+}
+
+func TestImageFromDIBHugeSize(t *testing.T) {
+	data := make([]byte, 40)
+	le := binary.LittleEndian
+	le.PutUint32(data[0:], 40)
+	le.PutUint32(data[4:], 0x7fffffff)
+	le.PutUint32(data[8:], 0x7fffffff)
+	le.PutUint16(data[12:], 1)
+	le.PutUint16(data[14:], 32)
+
+	if _, err := imageFromDIB(data); err == nil {
+		t.Error("imageFromDIB of a huge DIB without pixels succeeded")
+	}
+}
+
+func TestDropFilesData(t *testing.T) {
+	paths := []string{`C:\a.txt`, `D:\Grüße\b`}
+
+	data := dropFilesData(paths)
+
+	le := binary.LittleEndian
+	if got := le.Uint32(data[0:]); got != 20 {
+		t.Errorf("pFiles = %d, want 20", got)
+	}
+	if got := le.Uint32(data[16:]); got != 1 {
+		t.Errorf("fWide = %d, want 1", got)
+	}
+
+	chars := make([]uint16, (len(data)-20)/2)
+	for i := range chars {
+		chars[i] = le.Uint16(data[20+i*2:])
+	}
+
+	if !slices.Equal(chars[len(chars)-2:], []uint16{0, 0}) {
+		t.Fatalf("list does not end with two NULs")
+	}
+
+	got := strings.Split(string(utf16.Decode(chars[:len(chars)-2])), "\x00")
+	if !slices.Equal(got, paths) {
+		t.Errorf("paths = %q, want %q", got, paths)
+	}
+
+	got, err := filesFromDropFilesData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(got, paths) {
+		t.Errorf("filesFromDropFilesData(dropFilesData(%q)) = %q", paths, got)
+	}
+
+	// ANSI lists are still around.
+	ansi := append(make([]byte, 20), "C:\\x\x00C:\\y\x00\x00"...)
+	le.PutUint32(ansi[0:], 20)
+
+	got, err = filesFromDropFilesData(ansi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{`C:\x`, `C:\y`}; !slices.Equal(got, want) {
+		t.Errorf("filesFromDropFilesData(ansi) = %q, want %q", got, want)
+	}
+}
+
+func TestTextFromUTF16Data(t *testing.T) {
+	data := []byte{'H', 0, 'i', 0, 0xac, 0x20, 0, 0, 'x', 0}
+
+	if got := textFromUTF16Data(data, true); got != "Hi€" {
+		t.Errorf("textFromUTF16Data(data, true) = %q, want %q", got, "Hi€")
+	}
+	if got := textFromUTF16Data(data, false); got != "Hi€\x00x" {
+		t.Errorf("textFromUTF16Data(data, false) = %q, want %q", got, "Hi€\x00x")
+	}
+}
@@ -96,6 +96,9 @@ func comboBoxEditWndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uint
 			cb.editing = false
 			cb.editingFinishedPublisher.Publish()
 		}
+
+		// The edit control, not the ComboBox, has the focus.
+		cb.focusedChangedPublisher.Publish()
 	}
 
 	return win.CallWindowProc(cb.editOrigWndProcPtr, hwnd, msg, wParam, lParam)
@@ -398,7 +401,7 @@ func (cb *ComboBox) SetModel(mdl any) error {
 	}
 
 	cb.model = model
-	cb.bindingValueProvider, _ = model.(BindingValueProvider)
+	cb.bindingValueProvider, _ = passedThrough[BindingValueProvider](model)
 
 	if model != nil {
 		cb.attachModel()
@@ -743,13 +746,15 @@ func (cb *ComboBox) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	h := defaultSize.Height + 1
 
 	return &comboBoxLayoutItem{
-		layoutFlags: layoutFlags,
-		idealSize:   Size{w, h},
+		textBaseline: cb.newTextBaseline(0, AlignCenter),
+		layoutFlags:  layoutFlags,
+		idealSize:    Size{w, h},
 	}
 }
 
 type comboBoxLayoutItem struct {
 	LayoutItemBase
+	textBaseline
 	layoutFlags LayoutFlags
 	idealSize   Size // in native pixels
 }
@@ -25,12 +25,23 @@ type Container interface {
 	SetDataBinder(dbm *DataBinder)
 }
 
+// RightToLeftSetter may be implemented by a Container whose content can be
+// laid out and displayed from right to left. All Containers of this package
+// implement it.
+type RightToLeftSetter interface {
+	// SetRightToLeft sets whether the Container and its descendants lay out
+	// and display their content from right to left, e.g. for Arabic or
+	// Hebrew.
+	SetRightToLeft(rtl bool) error
+}
+
 type ContainerBase struct {
 	WidgetBase
-	layout     Layout
-	children   *WidgetList
-	dataBinder *DataBinder
-	persistent bool
+	layout      Layout
+	children    *WidgetList
+	dataBinder  *DataBinder
+	persistent  bool
+	rightToLeft *bool
 }
 
 func (cb *ContainerBase) AsWidgetBase() *WidgetBase {
@@ -61,6 +72,40 @@ func (cb *ContainerBase) applyFont(font *Font) {
 	applyFontToDescendants(cb.window.(Widget), font)
 }
 
+// RightToLeft returns whether the ContainerBase lays out and displays its
+// content from right to left.
+//
+// By default this is inherited from the parent.
+func (cb *ContainerBase) RightToLeft() bool {
+	if cb.rightToLeft != nil {
+		return *cb.rightToLeft
+	}
+
+	return cb.WidgetBase.RightToLeft()
+}
+
+// SetRightToLeft sets whether the ContainerBase and its descendants lay out
+// and display their content from right to left.
+//
+// Layouts are mirrored horizontally, native controls are mirrored and the
+// near and far text alignments of widgets like Label and LineEdit are
+// swapped. Descendant Containers may set a different value.
+func (cb *ContainerBase) SetRightToLeft(rtl bool) error {
+	cb.rightToLeft = &rtl
+
+	return applyRightToLeftToDescendants(cb.window)
+}
+
+func (cb *ContainerBase) applyRightToLeft(rtl bool) error {
+	if err := cb.WidgetBase.applyRightToLeft(rtl); err != nil {
+		return err
+	}
+
+	cb.RequestLayout()
+
+	return nil
+}
+
 func (cb *ContainerBase) ApplySysColors() {
 	cb.WidgetBase.ApplySysColors()
 
@@ -417,6 +462,11 @@ func (cb *ContainerBase) onInsertedWidget(index int, widget Widget) (err error)
 
 	widget.(applyFonter).applyFont(cb.Font())
 
+	// Widgets are created from left to right.
+	if windowRightToLeft(widget) {
+		err = applyRightToLeftToDescendants(widget)
+	}
+
 	return
 }
 
@@ -433,6 +483,10 @@ func (cb *ContainerBase) onRemovingWidget(index int, widget Widget) (err error)
 }
 
 func (cb *ContainerBase) onRemovedWidget(index int, widget Widget) (err error) {
+	if wr, ok := cb.layout.(interface{ onRemovedWidget(widget Widget) }); ok {
+		wr.onRemovedWidget(widget)
+	}
+
 	cb.RequestLayout()
 
 	return
@@ -453,6 +507,10 @@ func (cb *ContainerBase) onClearingWidgets() (err error) {
 }
 
 func (cb *ContainerBase) onClearedWidgets() (err error) {
+	if wc, ok := cb.layout.(interface{ onClearedWidgets() }); ok {
+		wc.onClearedWidgets()
+	}
+
 	cb.RequestLayout()
 
 	return
@@ -119,6 +119,8 @@ func (cw *CustomWidget) doPaint(ps *win.PAINTSTRUCT) error {
 	}
 	defer canvas.Dispose()
 
+	canvas.rightToLeftReading = cw.RightToLeft()
+
 	bounds := rectangleFromRECT(ps.RcPaint)
 	if cw.paintMode == PaintBuffered {
 		err = cw.bufferedPaint(canvas, bounds)
@@ -190,7 +192,7 @@ func (cw *CustomWidget) bufferedPaint(canvas *Canvas, updateBounds Rectangle) er
 	}
 	defer win.DeleteDC(hdc)
 
-	buffered := Canvas{hdc: hdc, doNotDisposeDC: true}
+	buffered := Canvas{hdc: hdc, doNotDisposeDC: true, rightToLeftReading: canvas.rightToLeftReading}
 	if _, err := buffered.init(); err != nil {
 		return err
 	}
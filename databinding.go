@@ -8,10 +8,12 @@
 package walk
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
-	"strings"
+	"sort"
 	"time"
 )
 
@@ -20,27 +22,76 @@ var (
 )
 
 type ErrorPresenter interface {
+	// PresentError presents err for widget, or removes the error of widget if
+	// err is nil. widget is nil for errors of a DataBinder that do not belong
+	// to a widget, like those of a CrossFieldValidator whose paths are not
+	// bound to any. Several of these are joined with errors.Join.
 	PresentError(err error, widget Widget)
 }
 
+// PropertyChangedNotifier may be implemented by the data source of a
+// DataBinder, to have bound widgets refresh when the data source is changed
+// outside of the DataBinder, e.g. by a background goroutine.
+type PropertyChangedNotifier interface {
+	// PropertyChanged returns the event the data source publishes after a value
+	// has been changed, with the path of that value, like `Customer.Name` or
+	// `Orders[2]`. An empty path means that anything may have changed. The
+	// event must be published on the GUI thread.
+	PropertyChanged() *StringEvent
+}
+
+// PropertyChangedNotifierBase implements PropertyChangedNotifier. Embed it in a
+// data source struct.
+type PropertyChangedNotifierBase struct {
+	propertyChangedPublisher StringEventPublisher
+}
+
+func (pcnb *PropertyChangedNotifierBase) PropertyChanged() *StringEvent {
+	return pcnb.propertyChangedPublisher.Event()
+}
+
+// PublishPropertyChanged publishes the PropertyChanged event for path. It may
+// be called from any goroutine. If it is not called on the GUI thread, the
+// event is published there later.
+func (pcnb *PropertyChangedNotifierBase) PublishPropertyChanged(path string) {
+	if App().IsUIThread() {
+		pcnb.propertyChangedPublisher.Publish(path)
+		return
+	}
+
+	App().Synchronize(func() {
+		pcnb.propertyChangedPublisher.Publish(path)
+	})
+}
+
 type DataBinder struct {
 	dataSource                 any
+	propertyChangedHandle      int
+	changedPaths               []string
+	changedPathsPending        bool
 	boundWidgets               []Widget
 	properties                 []Property
 	property2Widget            map[Property]Widget
 	property2ChangedHandle     map[Property]int
+	dirtyProperties            map[Property]bool
 	rootExpression             Expression
-	path2Expression            map[string]Expression
+	path2Expression            map[string]*dataBinderExpression
+	path2ElementIDs            map[string][]uintptr
 	errorPresenter             ErrorPresenter
+	crossFieldValidators       []CrossFieldValidator
+	asyncValidations           []*asyncValidation
+	runner                     asyncRunner // App() if nil
 	dataSourceChangedPublisher EventPublisher
 	canSubmitChangedPublisher  EventPublisher
 	submittedPublisher         EventPublisher
 	resetPublisher             EventPublisher
+	elementReplacedPublisher   StringEventPublisher
 	autoSubmitDelay            time.Duration
 	autoSubmitTimer            *time.Timer
 	autoSubmit                 bool
 	autoSubmitSuspended        bool
 	canSubmit                  bool
+	formErrorPresented         bool
 	inReset                    bool
 	dirty                      bool
 }
@@ -112,13 +163,40 @@ func (db *DataBinder) SetDataSource(dataSource any) error {
 		}
 	}
 
+	if pcn, ok := db.dataSource.(PropertyChangedNotifier); ok {
+		pcn.PropertyChanged().Detach(db.propertyChangedHandle)
+	}
+
 	db.dataSource = dataSource
 
+	if pcn, ok := dataSource.(PropertyChangedNotifier); ok {
+		db.propertyChangedHandle = pcn.PropertyChanged().Attach(db.onDataSourcePropertyChanged)
+	}
+
 	db.dataSourceChangedPublisher.Publish()
 
 	return nil
 }
 
+// Dispose detaches db from its data source and bound widgets and stops a
+// pending auto submit and running async validators.
+func (db *DataBinder) Dispose() {
+	if pcn, ok := db.dataSource.(PropertyChangedNotifier); ok {
+		pcn.PropertyChanged().Detach(db.propertyChangedHandle)
+	}
+	db.changedPaths = nil
+
+	if db.autoSubmitTimer != nil {
+		db.autoSubmitTimer.Stop()
+	}
+
+	for _, av := range db.asyncValidations {
+		av.stop()
+	}
+
+	db.SetBoundWidgets(nil)
+}
+
 type dataBinderRootExpression struct {
 	db *DataBinder
 }
@@ -162,6 +240,17 @@ func (db *DataBinder) SetBoundWidgets(boundWidgets []Widget) {
 			db.property2ChangedHandle[prop] = prop.Changed().Attach(func() {
 				db.dirty = true
 
+				if db.dirtyProperties == nil {
+					db.dirtyProperties = make(map[Property]bool)
+				}
+				db.dirtyProperties[prop] = true
+
+				if db.inReset {
+					// The value came from the data source, so there is
+					// nothing to submit or validate yet.
+					return
+				}
+
 				if db.autoSubmit && !db.autoSubmitSuspended {
 					if db.autoSubmitDelay > 0 {
 						if db.autoSubmitTimer == nil {
@@ -187,58 +276,645 @@ func (db *DataBinder) SetBoundWidgets(boundWidgets []Widget) {
 						db.submittedPublisher.Publish()
 					}
 				} else {
-					if !db.inReset {
-						db.validateProperties()
-					}
+					db.validateProperties()
 				}
 			})
 		}
 	}
 }
 
+// Expression returns an Expression for the value at path in the data source.
+//
+// Besides names of fields, methods and map keys, path may contain indexes and
+// keys in brackets, like `Orders[2].Customer.Name` or `Attrs["color"]`. The
+// Changed event of the expression is published on Reset and when RefreshElements
+// finds that an element on path has been replaced.
 func (db *DataBinder) Expression(path string) Expression {
 	if db.path2Expression == nil {
-		db.path2Expression = make(map[string]Expression)
+		db.path2Expression = make(map[string]*dataBinderExpression)
 	}
 
-	if prop, ok := db.path2Expression[path]; ok {
-		return prop
+	if expr, ok := db.path2Expression[path]; ok {
+		return expr
 	}
 
-	expr := NewReflectExpression(db.rootExpression, path)
+	expr := &dataBinderExpression{Expression: NewReflectExpression(db.rootExpression, path)}
+	expr.path, _ = parseBindingPath(path)
+
+	db.rootExpression.Changed().Attach(func() {
+		expr.changedPublisher.Publish()
+	})
 
 	db.path2Expression[path] = expr
 
+	if expr.path != nil && db.dataSource != nil && db.path2ElementIDs != nil {
+		if loc, err := expr.path.locate(reflect.ValueOf(db.dataSource)); err == nil {
+			db.path2ElementIDs[expr.path.String()] = loc.ids
+		}
+	}
+
 	return expr
 }
 
+type dataBinderExpression struct {
+	Expression
+	path             bindingPath
+	changedPublisher EventPublisher
+}
+
+func (dbe *dataBinderExpression) Changed() *Event {
+	return dbe.changedPublisher.Event()
+}
+
+// ElementReplaced returns the event that is published by RefreshElements with
+// the path of each replaced element, like `Orders[2]`.
+func (db *DataBinder) ElementReplaced() *StringEvent {
+	return db.elementReplacedPublisher.Event()
+}
+
+// RefreshElements checks if elements of slices, arrays or maps on the paths of
+// bound properties and expressions have been replaced since the last Reset or
+// Submit, e.g. because a slice has been sorted. Properties bound to replaced
+// elements are reset to the values of the new elements, discarding unsubmitted
+// changes.
+//
+// Pointer, map and slice elements are identified by what they point to, other
+// elements by their address. Submit calls RefreshElements first, so it never
+// writes into an element a property was not reset from.
+func (db *DataBinder) RefreshElements() error {
+	if db.dataSource == nil {
+		return nil
+	}
+
+	dsv := reflect.ValueOf(db.dataSource)
+
+	replaced := make(map[string]bindingPath)
+
+	checkPath := func(path bindingPath) error {
+		loc, err := path.locate(dsv)
+		if err != nil {
+			return err
+		}
+
+		key := path.String()
+
+		if prefix, ok := replacedElement(path, db.path2ElementIDs[key], loc.ids); ok {
+			replaced[prefix.String()] = prefix
+		}
+
+		return nil
+	}
+
+	for _, prop := range db.properties {
+		if path, ok := db.propertyPath(prop); ok {
+			if err := checkPath(path); err != nil {
+				return err
+			}
+		}
+	}
+	for _, expr := range db.path2Expression {
+		if expr.path != nil {
+			if err := checkPath(expr.path); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(replaced) == 0 {
+		return nil
+	}
+
+	isReplaced := func(path bindingPath) bool {
+		for _, prefix := range replaced {
+			if path.hasPrefix(prefix) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	db.inReset = true
+	err := db.forEach(func(prop Property, field DataField) error {
+		if path, ok := db.propertyPath(prop); !ok || !isReplaced(path) {
+			return nil
+		}
+
+		if err := db.resetProperty(prop, field); err != nil {
+			return err
+		}
+
+		delete(db.dirtyProperties, prop)
+
+		return nil
+	})
+	db.inReset = false
+	if err != nil {
+		return err
+	}
+
+	db.dirty = len(db.dirtyProperties) > 0
+
+	db.recordElementIDs()
+
+	db.validateProperties()
+
+	for _, expr := range db.path2Expression {
+		if expr.path != nil && isReplaced(expr.path) {
+			expr.changedPublisher.Publish()
+		}
+	}
+
+	paths := make([]string, 0, len(replaced))
+	for path := range replaced {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		db.elementReplacedPublisher.Publish(path)
+	}
+
+	return nil
+}
+
+// onDataSourcePropertyChanged collects the paths the data source reports as
+// changed, so they can be refreshed together.
+func (db *DataBinder) onDataSourcePropertyChanged(path string) {
+	db.changedPaths = append(db.changedPaths, path)
+
+	if !db.changedPathsPending {
+		db.changedPathsPending = true
+
+		App().Synchronize(db.refreshChangedPaths)
+	}
+}
+
+// refreshChangedPaths resets the properties and publishes the Changed events of
+// the expressions, whose paths overlap with paths the data source reported as
+// changed since the last call. Unsubmitted changes of these properties are
+// discarded, those of other properties are kept.
+func (db *DataBinder) refreshChangedPaths() {
+	sources := db.changedPaths
+	db.changedPaths = nil
+	db.changedPathsPending = false
+
+	if db.dataSource == nil {
+		return
+	}
+
+	var changed []bindingPath
+	for _, source := range sources {
+		path, err := parseBindingPath(source)
+		if err != nil {
+			newError(fmt.Sprintf("PropertyChanged: %s", err))
+			continue
+		}
+
+		changed = append(changed, path)
+	}
+
+	isChanged := func(path bindingPath) bool {
+		for _, c := range changed {
+			if path.overlaps(c) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	dsv := reflect.ValueOf(db.dataSource)
+
+	db.inReset = true
+	err := db.forEach(func(prop Property, field DataField) error {
+		path, ok := db.propertyPath(prop)
+		if !ok || !isChanged(path) {
+			return nil
+		}
+
+		if err := db.resetProperty(prop, field); err != nil {
+			return err
+		}
+
+		delete(db.dirtyProperties, prop)
+
+		if loc, err := path.locate(dsv); err == nil && db.path2ElementIDs != nil {
+			db.path2ElementIDs[path.String()] = loc.ids
+		}
+
+		return nil
+	})
+	db.inReset = false
+	if err != nil {
+		wrapError(err)
+	}
+
+	db.dirty = len(db.dirtyProperties) > 0
+
+	db.validateProperties()
+
+	for _, expr := range db.path2Expression {
+		// Expressions without path are about the whole data source.
+		if expr.path == nil || isChanged(expr.path) {
+			expr.changedPublisher.Publish()
+		}
+	}
+}
+
+// recordElementIDs remembers which elements the paths of bound properties and
+// expressions lead through, for RefreshElements.
+func (db *DataBinder) recordElementIDs() {
+	db.path2ElementIDs = make(map[string][]uintptr)
+
+	if db.dataSource == nil {
+		return
+	}
+
+	dsv := reflect.ValueOf(db.dataSource)
+
+	record := func(path bindingPath) {
+		if loc, err := path.locate(dsv); err == nil {
+			db.path2ElementIDs[path.String()] = loc.ids
+		}
+	}
+
+	for _, prop := range db.properties {
+		if path, ok := db.propertyPath(prop); ok {
+			record(path)
+		}
+	}
+	for _, expr := range db.path2Expression {
+		if expr.path != nil {
+			record(expr.path)
+		}
+	}
+}
+
+func (db *DataBinder) propertyPath(prop Property) (bindingPath, bool) {
+	source, ok := prop.Source().(string)
+	if !ok || source == "" {
+		return nil, false
+	}
+
+	path, err := parseBindingPath(source)
+	if err != nil {
+		return nil, false
+	}
+
+	return path, true
+}
+
+// AddCrossFieldValidator adds a validator for values that depend on each other.
+func (db *DataBinder) AddCrossFieldValidator(validator CrossFieldValidator) {
+	db.crossFieldValidators = append(db.crossFieldValidators, validator)
+}
+
+// AddAsyncValidator adds a validator that runs in the background.
+func (db *DataBinder) AddAsyncValidator(validator AsyncValidator) {
+	db.asyncValidations = append(db.asyncValidations, &asyncValidation{validator: validator})
+}
+
+// ValidationPending returns if an AsyncValidator is running.
+func (db *DataBinder) ValidationPending() bool {
+	for _, av := range db.asyncValidations {
+		if av.cancel != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+type asyncValidation struct {
+	validator AsyncValidator
+	values    map[string]any     // of the last run
+	cancel    context.CancelFunc // not nil while running
+	run       int
+	err       error
+}
+
 func (db *DataBinder) validateProperties() {
 	var hasError bool
 
+	var widgets []Widget
+	widget2Error := make(map[Widget]error)
+	var formErrs []error
+
+	addError := func(widget Widget, err error) {
+		if err != nil {
+			hasError = true
+		}
+
+		if widget == nil {
+			if err != nil {
+				formErrs = append(formErrs, err)
+			}
+			return
+		}
+
+		if prev, ok := widget2Error[widget]; !ok {
+			widgets = append(widgets, widget)
+			widget2Error[widget] = err
+		} else if prev == nil {
+			widget2Error[widget] = err
+		}
+	}
+
 	for _, prop := range db.properties {
 		validator := prop.Validator()
 		if validator == nil {
 			continue
 		}
 
-		err := validator.Validate(prop.Get())
-		if err != nil {
-			hasError = true
+		addError(db.property2Widget[prop], validator.Validate(prop.Get()))
+	}
+
+	for _, validator := range db.crossFieldValidators {
+		paths := validator.Paths()
+
+		addError(db.widgetBoundToPaths(paths), validator.ValidateValues(db.valuesAtPaths(paths)))
+	}
+
+	for _, av := range db.asyncValidations {
+		paths := av.validator.Paths()
+		widget := db.widgetBoundToPaths(paths)
+
+		if widget != nil && widget2Error[widget] != nil {
+			av.stop()
+			continue
 		}
 
-		if db.errorPresenter != nil {
-			widget := db.property2Widget[prop]
+		values := db.valuesAtPaths(paths)
 
-			db.errorPresenter.PresentError(err, widget)
+		if av.values == nil || !sameValues(values, av.values) {
+			db.startAsyncValidation(av, values)
 		}
+
+		// While pending, err is nil, which clears the error of the last run.
+		addError(widget, av.err)
 	}
 
-	if hasError == db.canSubmit {
-		db.canSubmit = !hasError
+	if db.errorPresenter != nil {
+		for _, widget := range widgets {
+			db.errorPresenter.PresentError(widget2Error[widget], widget)
+		}
+
+		// Only presented once there are any, so presenters that do not expect
+		// them keep working.
+		if formErr := errors.Join(formErrs...); formErr != nil || db.formErrorPresented {
+			db.errorPresenter.PresentError(formErr, nil)
+			db.formErrorPresented = formErr != nil
+		}
+	}
+
+	if canSubmit := !hasError && !db.ValidationPending(); canSubmit != db.canSubmit {
+		db.canSubmit = canSubmit
 		db.canSubmitChangedPublisher.Publish()
 	}
 }
 
+func (db *DataBinder) startAsyncValidation(av *asyncValidation, values map[string]any) {
+	av.stop()
+
+	runner := db.asyncRunner()
+
+	ctx, cancel := context.WithCancel(runner.Context())
+
+	av.values = values
+	av.cancel = cancel
+	av.run++
+	av.err = nil
+
+	run := av.run
+
+	finish := func(err error) {
+		runner.Synchronize(func() {
+			if av.run != run || av.cancel == nil {
+				// Values changed in the meantime or the run already finished.
+				return
+			}
+
+			cancel()
+			av.cancel = nil
+			av.err = err
+
+			db.validateProperties()
+		})
+	}
+
+	// The runner does not call the validator at all if the app is exiting, so
+	// finish the run when that happens, instead of leaving it pending.
+	context.AfterFunc(ctx, func() {
+		finish(ctx.Err())
+	})
+
+	runner.Go(func(context.Context) {
+		finish(av.validator.ValidateAsync(ctx, values))
+	})
+}
+
+// asyncRunner runs async validators and hands their results back to the GUI
+// thread. It is implemented by *Application.
+type asyncRunner interface {
+	Context() context.Context
+	Go(f func(context.Context))
+	Synchronize(f func())
+}
+
+func (db *DataBinder) asyncRunner() asyncRunner {
+	if db.runner != nil {
+		return db.runner
+	}
+
+	return App()
+}
+
+// stop cancels a running validation and makes sure the next one starts fresh.
+func (av *asyncValidation) stop() {
+	if av.cancel != nil {
+		av.cancel()
+		av.cancel = nil
+		av.run++
+	}
+
+	av.values = nil
+	av.err = nil
+}
+
+// widgetBoundToPaths returns the widget bound to the first of paths that has
+// one bound to it, or nil.
+func (db *DataBinder) widgetBoundToPaths(paths []string) Widget {
+	for _, source := range paths {
+		if prop := db.propertyBoundToPath(source); prop != nil {
+			return db.property2Widget[prop]
+		}
+	}
+
+	return nil
+}
+
+func (db *DataBinder) propertyBoundToPath(source string) Property {
+	path, err := parseBindingPath(source)
+	if err != nil {
+		return nil
+	}
+
+	key := path.String()
+
+	for _, prop := range db.properties {
+		if p, ok := db.propertyPath(prop); ok && p.String() == key {
+			return prop
+		}
+	}
+
+	return nil
+}
+
+// valuesAtPaths returns the current values at paths, which are those of bound
+// properties if there are any, keyed by path.
+func (db *DataBinder) valuesAtPaths(paths []string) map[string]any {
+	values := make(map[string]any, len(paths))
+
+	for _, source := range paths {
+		if prop := db.propertyBoundToPath(source); prop != nil {
+			values[source] = prop.Get()
+			continue
+		}
+
+		var value any
+		if db.dataSource != nil {
+			if path, err := parseBindingPath(source); err == nil {
+				if loc, err := path.locate(reflect.ValueOf(db.dataSource)); err == nil && loc.value.IsValid() && loc.value.CanInterface() {
+					value = loc.value.Interface()
+				}
+			}
+		}
+		values[source] = value
+	}
+
+	return values
+}
+
+// sameValues returns if a and b hold the same values. Other than
+// reflect.DeepEqual, it considers NaNs equal to each other and funcs equal to
+// themselves, so unchanged values never look changed.
+func sameValues(a, b map[string]any) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, va := range a {
+		vb, ok := b[key]
+		if !ok || !sameValue(reflect.ValueOf(va), reflect.ValueOf(vb)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameValue(a, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+
+	case reflect.Float32, reflect.Float64:
+		return sameFloat(a.Float(), b.Float())
+
+	case reflect.Complex64, reflect.Complex128:
+		ca, cb := a.Complex(), b.Complex()
+		return sameFloat(real(ca), real(cb)) && sameFloat(imag(ca), imag(cb))
+
+	case reflect.String:
+		return a.String() == b.String()
+
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+
+	case reflect.Pointer:
+		if a.Pointer() == b.Pointer() {
+			return true
+		}
+		if a.IsNil() || b.IsNil() {
+			return false
+		}
+		return sameValue(a.Elem(), b.Elem())
+
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return sameValue(a.Elem(), b.Elem())
+
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if !sameValue(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			return false
+		}
+		if a.Pointer() == b.Pointer() {
+			return true
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !sameValue(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			return false
+		}
+		if a.Pointer() == b.Pointer() {
+			return true
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			vb := b.MapIndex(iter.Key())
+			if !vb.IsValid() || !sameValue(iter.Value(), vb) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !sameValue(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+func sameFloat(a, b float64) bool {
+	return a == b || math.IsNaN(a) && math.IsNaN(b)
+}
+
 func (db *DataBinder) ErrorPresenter() ErrorPresenter {
 	return db.errorPresenter
 }
@@ -261,71 +937,76 @@ func (db *DataBinder) Reset() error {
 		db.inReset = false
 	}()
 
-	if err := db.forEach(func(prop Property, field DataField) error {
-		if f64, ok := prop.Get().(float64); ok {
-			switch v := field.Get().(type) {
-			case float32:
-				f64 = float64(v)
+	if err := db.forEach(db.resetProperty); err != nil {
+		return err
+	}
 
-			case float64:
-				f64 = v
+	db.validateProperties()
 
-			case int:
-				f64 = float64(v)
+	db.dirty = false
+	db.dirtyProperties = nil
 
-			case int8:
-				f64 = float64(v)
+	db.recordElementIDs()
 
-			case int16:
-				f64 = float64(v)
+	db.resetPublisher.Publish()
 
-			case int32:
-				f64 = float64(v)
+	return nil
+}
 
-			case int64:
-				f64 = float64(v)
+func (db *DataBinder) resetProperty(prop Property, field DataField) error {
+	if f64, ok := prop.Get().(float64); ok {
+		switch v := field.Get().(type) {
+		case float32:
+			f64 = float64(v)
 
-			case uint:
-				f64 = float64(v)
+		case float64:
+			f64 = v
 
-			case uint8:
-				f64 = float64(v)
+		case int:
+			f64 = float64(v)
 
-			case uint16:
-				f64 = float64(v)
+		case int8:
+			f64 = float64(v)
 
-			case uint32:
-				f64 = float64(v)
+		case int16:
+			f64 = float64(v)
 
-			case uint64:
-				f64 = float64(v)
+		case int32:
+			f64 = float64(v)
 
-			case uintptr:
-				f64 = float64(v)
+		case int64:
+			f64 = float64(v)
 
-			default:
-				return newError(fmt.Sprintf("Field '%s': Can't convert %T to float64.", prop.Source().(string), field.Get()))
-			}
+		case uint:
+			f64 = float64(v)
 
-			if err := prop.Set(f64); err != nil {
-				return err
-			}
-		} else {
-			if err := prop.Set(field.Get()); err != nil {
-				return err
-			}
-		}
+		case uint8:
+			f64 = float64(v)
 
-		return nil
-	}); err != nil {
-		return err
-	}
+		case uint16:
+			f64 = float64(v)
 
-	db.validateProperties()
+		case uint32:
+			f64 = float64(v)
 
-	db.dirty = false
+		case uint64:
+			f64 = float64(v)
 
-	db.resetPublisher.Publish()
+		case uintptr:
+			f64 = float64(v)
+
+		default:
+			return newError(fmt.Sprintf("Field '%s': Can't convert %T to float64.", prop.Source().(string), field.Get()))
+		}
+
+		if err := prop.Set(f64); err != nil {
+			return err
+		}
+	} else {
+		if err := prop.Set(field.Get()); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -339,6 +1020,10 @@ func (db *DataBinder) Submit() error {
 		return errValidationFailed
 	}
 
+	if err := db.RefreshElements(); err != nil {
+		return err
+	}
+
 	if err := db.forEach(func(prop Property, field DataField) error {
 		return db.submitProperty(prop, field)
 	}); err != nil {
@@ -346,6 +1031,9 @@ func (db *DataBinder) Submit() error {
 	}
 
 	db.dirty = false
+	db.dirtyProperties = nil
+
+	db.recordElementIDs()
 
 	db.submittedPublisher.Publish()
 
@@ -356,6 +1044,24 @@ func (db *DataBinder) Dirty() bool {
 	return db.dirty
 }
 
+// DirtyAt returns if a property bound to path, or to a path below it like
+// path + ".Name" or path + "[0]", has been changed since the last Reset or
+// Submit.
+func (db *DataBinder) DirtyAt(path string) bool {
+	prefix, err := parseBindingPath(path)
+	if err != nil {
+		return false
+	}
+
+	for prop := range db.dirtyProperties {
+		if p, ok := db.propertyPath(prop); ok && p.hasPrefix(prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (db *DataBinder) submitProperty(prop Property, field DataField) error {
 	if !field.CanSet() {
 		// FIXME: handle properly
@@ -432,110 +1138,24 @@ type DataField interface {
 }
 
 func dataFieldFromPath(root reflect.Value, path string) (DataField, error) {
-	parent, value, err := reflectValueFromPath(root, path)
+	p, err := parseBindingPath(path)
 	if err != nil {
 		return nil, err
 	}
 
-	// convert to DataField
-	if i, ok := value.Interface().(DataField); ok {
-		return i, nil
+	loc, err := p.locate(root)
+	if err != nil {
+		return nil, err
 	}
 
-	return &reflectField{parent: parent, value: value, key: path[strings.LastIndexByte(path, '.')+1:]}, nil
-}
-
-func reflectValueFromPath(root reflect.Value, path string) (parent, value reflect.Value, err error) {
-	fullPath := path
-	value = root
-
-	for path != "" {
-		var name string
-		name, path = nextPathPart(path)
-
-		var p reflect.Value
-		for value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
-			p = value
-			value = value.Elem()
-		}
-
-		switch value.Kind() {
-		case reflect.Map:
-			parent = value
-			value = value.MapIndex(reflect.ValueOf(name))
-
-		case reflect.Struct:
-			parent = value
-
-			var fun reflect.Value
-
-			// Try as field first.
-			if f := value.FieldByName(name); f.IsValid() {
-				switch f.Kind() {
-				case reflect.Func:
-					fun = f
-
-				case reflect.Interface:
-					if fn := f.Elem(); fn.Kind() == reflect.Func {
-						fun = fn
-					} else {
-						value = f
-					}
-
-				default:
-					value = f
-				}
-			} else {
-				// No field, so let's see if we got a method.
-				if p.IsValid() {
-					// Try pointer receiver first.
-					fun = p.MethodByName(name)
-				}
-
-				if !fun.IsValid() {
-					// No pointer, try directly.
-					fun = value.MethodByName(name)
-				}
-				if !fun.IsValid() {
-					return parent, value, fmt.Errorf("bad member: '%s', path: '%s'", path, fullPath)
-				}
-			}
-
-			if fun.IsValid() {
-				// We assume it takes no args and returns one mandatory value plus
-				// maybe an error.
-				rvs := fun.Call(nil)
-				switch len(rvs) {
-				case 1:
-					value = rvs[0]
-
-				case 2:
-					rv2 := rvs[1].Interface()
-					if err, ok := rv2.(error); ok {
-						return parent, value, err
-					} else if rv2 != nil {
-						return parent, value, fmt.Errorf("second method return value must implement error")
-					}
-
-					value = rvs[0]
-
-				default:
-					return parent, value, fmt.Errorf("method must return a value plus optionally an error: %s", name)
-				}
-			}
+	// convert to DataField
+	if loc.value.IsValid() && loc.value.CanInterface() {
+		if i, ok := loc.value.Interface().(DataField); ok {
+			return i, nil
 		}
 	}
 
-	return parent, value, nil
-}
-
-func nextPathPart(p string) (next, remaining string) {
-	for i, r := range p {
-		if r == '.' {
-			return p[:i], p[i+1:]
-		}
-	}
-	return p, ""
+	return &pathField{loc: loc}, nil
 }
 
 type nilField struct {
@@ -557,54 +1177,3 @@ func (nilField) Set(any) error {
 func (f nilField) Zero() any {
 	return reflect.Zero(reflect.TypeOf(f.prop.Get())).Interface()
 }
-
-type reflectField struct {
-	parent reflect.Value
-	value  reflect.Value
-	key    string
-}
-
-func (f *reflectField) CanSet() bool {
-	if f.parent.IsValid() && f.parent.Kind() == reflect.Map {
-		return true
-	}
-
-	return f.value.CanSet()
-}
-
-func (f *reflectField) Get() any {
-	return f.value.Interface()
-}
-
-func (f *reflectField) Set(value any) error {
-	if f.parent.IsValid() && f.parent.Kind() == reflect.Map {
-		f.parent.SetMapIndex(reflect.ValueOf(f.key), reflect.ValueOf(value))
-		return nil
-	}
-
-	if f64, ok := value.(float64); ok {
-		switch f.value.Kind() {
-		case reflect.Float32, reflect.Float64:
-			f.value.SetFloat(f64)
-
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			f.value.SetInt(int64(f64))
-
-		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-			f.value.SetUint(uint64(f64))
-
-		default:
-			return newError(fmt.Sprintf("Can't convert float64 to %s.", f.value.Type().Name()))
-		}
-
-		return nil
-	}
-
-	f.value.Set(reflect.ValueOf(value))
-
-	return nil
-}
-
-func (f *reflectField) Zero() any {
-	return reflect.Zero(f.value.Type()).Interface()
-}
@@ -0,0 +1,320 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+// testAsyncRunner queues the funcs passed to Go and Synchronize, so tests can
+// decide when they run.
+type testAsyncRunner struct {
+	ctx     context.Context
+	exit    context.CancelFunc
+	goFuncs []func(context.Context)
+	synced  chan func() // may be sent to from other goroutines
+}
+
+func newTestAsyncRunner() *testAsyncRunner {
+	ctx, exit := context.WithCancel(context.Background())
+
+	return &testAsyncRunner{ctx: ctx, exit: exit, synced: make(chan func(), 100)}
+}
+
+func (r *testAsyncRunner) Context() context.Context {
+	return r.ctx
+}
+
+func (r *testAsyncRunner) Go(f func(context.Context)) {
+	if r.ctx.Err() != nil {
+		return
+	}
+
+	r.goFuncs = append(r.goFuncs, f)
+}
+
+func (r *testAsyncRunner) Synchronize(f func()) {
+	r.synced <- f
+}
+
+// run runs the queued funcs until there are none left, the ones passed to Go
+// first.
+func (r *testAsyncRunner) run() {
+	for {
+		if len(r.goFuncs) > 0 {
+			f := r.goFuncs[0]
+			r.goFuncs = r.goFuncs[1:]
+			f(r.ctx)
+			continue
+		}
+
+		select {
+		case f := <-r.synced:
+			f()
+
+		default:
+			return
+		}
+	}
+}
+
+type validationTestSource struct {
+	Start, End int
+	Name       string
+	Ratio      float64
+}
+
+func newValidationTestBinder(t *testing.T, ds *validationTestSource) (*DataBinder, *testAsyncRunner) {
+	t.Helper()
+
+	runner := newTestAsyncRunner()
+
+	db := NewDataBinder()
+	db.runner = runner
+
+	if err := db.SetDataSource(ds); err != nil {
+		t.Fatal(err)
+	}
+
+	return db, runner
+}
+
+func TestDataBinderCrossFieldValidator(t *testing.T) {
+	ds := &validationTestSource{Start: 1, End: 5}
+	db, _ := newValidationTestBinder(t, ds)
+
+	db.AddCrossFieldValidator(NewCrossFieldValidator(func(values map[string]any) error {
+		if values["End"].(int) < values["Start"].(int) {
+			return errors.New("End before Start")
+		}
+
+		return nil
+	}, "Start", "End"))
+
+	tests := []struct {
+		start, end    int
+		wantCanSubmit bool
+	}{
+		{1, 5, true},
+		{6, 5, false},
+		{5, 5, true},
+	}
+
+	for _, tt := range tests {
+		ds.Start, ds.End = tt.start, tt.end
+
+		db.validateProperties()
+
+		if got := db.CanSubmit(); got != tt.wantCanSubmit {
+			t.Errorf("Start %d, End %d: CanSubmit() = %t, want %t", tt.start, tt.end, got, tt.wantCanSubmit)
+		}
+	}
+}
+
+func TestDataBinderAsyncValidator(t *testing.T) {
+	ds := &validationTestSource{Name: "taken"}
+	db, runner := newValidationTestBinder(t, ds)
+
+	var calls int
+	db.AddAsyncValidator(NewAsyncValidator(func(ctx context.Context, values map[string]any) error {
+		calls++
+
+		if values["Name"] == "taken" {
+			return errors.New("name taken")
+		}
+
+		return nil
+	}, "Name"))
+
+	db.validateProperties()
+
+	if !db.ValidationPending() || db.CanSubmit() {
+		t.Fatalf("before run: ValidationPending() = %t, CanSubmit() = %t", db.ValidationPending(), db.CanSubmit())
+	}
+
+	runner.run()
+
+	if db.ValidationPending() || db.CanSubmit() {
+		t.Fatalf("after run: ValidationPending() = %t, CanSubmit() = %t", db.ValidationPending(), db.CanSubmit())
+	}
+
+	ds.Name = "free"
+	db.validateProperties()
+	runner.run()
+
+	if db.ValidationPending() || !db.CanSubmit() {
+		t.Fatalf("after change: ValidationPending() = %t, CanSubmit() = %t", db.ValidationPending(), db.CanSubmit())
+	}
+
+	if calls != 2 {
+		t.Errorf("validator called %d times, want 2", calls)
+	}
+}
+
+func TestDataBinderAsyncValidatorDropsStaleRun(t *testing.T) {
+	ds := &validationTestSource{Name: "first"}
+	db, runner := newValidationTestBinder(t, ds)
+
+	var contexts []context.Context
+	db.AddAsyncValidator(NewAsyncValidator(func(ctx context.Context, values map[string]any) error {
+		contexts = append(contexts, ctx)
+
+		if values["Name"] == "first" {
+			return errors.New("stale result")
+		}
+
+		return nil
+	}, "Name"))
+
+	db.validateProperties()
+
+	ds.Name = "second"
+	db.validateProperties()
+
+	runner.run()
+
+	if len(contexts) != 2 {
+		t.Fatalf("validator called %d times, want 2", len(contexts))
+	}
+
+	if contexts[0].Err() == nil {
+		t.Error("context of stale run not canceled")
+	}
+
+	if db.ValidationPending() || !db.CanSubmit() {
+		t.Errorf("ValidationPending() = %t, CanSubmit() = %t, want false, true", db.ValidationPending(), db.CanSubmit())
+	}
+}
+
+func TestDataBinderAsyncValidatorCanceledOnExit(t *testing.T) {
+	ds := &validationTestSource{Name: "name"}
+	db, runner := newValidationTestBinder(t, ds)
+
+	var calls int
+	db.AddAsyncValidator(NewAsyncValidator(func(ctx context.Context, values map[string]any) error {
+		calls++
+		return nil
+	}, "Name"))
+
+	db.validateProperties()
+
+	// The validator never runs, like with App().Go during shutdown.
+	runner.goFuncs = nil
+	runner.exit()
+
+	// Wait for the run to be finished on exit.
+	(<-runner.synced)()
+
+	if calls != 0 {
+		t.Errorf("validator called %d times, want 0", calls)
+	}
+
+	if db.ValidationPending() {
+		t.Error("validation still pending after exit")
+	}
+}
+
+func TestDataBinderAsyncValidatorNaN(t *testing.T) {
+	ds := &validationTestSource{Ratio: math.NaN()}
+	db, runner := newValidationTestBinder(t, ds)
+
+	var calls int
+	db.AddAsyncValidator(NewAsyncValidator(func(ctx context.Context, values map[string]any) error {
+		calls++
+		return nil
+	}, "Ratio"))
+
+	db.validateProperties()
+	runner.run()
+	db.validateProperties()
+	runner.run()
+
+	if calls != 1 {
+		t.Errorf("validator called %d times, want 1", calls)
+	}
+}
+
+func TestSameValues(t *testing.T) {
+	f := func() {}
+	nan := math.NaN()
+
+	tests := []struct {
+		a, b any
+		want bool
+	}{
+		{nil, nil, true},
+		{1, 1, true},
+		{1, 2, false},
+		{1, int64(1), false},
+		{"a", "a", true},
+		{nan, nan, true},
+		{nan, 1.0, false},
+		{complex(nan, 1), complex(nan, 1), true},
+		{f, f, true},
+		{[]float64{1, nan}, []float64{1, nan}, true},
+		{[]int{1}, []int{1, 2}, false},
+		{map[string]float64{"x": nan}, map[string]float64{"x": nan}, true},
+		{&validationTestSource{Ratio: nan}, &validationTestSource{Ratio: nan}, true},
+		{validationTestSource{Name: "a"}, validationTestSource{Name: "b"}, false},
+	}
+
+	for i, tt := range tests {
+		if got := sameValues(map[string]any{"v": tt.a}, map[string]any{"v": tt.b}); got != tt.want {
+			t.Errorf("%d: sameValues(%v, %v) = %t, want %t", i, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+type recordingErrorPresenter struct {
+	widgets []Widget
+	errs    []error
+}
+
+func (rep *recordingErrorPresenter) PresentError(err error, widget Widget) {
+	rep.widgets = append(rep.widgets, widget)
+	rep.errs = append(rep.errs, err)
+}
+
+func TestDataBinderPresentsFormErrors(t *testing.T) {
+	ds := &validationTestSource{Start: 6, End: 5}
+	db, _ := newValidationTestBinder(t, ds)
+
+	rep := new(recordingErrorPresenter)
+	db.SetErrorPresenter(rep)
+
+	errEndBeforeStart := errors.New("End before Start")
+	db.AddCrossFieldValidator(NewCrossFieldValidator(func(values map[string]any) error {
+		if values["End"].(int) < values["Start"].(int) {
+			return errEndBeforeStart
+		}
+
+		return nil
+	}, "Start", "End"))
+
+	db.validateProperties()
+
+	if len(rep.errs) != 1 || rep.widgets[0] != nil || !errors.Is(rep.errs[0], errEndBeforeStart) {
+		t.Fatalf("presented %v for %v, want %v for nil", rep.errs, rep.widgets, errEndBeforeStart)
+	}
+
+	ds.End = 7
+	db.validateProperties()
+
+	if len(rep.errs) != 2 || rep.widgets[1] != nil || rep.errs[1] != nil {
+		t.Fatalf("presented %v for %v, want the error removed", rep.errs, rep.widgets)
+	}
+
+	db.validateProperties()
+
+	if len(rep.errs) != 2 {
+		t.Errorf("presented %v again without an error", rep.errs[2:])
+	}
+}
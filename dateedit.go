@@ -251,12 +251,14 @@ func (*DateEdit) NeedsWmSize() bool {
 
 func (de *DateEdit) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	return &dateEditLayoutItem{
-		idealSize: de.dialogBaseUnitsToPixels(Size{80, 12}),
+		textBaseline: de.newTextBaseline(0, AlignCenter),
+		idealSize:    de.dialogBaseUnitsToPixels(Size{80, 12}),
 	}
 }
 
 type dateEditLayoutItem struct {
 	LayoutItemBase
+	textBaseline
 	idealSize Size // in native pixels
 }
 
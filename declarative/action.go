@@ -207,7 +207,9 @@ func setActionBoolOrCondition(setBool func(bool) error, setCond func(walk.Condit
 			if err := setBool(b); err != nil {
 				return err
 			}
-		} else if s := builder.conditionOrProperty(value); s != nil {
+		} else if s, err := builder.conditionOrProperty(value, nil); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		} else if s != nil {
 			if c, ok := s.(walk.Condition); ok {
 				setCond(c)
 			} else {
@@ -8,26 +8,17 @@
 package declarative
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
-	"regexp"
 	"strings"
 
-	"github.com/wuc656/govaluate"
 	"github.com/wuc656/walk"
+	"github.com/wuc656/walk/bindexpr"
 )
 
-var (
-	conditionsByName = make(map[string]walk.Condition)
-	propertyRE       *regexp.Regexp
-)
-
-func init() {
-	walk.AppendToWalkInit(func() {
-		propertyRE = regexp.MustCompile("[A-Za-z]+[0-9A-Za-z]*(\\.[A-Za-z]+[0-9A-Za-z]*)+")
-	})
-}
+var conditionsByName = make(map[string]walk.Condition)
 
 func MustRegisterCondition(name string, condition walk.Condition) {
 	if name == "" {
@@ -60,10 +51,11 @@ type Builder struct {
 	declWidgets              []declWidget
 	name2Window              map[string]walk.Window
 	name2DataBinder          map[string]*walk.DataBinder
+	container2DataBinder     map[walk.Container]*walk.DataBinder
 	deferredFuncs            []func() error
 	knownCompositeConditions map[string]walk.Condition
 	expressions              map[string]walk.Expression
-	functions                map[string]govaluate.ExpressionFunction
+	functions                map[string]func(args ...any) (any, error)
 }
 
 func NewBuilder(parent walk.Container) *Builder {
@@ -78,9 +70,10 @@ func NewBuilder(parent walk.Container) *Builder {
 		parent:                   parent,
 		name2Window:              make(map[string]walk.Window),
 		name2DataBinder:          make(map[string]*walk.DataBinder),
+		container2DataBinder:     make(map[walk.Container]*walk.DataBinder),
 		knownCompositeConditions: make(map[string]walk.Condition),
 		expressions:              make(map[string]walk.Expression),
-		functions:                make(map[string]govaluate.ExpressionFunction),
+		functions:                make(map[string]func(args ...any) (any, error)),
 	}
 }
 
@@ -309,6 +302,25 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 				if err := l.SetRange(widget, r); err != nil {
 					return err
 				}
+
+			case *walk.AnchorLayout:
+				if anchors := b.anchors(); anchors != (Anchors{}) {
+					// Anchor targets may be siblings that are not created yet.
+					b.Defer(func() error {
+						a, err := anchors.toW(func(name string) walk.AnchorTarget {
+							if sibling, ok := b.name2Window[name].(walk.Widget); ok {
+								return sibling
+							}
+
+							return nil
+						})
+						if err != nil {
+							return err
+						}
+
+						return l.SetAnchors(widget, a)
+					})
+				}
 			}
 		}
 	}
@@ -334,6 +346,17 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 			}
 		}
 
+		if b.bool("RightToLeft") {
+			setter, ok := wc.(walk.RightToLeftSetter)
+			if !ok {
+				return fmt.Errorf("%T does not support RightToLeft", wc)
+			}
+
+			if err := setter.SetRightToLeft(true); err != nil {
+				return err
+			}
+		}
+
 		type DelegateContainerer interface {
 			DelegateContainer() walk.Container
 		}
@@ -369,6 +392,12 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 			}
 		}
 
+		if fl, ok := layout.(FormLayout); ok {
+			if err := fl.createRows(b); err != nil {
+				return err
+			}
+		}
+
 		if val := b.widgetValue.FieldByName("Children"); val.IsValid() {
 			for _, child := range val.Interface().([]Widget) {
 				if err := child.Create(b); err != nil {
@@ -386,6 +415,9 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 				db = dataB
 
 				b.name2DataBinder[dataBinder.Name] = db
+				b.container2DataBinder[wc] = db
+
+				wc.AddDisposable(db)
 
 				if ep := db.ErrorPresenter(); ep != nil {
 					if dep, ok := ep.(walk.Disposable); ok {
@@ -501,6 +533,14 @@ func (b *Builder) alignment() Alignment2D {
 	return AlignHVDefault
 }
 
+func (b *Builder) anchors() Anchors {
+	if field := b.widgetValue.FieldByName("Anchors"); field.IsValid() {
+		return field.Interface().(Anchors)
+	}
+
+	return Anchors{}
+}
+
 func (b *Builder) bool(fieldName string) bool {
 	fieldValue := b.widgetValue.FieldByName(fieldName)
 
@@ -592,6 +632,8 @@ func (b *Builder) string(fieldName string) string {
 }
 
 func (b *Builder) initProperties() error {
+	var exprErrs []error
+
 	for _, dw := range b.declWidgets {
 		d, w := dw.d, dw.w
 
@@ -618,7 +660,12 @@ func (b *Builder) initProperties() error {
 					panic(sf.Name + " is not a property")
 				}
 
-				src := b.conditionOrProperty(val)
+				src, err := b.conditionOrProperty(val, w)
+				if err != nil {
+					// Keep going, so all broken expressions are reported at once.
+					exprErrs = append(exprErrs, fmt.Errorf("%s.%s: %w", describeWidget(st, w), sf.Name, err))
+					continue
+				}
 
 				if src == nil {
 					// No luck so far, so we assume the expression refers to
@@ -661,96 +708,222 @@ func (b *Builder) initProperties() error {
 		}
 	}
 
-	return nil
+	return errors.Join(exprErrs...)
 }
 
-func (b *Builder) conditionOrProperty(data Property) any {
-	switch val := data.(type) {
-	case bindData:
-		if val.expression == "" {
-			return nil
+func describeWidget(st reflect.Type, w walk.Window) string {
+	if name := w.Name(); name != "" {
+		return name
+	}
+
+	return st.Name()
+}
+
+// dataBinderFor returns the DataBinder of the closest container of w that has
+// one. If w is nil or has none, it returns the only DataBinder of the builder,
+// if there is exactly one.
+func (b *Builder) dataBinderFor(w walk.Window) *walk.DataBinder {
+	for w != nil {
+		if c, ok := w.(walk.Container); ok {
+			if db := b.container2DataBinder[c]; db != nil {
+				return db
+			}
 		}
 
-		e := &expression{
-			text:           val.expression,
-			subExprsByPath: subExpressions(make(map[string]walk.Expression)),
+		widget, ok := w.(walk.Widget)
+		if !ok || widget.Parent() == nil {
+			break
 		}
+		w = widget.Parent()
+	}
 
-		var singleExpr walk.Expression
+	if len(b.container2DataBinder) == 1 {
+		for _, db := range b.container2DataBinder {
+			return db
+		}
+	}
 
-		text := propertyRE.ReplaceAllStringFunc(val.expression, func(s string) string {
-			if _, ok := e.subExprsByPath[s]; !ok {
-				parts := strings.Split(s, ".")
+	return nil
+}
 
-				if w, ok := b.name2Window[parts[0]]; ok {
-					if prop := w.AsWindowBase().Property(parts[1]); prop != nil {
-						if len(s) == len(val.expression) {
-							singleExpr = prop
-							return ""
-						}
+// property returns the property a path like "nameEdit.Text" refers to, or nil.
+func (b *Builder) property(path string) walk.Property {
+	parts := strings.Split(path, ".")
+	if len(parts) != 2 {
+		return nil
+	}
 
-						if len(parts) == 2 {
-							e.addSubExpression(s, prop)
-						} else {
-							e.addSubExpression(s, walk.NewReflectExpression(prop, s[len(parts[0])+len(parts[1])+2:]))
-						}
-					} else {
-						panic(fmt.Errorf(`invalid sub expression: "%s"`, s))
-					}
-				} else if db, ok := b.name2DataBinder[parts[0]]; ok {
-					e.addSubExpression(s, db.Expression(s[len(parts[0])+1:]))
-				} else if expr, ok := b.expressions[parts[0]]; ok {
-					e.addSubExpression(s, walk.NewReflectExpression(expr, s[len(parts[0])+1:]))
-				}
+	w, ok := b.name2Window[parts[0]]
+	if !ok {
+		return nil
+	}
+
+	return w.AsWindowBase().Property(parts[1])
+}
+
+// propertyExpression returns the expression a path like "nameEdit.Text" or
+// "nameEdit.Value.Name" refers to, or nil. Path elements after the property
+// are looked up in its value by reflection.
+func (b *Builder) propertyExpression(path string) walk.Expression {
+	parts := strings.SplitN(path, ".", 3)
+	if len(parts) < 2 {
+		return nil
+	}
+
+	prop := b.property(parts[0] + "." + parts[1])
+	if prop == nil {
+		return nil
+	}
+
+	if len(parts) == 2 {
+		return prop
+	}
+
+	return walk.NewReflectExpression(prop, parts[2])
+}
+
+// subExpression returns the expression providing the value of a variable of
+// a bindScope.
+func (b *Builder) subExpression(name string) walk.Expression {
+	if c, ok := conditionsByName[name]; ok {
+		return c
+	}
+	if x, ok := b.expressions[name]; ok {
+		return x
+	}
+	if db, ok := b.name2DataBinder[name]; ok && name != "" {
+		return db.Expression("")
+	}
+
+	return b.propertyExpression(name)
+}
+
+// bindScope resolves the names used in Bind expressions. Names that are not
+// registered conditions, expressions, named DataBinders or widget properties
+// are looked up in the DataSource of dataBinder.
+type bindScope struct {
+	builder    *Builder
+	dataBinder *walk.DataBinder
+}
+
+func (s *bindScope) Root() reflect.Type {
+	if s.dataBinder == nil || s.dataBinder.DataSource() == nil {
+		// We cannot check anything before there is a data source.
+		return bindexpr.AnyType
+	}
+
+	return reflect.TypeOf(s.dataBinder.DataSource())
+}
+
+func (s *bindScope) Var(name string) (reflect.Type, bool) {
+	if _, ok := conditionsByName[name]; ok {
+		return reflect.TypeFor[bool](), true
+	}
+	if _, ok := s.builder.expressions[name]; ok {
+		return bindexpr.AnyType, true
+	}
+	if db, ok := s.builder.name2DataBinder[name]; ok && name != "" {
+		if ds := db.DataSource(); ds != nil {
+			return reflect.TypeOf(ds), true
+		}
+		return bindexpr.AnyType, true
+	}
+	if s.builder.propertyExpression(name) != nil {
+		return bindexpr.AnyType, true
+	}
+
+	return nil, false
+}
+
+func (s *bindScope) Func(name string) (any, bool) {
+	fn, ok := s.builder.functions[name]
+	if !ok {
+		return nil, false
+	}
+
+	return func(args ...any) (any, error) {
+		// Functions have always received numbers as float64.
+		for i, arg := range args {
+			if v := reflect.ValueOf(arg); v.CanInt() {
+				args[i] = float64(v.Int())
+			} else if v.CanUint() {
+				args[i] = float64(v.Uint())
+			} else if v.CanFloat() {
+				args[i] = v.Float()
 			}
+		}
 
-			return strings.Replace(s, ".", "\\.", -1)
-		})
+		return fn(args...)
+	}, true
+}
 
-		if singleExpr != nil {
-			return singleExpr
+func (b *Builder) conditionOrProperty(data Property, w walk.Window) (any, error) {
+	switch val := data.(type) {
+	case bindData:
+		if val.expression == "" {
+			return nil, nil
 		}
 
-		expr, err := govaluate.NewEvaluableExpressionWithFunctions(text, b.functions)
+		db := b.dataBinderFor(w)
+
+		prog, err := bindexpr.Compile(val.expression, &bindScope{builder: b, dataBinder: db})
 		if err != nil {
-			panic(fmt.Errorf(`invalid expression "%s": %s`, e.text, err.Error()))
+			return nil, fmt.Errorf(`invalid expression "%s": %w`, val.expression, err)
 		}
 
-		for _, token := range expr.Tokens() {
-			if token.Kind == govaluate.VARIABLE {
-				name := token.Value.(string)
-				if c, ok := conditionsByName[name]; ok {
-					e.addSubExpression(name, c)
-				}
-				if x, ok := b.expressions[name]; ok {
-					e.addSubExpression(name, x)
-				}
+		if _, ok := prog.Path(); ok {
+			// A plain path into the data source, which the DataBinder binds in
+			// both directions.
+			return nil, nil
+		}
+
+		vars := prog.Vars()
+
+		if len(vars) == 1 && !prog.UsesRoot() && strings.TrimSpace(val.expression) == vars[0] {
+			if prop := b.property(vars[0]); prop != nil {
+				return prop, nil
 			}
 		}
 
-		e.expr = expr
+		if prog.UsesRoot() && db == nil {
+			return nil, fmt.Errorf(`invalid expression "%s": no DataBinder to look up names in`, val.expression)
+		}
 
-		if _, err := e.expr.Eval(e.subExprsByPath); err != nil {
-			// We hope for the best and leave it to a DataBinder...
-			return nil
+		e := &expression{
+			prog:           prog,
+			subExprsByPath: subExpressions(make(map[string]walk.Expression)),
 		}
 
-		if _, ok := e.Value().(bool); ok {
-			return &boolExpression{expression: e}
+		for _, name := range vars {
+			e.addSubExpression(name, b.subExpression(name))
 		}
 
-		return e
+		if prog.UsesRoot() {
+			e.root = db.Expression("")
+			e.attach(e.root.Changed())
+			e.attach(db.Submitted())
+		}
+
+		if t := prog.Type(); t.Kind() == reflect.Bool {
+			return &boolExpression{expression: e}, nil
+		} else if t == bindexpr.AnyType {
+			if _, ok := e.Value().(bool); ok {
+				return &boolExpression{expression: e}, nil
+			}
+		}
+
+		return e, nil
 
 	case walk.Expression:
-		return val
+		return val, nil
 	}
 
-	return nil
+	return nil, nil
 }
 
 type expression struct {
-	expr                   *govaluate.EvaluableExpression
-	text                   string
+	prog                   *bindexpr.Program
+	root                   walk.Expression
 	subExprsByPath         subExpressions
 	subExprsChangedHandles []int
 	changedPublisher       walk.EventPublisher
@@ -768,13 +941,18 @@ func (se subExpressions) Get(name string) (any, error) {
 }
 
 func (e *expression) String() string {
-	return e.text
+	return e.prog.String()
 }
 
 func (e *expression) Value() any {
-	val, err := e.expr.Eval(e.subExprsByPath)
+	var root any
+	if e.root != nil {
+		root = e.root.Value()
+	}
+
+	val, err := e.prog.Eval(root, e.subExprsByPath)
 	if err != nil {
-		log.Printf(`walk - failed to evaluate expression "%s": %s`, e.text, err.Error())
+		log.Printf(`walk - failed to evaluate expression "%s": %s`, e.prog, err.Error())
 	}
 
 	e.lastReportedValue = val
@@ -789,7 +967,11 @@ func (e *expression) Changed() *walk.Event {
 func (e *expression) addSubExpression(path string, subExpr walk.Expression) {
 	e.subExprsByPath[path] = subExpr
 
-	handle := subExpr.Changed().Attach(func() {
+	e.attach(subExpr.Changed())
+}
+
+func (e *expression) attach(event *walk.Event) {
+	handle := event.Attach(func() {
 		last := e.lastReportedValue
 		if v := e.Value(); v != last {
 			e.changedPublisher.Publish()
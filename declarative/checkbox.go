@@ -40,6 +40,7 @@ type CheckBox struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
@@ -42,6 +42,7 @@ type ComboBox struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
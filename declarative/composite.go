@@ -43,6 +43,7 @@ type Composite struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
@@ -52,9 +53,10 @@ type Composite struct {
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
-	Layout     Layout
+	Children    []Widget
+	DataBinder  DataBinder
+	Layout      Layout
+	RightToLeft bool
 
 	// Composite
 
@@ -48,6 +48,7 @@ type CustomWidget struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
@@ -14,16 +14,18 @@ import (
 )
 
 type DataBinder struct {
-	AssignTo            **walk.DataBinder
-	AutoSubmit          bool
-	AutoSubmitDelay     time.Duration
-	DataSource          any
-	ErrorPresenter      ErrorPresenter
-	Name                string
-	OnCanSubmitChanged  walk.EventHandler
-	OnDataSourceChanged walk.EventHandler
-	OnReset             walk.EventHandler
-	OnSubmitted         walk.EventHandler
+	AssignTo             **walk.DataBinder
+	AsyncValidators      []walk.AsyncValidator
+	AutoSubmit           bool
+	AutoSubmitDelay      time.Duration
+	CrossFieldValidators []walk.CrossFieldValidator
+	DataSource           any
+	ErrorPresenter       ErrorPresenter
+	Name                 string
+	OnCanSubmitChanged   walk.EventHandler
+	OnDataSourceChanged  walk.EventHandler
+	OnReset              walk.EventHandler
+	OnSubmitted          walk.EventHandler
 }
 
 func (db DataBinder) create() (*walk.DataBinder, error) {
@@ -43,6 +45,13 @@ func (db DataBinder) create() (*walk.DataBinder, error) {
 
 	b.SetDataSource(db.DataSource)
 
+	for _, v := range db.CrossFieldValidators {
+		b.AddCrossFieldValidator(v)
+	}
+	for _, v := range db.AsyncValidators {
+		b.AddAsyncValidator(v)
+	}
+
 	b.SetAutoSubmit(db.AutoSubmit)
 	b.SetAutoSubmitDelay(db.AutoSubmitDelay)
 
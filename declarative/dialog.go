@@ -41,9 +41,10 @@ type Dialog struct {
 
 	// Container
 
-	DataBinder DataBinder
-	Layout     Layout
-	Children   []Widget
+	DataBinder  DataBinder
+	Layout      Layout
+	Children    []Widget
+	RightToLeft bool
 
 	// Form
 
@@ -102,9 +103,10 @@ func (d Dialog) Create(owner walk.Form) error {
 		Accessibility:      d.Accessibility,
 
 		// Container
-		Children:   d.Children,
-		DataBinder: d.DataBinder,
-		Layout:     d.Layout,
+		Children:    d.Children,
+		DataBinder:  d.DataBinder,
+		Layout:      d.Layout,
+		RightToLeft: d.RightToLeft,
 
 		// Form
 		Icon:  d.Icon,
@@ -11,12 +11,13 @@ import (
 )
 
 type DialogEx struct {
-	Background Brush
-	Layout     Layout
-	Children   []Widget
-	Icon       Property
-	Title      string
-	Size       Size
+	Background  Brush
+	Layout      Layout
+	Children    []Widget
+	RightToLeft bool
+	Icon        Property
+	Title       string
+	Size        Size
 
 	AssignTo **walk.DialogEx
 }
@@ -37,8 +38,9 @@ func (d DialogEx) Create(owner walk.Form) error {
 		Enabled:    true,
 
 		// Container
-		Children: d.Children,
-		Layout:   d.Layout,
+		Children:    d.Children,
+		Layout:      d.Layout,
+		RightToLeft: d.RightToLeft,
 
 		// Form
 		Icon:  d.Icon,
@@ -43,6 +43,7 @@ type GradientComposite struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
@@ -52,9 +53,10 @@ type GradientComposite struct {
 
 	// Container
 
-	Children   []Widget
-	Layout     Layout
-	DataBinder DataBinder
+	Children    []Widget
+	Layout      Layout
+	DataBinder  DataBinder
+	RightToLeft bool
 
 	// GradientComposite
 
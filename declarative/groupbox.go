@@ -40,6 +40,7 @@ type GroupBox struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
@@ -49,9 +50,10 @@ type GroupBox struct {
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
-	Layout     Layout
+	Children    []Widget
+	DataBinder  DataBinder
+	Layout      Layout
+	RightToLeft bool
 
 	// GroupBox
 
@@ -28,6 +28,18 @@ type bindData struct {
 	validator  Validator
 }
 
+// Bind binds a property to expression, which is compiled by package bindexpr
+// and type checked against the DataSource of the closest DataBinder.
+//
+// Names in expression refer to registered conditions, Expressions and
+// Functions of the Builder, named DataBinders, properties of named widgets like
+// nameEdit.Text, optionally followed by more fields like nameEdit.Value.Name,
+// and otherwise to the DataSource.
+//
+// Expressions were evaluated by govaluate before. Its =~, !~, in and bitwise
+// operators are not supported anymore and are reported as errors when the
+// widgets are created, use Functions instead. Its date literals are plain
+// strings now.
 func Bind(expression string, validators ...Validator) Property {
 	bd := bindData{expression: expression}
 	switch len(validators) {
@@ -142,9 +154,10 @@ type formInfo struct {
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
-	Layout     Layout
+	Children    []Widget
+	DataBinder  DataBinder
+	Layout      Layout
+	RightToLeft bool
 
 	// Form
 
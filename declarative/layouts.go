@@ -9,6 +9,7 @@ package declarative
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/wuc656/walk"
 )
@@ -72,11 +73,12 @@ func setLayoutSpacing(layout walk.Layout, spacing int, spacingZero bool) error {
 }
 
 type HBox struct {
-	Margins     Margins
-	Alignment   Alignment2D
-	Spacing     int
-	MarginsZero bool
-	SpacingZero bool
+	Margins           Margins
+	Alignment         Alignment2D
+	BaselineAlignment bool
+	Spacing           int
+	MarginsZero       bool
+	SpacingZero       bool
 }
 
 func (hb HBox) Create() (walk.Layout, error) {
@@ -94,6 +96,10 @@ func (hb HBox) Create() (walk.Layout, error) {
 		return nil, err
 	}
 
+	if err := l.SetBaselineAlignment(hb.BaselineAlignment); err != nil {
+		return nil, err
+	}
+
 	return l, nil
 }
 
@@ -124,13 +130,14 @@ func (vb VBox) Create() (walk.Layout, error) {
 }
 
 type Grid struct {
-	Rows        int
-	Columns     int
-	Margins     Margins
-	Alignment   Alignment2D
-	Spacing     int
-	MarginsZero bool
-	SpacingZero bool
+	Rows              int
+	Columns           int
+	Margins           Margins
+	Alignment         Alignment2D
+	BaselineAlignment bool
+	Spacing           int
+	MarginsZero       bool
+	SpacingZero       bool
 }
 
 func (g Grid) Create() (walk.Layout, error) {
@@ -152,15 +159,20 @@ func (g Grid) Create() (walk.Layout, error) {
 		return nil, err
 	}
 
+	if err := l.SetBaselineAlignment(g.BaselineAlignment); err != nil {
+		return nil, err
+	}
+
 	return l, nil
 }
 
 type Flow struct {
-	Margins     Margins
-	Alignment   Alignment2D
-	Spacing     int
-	MarginsZero bool
-	SpacingZero bool
+	Margins           Margins
+	Alignment         Alignment2D
+	BaselineAlignment bool
+	Spacing           int
+	MarginsZero       bool
+	SpacingZero       bool
 }
 
 func (f Flow) Create() (walk.Layout, error) {
@@ -178,5 +190,167 @@ func (f Flow) Create() (walk.Layout, error) {
 		return nil, err
 	}
 
+	if err := l.SetBaselineAlignment(f.BaselineAlignment); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+type AnchorLayout struct {
+	Margins     Margins
+	MarginsZero bool
+}
+
+func (al AnchorLayout) Create() (walk.Layout, error) {
+	l := walk.NewAnchorLayout()
+
+	if err := setLayoutMargins(l, al.Margins, al.MarginsZero); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+type AnchorEdge int
+
+const (
+	AnchorNone     = AnchorEdge(walk.AnchorNone)
+	AnchorLeft     = AnchorEdge(walk.AnchorLeft)
+	AnchorTop      = AnchorEdge(walk.AnchorTop)
+	AnchorRight    = AnchorEdge(walk.AnchorRight)
+	AnchorBottom   = AnchorEdge(walk.AnchorBottom)
+	AnchorBaseline = AnchorEdge(walk.AnchorBaseline)
+)
+
+// Anchor attaches an edge of a widget in an AnchorLayout to Edge of the
+// sibling widget named To, or of the parent if To is empty.
+type Anchor struct {
+	To     string
+	Edge   AnchorEdge
+	Offset int
+}
+
+// Anchors holds the Anchor of each edge of a widget in an AnchorLayout. See
+// walk.Anchors for how they are applied.
+type Anchors struct {
+	Left     Anchor
+	Top      Anchor
+	Right    Anchor
+	Bottom   Anchor
+	Baseline Anchor
+}
+
+// toW converts a to a walk.Anchor. name2Target returns the sibling with the
+// given name, or nil if there is none.
+func (a Anchor) toW(name2Target func(name string) walk.AnchorTarget) (walk.Anchor, error) {
+	anchor := walk.Anchor{Edge: walk.AnchorEdge(a.Edge), Offset: a.Offset}
+
+	if a.To != "" {
+		if anchor.Target = name2Target(a.To); anchor.Target == nil {
+			return walk.Anchor{}, fmt.Errorf("unknown anchor target %q", a.To)
+		}
+	}
+
+	return anchor, nil
+}
+
+func (a Anchors) toW(name2Target func(name string) walk.AnchorTarget) (walk.Anchors, error) {
+	var anchors walk.Anchors
+
+	for _, edge := range []struct {
+		src Anchor
+		dst *walk.Anchor
+	}{
+		{a.Left, &anchors.Left},
+		{a.Top, &anchors.Top},
+		{a.Right, &anchors.Right},
+		{a.Bottom, &anchors.Bottom},
+		{a.Baseline, &anchors.Baseline},
+	} {
+		anchor, err := edge.src.toW(name2Target)
+		if err != nil {
+			return walk.Anchors{}, err
+		}
+
+		*edge.dst = anchor
+	}
+
+	return anchors, nil
+}
+
+// FormRow is a row of a FormLayout.
+type FormRow struct {
+	// Label is the text of the label of Field. If nil, Field spans both
+	// columns.
+	Label Property
+
+	// Field is the widget of the row. It must not be nil.
+	Field Widget
+}
+
+// FormLayout arranges Rows of label/field pairs in two columns. Children of
+// the container follow the rows, spanning both columns.
+type FormLayout struct {
+	Margins     Margins
+	Spacing     int
+	MarginsZero bool
+	SpacingZero bool
+	Rows        []FormRow
+}
+
+func (fl FormLayout) Create() (walk.Layout, error) {
+	l := walk.NewFormLayout()
+
+	if err := setLayoutMargins(l, fl.Margins, fl.MarginsZero); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutSpacing(l, fl.Spacing, fl.SpacingZero); err != nil {
+		return nil, err
+	}
+
 	return l, nil
 }
+
+// createRows creates the labels and fields of fl in the parent of builder.
+func (fl FormLayout) createRows(builder *Builder) error {
+	parent := builder.Parent()
+
+	l, ok := parent.Layout().(*walk.FormLayout)
+	if !ok {
+		return errors.New("FormLayout rows require a *walk.FormLayout")
+	}
+
+	for i, row := range fl.Rows {
+		if row.Field == nil {
+			return fmt.Errorf("FormLayout row %d has no Field", i)
+		}
+
+		var label *walk.Label
+		if row.Label != nil {
+			if err := (Label{AssignTo: &label, Text: row.Label}).Create(builder); err != nil {
+				return err
+			}
+		}
+
+		if err := row.Field.Create(builder); err != nil {
+			return err
+		}
+
+		if label == nil {
+			continue
+		}
+
+		children := parent.Children()
+		field := children.At(children.Len() - 1)
+
+		// Deferred, so the label has its final text when it becomes the
+		// accessible name of field.
+		builder.Defer(func() error {
+			return l.SetLabel(field, label)
+		})
+	}
+
+	return nil
+}
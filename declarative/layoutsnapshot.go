@@ -0,0 +1,781 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/wuc656/walk"
+)
+
+// Metrics used to estimate the sizes of leaf widgets in layout snapshots. They
+// approximate the default GUI font at 96 DPI.
+const (
+	snapshotCharWidth  = 7
+	snapshotTextHeight = 15
+	snapshotTextAscent = 12
+)
+
+// LayoutSnapshotCfg configures LayoutSnapshot and MatchLayoutSnapshot.
+type LayoutSnapshotCfg struct {
+	// Sizes lists the client sizes, in 1/96" units, to lay out the root
+	// widget at.
+	Sizes []Size
+
+	// DPIs lists the DPIs to lay out at. If empty, only 96 DPI is used.
+	DPIs []int
+
+	// LeafItemCfg, if not nil, may return a description of the layout
+	// properties of a widget without children, overriding the built-in
+	// estimate. Returning nil keeps the estimate.
+	LeafItemCfg func(w Widget) *walk.LayoutItemCfg
+
+	// Update makes MatchLayoutSnapshot (re)write the golden file instead of
+	// comparing against it.
+	Update bool
+}
+
+// LayoutSnapshot lays out the declarative widget tree rooted at root for each
+// combination of cfg.Sizes and cfg.DPIs, without creating any windows, and
+// returns the resulting bounds of every widget as stable text.
+//
+// Containers and their layouts are taken from the declarative description.
+// Sizes of leaf widgets are estimated from their type and text, unless
+// cfg.LeafItemCfg provides them. Bounds are in native pixels, relative to the
+// parent container.
+func LayoutSnapshot(root Widget, cfg *LayoutSnapshotCfg) (string, error) {
+	if len(cfg.Sizes) == 0 {
+		return "", errors.New("at least one size required")
+	}
+
+	dpis := cfg.DPIs
+	if len(dpis) == 0 {
+		dpis = []int{96}
+	}
+
+	var sb strings.Builder
+
+	for _, dpi := range dpis {
+		for _, size := range cfg.Sizes {
+			s := &layoutSnapshotter{
+				ctx: walk.NewLayoutContext(dpi),
+				cfg: cfg,
+			}
+
+			node, err := s.node(root)
+			if err != nil {
+				return "", err
+			}
+
+			container, ok := node.item.(walk.ContainerLayoutItem)
+			if !ok {
+				return "", fmt.Errorf("root %s is not a container", node.typeName)
+			}
+
+			clientSize := walk.SizeFrom96DPI(size.toW(), dpi)
+
+			bounds := map[walk.LayoutItem]walk.Rectangle{
+				container: {Width: clientSize.Width, Height: clientSize.Height},
+			}
+			for _, result := range walk.PerformLayoutTree(container, clientSize) {
+				for _, ri := range result.Items() {
+					bounds[ri.Item] = ri.Bounds
+				}
+			}
+
+			fmt.Fprintf(&sb, "# %dx%d @ %d DPI\n", size.Width, size.Height, dpi)
+			node.write(&sb, 0, bounds)
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// MatchLayoutSnapshot compares the LayoutSnapshot of root against the contents
+// of goldenFile and returns an error describing the first differences, if any.
+//
+// If cfg.Update is true, goldenFile is written instead.
+func MatchLayoutSnapshot(goldenFile string, root Widget, cfg *LayoutSnapshotCfg) error {
+	snapshot, err := LayoutSnapshot(root, cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Update {
+		if err := os.MkdirAll(filepath.Dir(goldenFile), 0755); err != nil {
+			return err
+		}
+
+		return os.WriteFile(goldenFile, []byte(snapshot), 0644)
+	}
+
+	data, err := os.ReadFile(goldenFile)
+	if err != nil {
+		return err
+	}
+
+	golden := strings.ReplaceAll(string(data), "\r\n", "\n")
+	if golden == snapshot {
+		return nil
+	}
+
+	const maxDiffs = 10
+
+	wantLines := strings.Split(golden, "\n")
+	gotLines := strings.Split(snapshot, "\n")
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "layout snapshot differs from %s:\n", goldenFile)
+
+	var diffs int
+	for i := 0; i < max(len(wantLines), len(gotLines)) && diffs < maxDiffs; i++ {
+		var want, got string
+		if i < len(wantLines) {
+			want = wantLines[i]
+		}
+		if i < len(gotLines) {
+			got = gotLines[i]
+		}
+
+		if want != got {
+			fmt.Fprintf(&sb, "line %d:\n-%s\n+%s\n", i+1, want, got)
+			diffs++
+		}
+	}
+
+	return errors.New(sb.String())
+}
+
+type layoutSnapshotNode struct {
+	typeName string
+	name     string
+	item     walk.LayoutItem
+	children []*layoutSnapshotNode
+}
+
+func (n *layoutSnapshotNode) write(sb *strings.Builder, depth int, bounds map[walk.LayoutItem]walk.Rectangle) {
+	sb.WriteString(strings.Repeat("  ", depth))
+	sb.WriteString(n.typeName)
+	if n.name != "" {
+		fmt.Fprintf(sb, " %q", n.name)
+	}
+
+	if !n.item.Visible() {
+		sb.WriteString(" hidden\n")
+	} else if b, ok := bounds[n.item]; ok {
+		fmt.Fprintf(sb, " %d,%d %dx%d\n", b.X, b.Y, b.Width, b.Height)
+	} else {
+		sb.WriteString(" -\n")
+	}
+
+	for _, child := range n.children {
+		child.write(sb, depth+1, bounds)
+	}
+}
+
+type layoutSnapshotter struct {
+	ctx     *walk.LayoutContext
+	cfg     *LayoutSnapshotCfg
+	rows    int
+	columns int
+	row     int
+	col     int
+}
+
+// node creates the layout item tree for the declarative widget d.
+func (s *layoutSnapshotter) node(d Widget) (*layoutSnapshotNode, error) {
+	v := reflect.ValueOf(d)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	name, err := snapshotString(v, "Name")
+	if err != nil {
+		return nil, err
+	}
+
+	n := &layoutSnapshotNode{
+		typeName: v.Type().Name(),
+		name:     name,
+	}
+
+	switch w := d.(type) {
+	case HSplitter:
+		n.item, n.children, err = s.splitter(walk.Horizontal, w.HandleWidth, w.Children)
+
+	case VSplitter:
+		n.item, n.children, err = s.splitter(walk.Vertical, w.HandleWidth, w.Children)
+
+	case GroupBox:
+		var content walk.ContainerLayoutItem
+		if content, n.children, err = s.container(w.Layout, w.Children); err == nil {
+			n.item = walk.NewGroupBoxLayoutItem(s.ctx, &walk.GroupBoxLayoutItemCfg{
+				HeaderHeight: snapshotTextHeight,
+				Content:      content,
+			})
+		}
+
+	case HSpacer:
+		n.item = s.spacer(walk.Size{Width: w.Size}, w.Size == 0, walk.ShrinkableHorz|walk.GrowableHorz|walk.GreedyHorz, w.GreedyLocallyOnly)
+
+	case VSpacer:
+		n.item = s.spacer(walk.Size{Height: w.Size}, w.Size == 0, walk.ShrinkableVert|walk.GrowableVert|walk.GreedyVert, w.GreedyLocallyOnly)
+
+	default:
+		var children reflect.Value
+		if children, err = snapshotField(v, "Children", reflect.TypeFor[[]Widget]()); err != nil {
+			return nil, err
+		}
+
+		if children.IsValid() {
+			var layout Layout
+			if val := v.FieldByName("Layout"); val.IsValid() {
+				layout, _ = val.Interface().(Layout)
+			}
+
+			n.item, n.children, err = s.container(layout, children.Interface().([]Widget))
+		} else {
+			var cfg *walk.LayoutItemCfg
+			if s.cfg.LeafItemCfg != nil {
+				cfg = s.cfg.LeafItemCfg(d)
+			}
+			if cfg == nil {
+				estimate := estimatedLayoutItemCfg(d, v)
+				cfg = &estimate
+			}
+
+			n.item = walk.NewLayoutItemWithCfg(s.ctx, cfg)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	geometry := n.item.Geometry()
+	minSize, err := snapshotSize(v, "MinSize")
+	if err != nil {
+		return nil, err
+	}
+	if !minSize.IsZero() {
+		geometry.MinSize = walk.SizeFrom96DPI(minSize, s.ctx.DPI())
+	}
+	maxSize, err := snapshotSize(v, "MaxSize")
+	if err != nil {
+		return nil, err
+	}
+	if !maxSize.IsZero() {
+		geometry.MaxSize = walk.SizeFrom96DPI(maxSize, s.ctx.DPI())
+	}
+	alignment, err := snapshotField(v, "Alignment", reflect.TypeFor[Alignment2D]())
+	if err != nil {
+		return nil, err
+	}
+	if alignment.IsValid() {
+		geometry.Alignment = walk.Alignment2D(alignment.Interface().(Alignment2D))
+	}
+	alwaysConsumeSpace, err := snapshotField(v, "AlwaysConsumeSpace", reflect.TypeFor[bool]())
+	if err != nil {
+		return nil, err
+	}
+	if alwaysConsumeSpace.IsValid() {
+		geometry.ConsumingSpaceWhenInvisible = alwaysConsumeSpace.Bool()
+	}
+	if val := v.FieldByName("Visible"); val.IsValid() {
+		if visible, ok := val.Interface().(bool); ok && !visible {
+			walk.SetLayoutItemVisible(n.item, false)
+		}
+	}
+
+	return n, nil
+}
+
+func (s *layoutSnapshotter) spacer(sizeHint walk.Size, greedy bool, greedyFlags walk.LayoutFlags, greedyLocallyOnly bool) walk.LayoutItem {
+	var flags walk.LayoutFlags
+	if greedy {
+		flags = greedyFlags
+	}
+
+	return walk.NewSpacerLayoutItemWithCfg(s.ctx, &walk.SpacerCfg{
+		LayoutFlags:       flags,
+		SizeHint:          sizeHint,
+		GreedyLocallyOnly: greedyLocallyOnly,
+	})
+}
+
+// container creates the layout item for a container with layout and children,
+// following what Builder.InitWidget does for real widgets.
+func (s *layoutSnapshotter) container(layout Layout, children []Widget) (walk.ContainerLayoutItem, []*layoutSnapshotNode, error) {
+	if grid, ok := layout.(Grid); ok && len(children) > 0 {
+		return s.grid(grid, children)
+	}
+	if form, ok := layout.(FormLayout); ok {
+		return s.form(form, children)
+	}
+
+	var nodes []*layoutSnapshotNode
+	var items []walk.LayoutItem
+	var stretchFactors []int
+
+	for _, child := range children {
+		node, err := s.node(child)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		stretchFactor, err := snapshotInt(reflect.ValueOf(child), "StretchFactor")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nodes = append(nodes, node)
+		items = append(items, node.item)
+		stretchFactors = append(stretchFactors, stretchFactor)
+	}
+
+	orientation := walk.Orientation(walk.Horizontal)
+	margins := Margins{}
+	spacing := 6
+	var alignment Alignment2D
+	var baselineAlignment bool
+
+	if len(children) > 0 {
+		switch l := layout.(type) {
+		case HBox:
+			margins, spacing, alignment = snapshotMargins(l.Margins, l.MarginsZero), snapshotSpacing(l.Spacing, l.SpacingZero), l.Alignment
+			baselineAlignment = l.BaselineAlignment
+
+		case VBox:
+			orientation = walk.Vertical
+			margins, spacing, alignment = snapshotMargins(l.Margins, l.MarginsZero), snapshotSpacing(l.Spacing, l.SpacingZero), l.Alignment
+
+		case Flow:
+			return walk.NewFlowLayoutItem(s.ctx, &walk.FlowLayoutItemCfg{
+				Margins:           snapshotMargins(l.Margins, l.MarginsZero).toW(),
+				Spacing:           snapshotSpacing(l.Spacing, l.SpacingZero),
+				Alignment:         walk.Alignment2D(l.Alignment),
+				BaselineAlignment: l.BaselineAlignment,
+				Children:          items,
+				StretchFactors:    stretchFactors,
+			}), nodes, nil
+
+		case AnchorLayout:
+			name2Target := func(name string) walk.AnchorTarget {
+				for _, node := range nodes {
+					if node.name == name {
+						return node.item
+					}
+				}
+
+				return nil
+			}
+
+			var anchors []walk.Anchors
+			for _, child := range children {
+				var a Anchors
+				val, err := snapshotField(reflect.ValueOf(child), "Anchors", reflect.TypeFor[Anchors]())
+				if err != nil {
+					return nil, nil, err
+				}
+				if val.IsValid() {
+					a = val.Interface().(Anchors)
+				}
+
+				wa, err := a.toW(name2Target)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				anchors = append(anchors, wa)
+			}
+
+			return walk.NewAnchorLayoutItem(s.ctx, &walk.AnchorLayoutItemCfg{
+				Margins:  snapshotMargins(l.Margins, l.MarginsZero).toW(),
+				Children: items,
+				Anchors:  anchors,
+			}), nodes, nil
+
+		case nil:
+
+		default:
+			return nil, nil, fmt.Errorf("unsupported layout %T", layout)
+		}
+	}
+
+	return walk.NewBoxLayoutItem(s.ctx, &walk.BoxLayoutItemCfg{
+		Orientation:       orientation,
+		Margins:           margins.toW(),
+		Spacing:           spacing,
+		Alignment:         walk.Alignment2D(alignment),
+		BaselineAlignment: baselineAlignment,
+		Children:          items,
+		StretchFactors:    stretchFactors,
+	}), nodes, nil
+}
+
+func (s *layoutSnapshotter) grid(g Grid, children []Widget) (walk.ContainerLayoutItem, []*layoutSnapshotNode, error) {
+	rows, columns, row, col := s.rows, s.columns, s.row, s.col
+	defer func() {
+		s.rows, s.columns, s.row, s.col = rows, columns, row, col
+	}()
+
+	s.rows, s.columns, s.row, s.col = g.Rows, g.Columns, 0, 0
+
+	var nodes []*layoutSnapshotNode
+	var cells []walk.GridLayoutCellCfg
+	var rowStretchFactors, columnStretchFactors []int
+
+	stretchFactorAt := func(factors []int, i int) int {
+		if i < len(factors) {
+			return factors[i]
+		}
+		return 1
+	}
+	setStretchFactor := func(factors []int, i, factor int) []int {
+		for len(factors) <= i {
+			factors = append(factors, 1)
+		}
+		factors[i] = factor
+		return factors
+	}
+
+	for _, child := range children {
+		v := reflect.ValueOf(child)
+
+		var err error
+		intField := func(fieldName string) int {
+			var i int
+			if err == nil {
+				i, err = snapshotInt(v, fieldName)
+			}
+			return i
+		}
+
+		row := intField("Row")
+		rowSpan := max(intField("RowSpan"), 1)
+		column := intField("Column")
+		columnSpan := max(intField("ColumnSpan"), 1)
+		stretchFactor := max(intField("StretchFactor"), 1)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		columnStretchFactors = setStretchFactor(columnStretchFactors, column, max(stretchFactorAt(columnStretchFactors, column), stretchFactor))
+		rowStretchFactors = setStretchFactor(rowStretchFactors, row, max(stretchFactorAt(rowStretchFactors, row), stretchFactor))
+
+		if s.rows > 0 && column == 0 && row == 0 {
+			if s.row+rowSpan > s.rows {
+				s.col++
+				s.row = 0
+			}
+
+			column = s.col
+			row = s.row
+
+			s.row += rowSpan
+		}
+
+		if s.columns > 0 && row == 0 && column == 0 {
+			if s.col+columnSpan > s.columns {
+				s.row++
+				s.col = 0
+			}
+
+			row = s.row
+			column = s.col
+
+			s.col += columnSpan
+		}
+
+		node, err := s.node(child)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nodes = append(nodes, node)
+		cells = append(cells, walk.GridLayoutCellCfg{
+			Item:  node.item,
+			Range: walk.Rectangle{X: column, Y: row, Width: columnSpan, Height: rowSpan},
+		})
+	}
+
+	return walk.NewGridLayoutItem(s.ctx, &walk.GridLayoutItemCfg{
+		Margins:              snapshotMargins(g.Margins, g.MarginsZero).toW(),
+		Spacing:              snapshotSpacing(g.Spacing, g.SpacingZero),
+		Alignment:            walk.Alignment2D(g.Alignment),
+		BaselineAlignment:    g.BaselineAlignment,
+		RowStretchFactors:    rowStretchFactors,
+		ColumnStretchFactors: columnStretchFactors,
+		Cells:                cells,
+	}), nodes, nil
+}
+
+func (s *layoutSnapshotter) form(f FormLayout, children []Widget) (walk.ContainerLayoutItem, []*layoutSnapshotNode, error) {
+	var nodes []*layoutSnapshotNode
+	var rows []walk.FormLayoutRowCfg
+
+	for i, row := range f.Rows {
+		if row.Field == nil {
+			return nil, nil, fmt.Errorf("FormLayout row %d has no Field", i)
+		}
+
+		var rowCfg walk.FormLayoutRowCfg
+
+		if row.Label != nil {
+			node, err := s.node(Label{Text: row.Label})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			nodes = append(nodes, node)
+			rowCfg.Label = node.item
+		}
+
+		node, err := s.node(row.Field)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nodes = append(nodes, node)
+		rowCfg.Field = node.item
+
+		rows = append(rows, rowCfg)
+	}
+
+	for _, child := range children {
+		node, err := s.node(child)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nodes = append(nodes, node)
+		rows = append(rows, walk.FormLayoutRowCfg{Field: node.item})
+	}
+
+	return walk.NewFormLayoutItem(s.ctx, &walk.FormLayoutItemCfg{
+		Margins: snapshotMargins(f.Margins, f.MarginsZero).toW(),
+		Spacing: snapshotSpacing(f.Spacing, f.SpacingZero),
+		Rows:    rows,
+	}), nodes, nil
+}
+
+func (s *layoutSnapshotter) splitter(orientation walk.Orientation, handleWidth int, children []Widget) (walk.ContainerLayoutItem, []*layoutSnapshotNode, error) {
+	if handleWidth <= 0 {
+		handleWidth = 5
+	}
+
+	var nodes []*layoutSnapshotNode
+	var items []walk.LayoutItem
+	var stretchFactors []int
+
+	for _, child := range children {
+		node, err := s.node(child)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		stretchFactor, err := snapshotInt(reflect.ValueOf(child), "StretchFactor")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nodes = append(nodes, node)
+		items = append(items, node.item)
+		stretchFactors = append(stretchFactors, stretchFactor)
+	}
+
+	return walk.NewSplitterLayoutItem(s.ctx, &walk.SplitterLayoutItemCfg{
+		Orientation:    orientation,
+		HandleWidth:    handleWidth,
+		Children:       items,
+		StretchFactors: stretchFactors,
+	}), nodes, nil
+}
+
+// estimatedLayoutItemCfg approximates the layout properties the walk widget
+// created for the declarative widget d would report.
+func estimatedLayoutItemCfg(d Widget, v reflect.Value) walk.LayoutItemCfg {
+	textWidth := snapshotCharWidth * utf8.RuneCountInString(snapshotText(v))
+	dlu := func(x, y int) walk.Size {
+		return walk.Size{Width: (x*snapshotCharWidth + 2) / 4, Height: (y*snapshotTextHeight + 4) / 8}
+	}
+	topBaseline := func(height int) int {
+		return snapshotTextAscent
+	}
+	centeredBaseline := func(height int) int {
+		return (height-snapshotTextHeight)/2 + snapshotTextAscent
+	}
+	greedy := walk.LayoutItemCfg{
+		LayoutFlags: walk.ShrinkableHorz | walk.ShrinkableVert | walk.GrowableHorz | walk.GrowableVert | walk.GreedyHorz | walk.GreedyVert,
+		MinSize:     walk.Size{Width: 50, Height: 50},
+		IdealSize:   walk.Size{Width: 100, Height: 100},
+	}
+
+	switch w := d.(type) {
+	case Label, TextLabel, LinkLabel, NumberLabel, DateLabel:
+		return walk.LayoutItemCfg{IdealSize: walk.Size{Width: textWidth, Height: snapshotTextHeight}, Baseline: topBaseline}
+
+	case PushButton, SplitButton, ToolButton:
+		return walk.LayoutItemCfg{IdealSize: walk.Size{Width: max(75, textWidth+16), Height: 23}, Baseline: centeredBaseline}
+
+	case CheckBox, RadioButton:
+		return walk.LayoutItemCfg{IdealSize: walk.Size{Width: textWidth + 20, Height: 17}, Baseline: centeredBaseline}
+
+	case LineEdit:
+		const greedyLimit = 29
+
+		flags := walk.ShrinkableHorz | walk.GrowableHorz
+		chars := greedyLimit
+		if w.MaxLength <= 0 || w.MaxLength > greedyLimit {
+			flags |= walk.GreedyHorz
+		} else {
+			chars = w.MaxLength
+		}
+
+		height := dlu(50, 12).Height
+		return walk.LayoutItemCfg{
+			LayoutFlags: flags,
+			MinSize:     walk.Size{Width: 2 * snapshotCharWidth, Height: height},
+			IdealSize:   walk.Size{Width: (chars + 1) * snapshotCharWidth, Height: height},
+			Baseline:    centeredBaseline,
+		}
+
+	case NumberEdit:
+		height := dlu(50, 12).Height
+		return walk.LayoutItemCfg{
+			LayoutFlags: walk.ShrinkableHorz | walk.GrowableHorz,
+			MinSize:     walk.Size{Width: 20, Height: height},
+			IdealSize:   walk.Size{Width: 80, Height: height},
+			Baseline:    centeredBaseline,
+		}
+
+	case DateEdit:
+		return walk.LayoutItemCfg{LayoutFlags: walk.GrowableHorz, IdealSize: dlu(80, 12), Baseline: centeredBaseline}
+
+	case ComboBox:
+		flags := walk.LayoutFlags(walk.GrowableHorz)
+		if w.Editable {
+			flags |= walk.GreedyHorz
+		}
+
+		size := dlu(30, 12)
+		size.Height++
+		return walk.LayoutItemCfg{LayoutFlags: flags, IdealSize: size, Baseline: centeredBaseline}
+
+	case ProgressBar:
+		return walk.LayoutItemCfg{
+			LayoutFlags: walk.ShrinkableHorz | walk.GrowableHorz | walk.GreedyHorz,
+			MinSize:     dlu(10, 14),
+			IdealSize:   dlu(50, 14),
+		}
+
+	case Slider:
+		if w.Orientation == Vertical {
+			return walk.LayoutItemCfg{LayoutFlags: walk.ShrinkableVert | walk.GrowableVert, IdealSize: walk.Size{Width: 30, Height: 100}}
+		}
+
+		return walk.LayoutItemCfg{LayoutFlags: walk.ShrinkableHorz | walk.GrowableHorz, IdealSize: walk.Size{Width: 100, Height: 30}}
+
+	case HSeparator:
+		return walk.LayoutItemCfg{LayoutFlags: walk.GrowableHorz | walk.GreedyHorz, IdealSize: walk.Size{Height: 2}}
+
+	case VSeparator:
+		return walk.LayoutItemCfg{LayoutFlags: walk.GrowableVert | walk.GreedyVert, IdealSize: walk.Size{Width: 2}}
+
+	case TextEdit:
+		greedy.MinSize = dlu(20, 12)
+		return greedy
+	}
+
+	return greedy
+}
+
+func snapshotMargins(margins Margins, marginsZero bool) Margins {
+	if !marginsZero && margins.isZero() {
+		return Margins{9, 9, 9, 9}
+	}
+
+	return margins
+}
+
+func snapshotSpacing(spacing int, spacingZero bool) int {
+	if !spacingZero && spacing == 0 {
+		return 6
+	}
+
+	return spacing
+}
+
+// snapshotField returns the field fieldName of the declarative widget v, or
+// the zero Value if there is no such field. It returns an error if the field
+// is not of type t.
+func snapshotField(v reflect.Value, fieldName string, t reflect.Type) (reflect.Value, error) {
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%s is not a struct", v.Type())
+	}
+
+	fieldValue := v.FieldByName(fieldName)
+	if !fieldValue.IsValid() {
+		return reflect.Value{}, nil
+	}
+
+	if fieldValue.Type() != t {
+		return reflect.Value{}, fmt.Errorf("%s.%s: want type %s, got %s", v.Type().Name(), fieldName, t, fieldValue.Type())
+	}
+
+	return fieldValue, nil
+}
+
+func snapshotInt(v reflect.Value, fieldName string) (int, error) {
+	fieldValue, err := snapshotField(v, fieldName, reflect.TypeFor[int]())
+	if err != nil || !fieldValue.IsValid() {
+		return 0, err
+	}
+
+	return int(fieldValue.Int()), nil
+}
+
+func snapshotSize(v reflect.Value, fieldName string) (walk.Size, error) {
+	fieldValue, err := snapshotField(v, fieldName, reflect.TypeFor[Size]())
+	if err != nil || !fieldValue.IsValid() {
+		return walk.Size{}, err
+	}
+
+	return fieldValue.Interface().(Size).toW(), nil
+}
+
+func snapshotString(v reflect.Value, fieldName string) (string, error) {
+	fieldValue, err := snapshotField(v, fieldName, reflect.TypeFor[string]())
+	if err != nil || !fieldValue.IsValid() {
+		return "", err
+	}
+
+	return fieldValue.String(), nil
+}
+
+// snapshotText returns the Text of a widget if it is a plain string, or a
+// placeholder for bound and missing texts.
+func snapshotText(v reflect.Value) string {
+	if fieldValue := v.FieldByName("Text"); fieldValue.IsValid() {
+		if text, ok := fieldValue.Interface().(string); ok {
+			return text
+		}
+	}
+
+	return "XXXXXXXX"
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package declarative
+
+import (
+	"flag"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+func TestMatchLayoutSnapshot(t *testing.T) {
+	root := Composite{
+		Layout: VBox{},
+		Children: []Widget{
+			Composite{
+				Name:   "nameRow",
+				Layout: HBox{MarginsZero: true},
+				Children: []Widget{
+					Label{Text: "Name:"},
+					LineEdit{Name: "nameLE"},
+				},
+			},
+			VSpacer{},
+			PushButton{Name: "okPB", Text: "OK"},
+		},
+	}
+
+	cfg := &LayoutSnapshotCfg{
+		Sizes:  []Size{{300, 200}, {400, 300}},
+		DPIs:   []int{96, 144},
+		Update: *updateGolden,
+	}
+
+	if err := MatchLayoutSnapshot("testdata/layoutsnapshot.golden", root, cfg); err != nil {
+		t.Error(err)
+	}
+}
+
+type badSizeWidget struct {
+	Name    string
+	MinSize string
+}
+
+func (badSizeWidget) Create(builder *Builder) error {
+	return nil
+}
+
+func TestLayoutSnapshotFieldTypeMismatch(t *testing.T) {
+	root := Composite{
+		Layout:   VBox{},
+		Children: []Widget{badSizeWidget{MinSize: "100x20"}},
+	}
+
+	_, err := LayoutSnapshot(root, &LayoutSnapshotCfg{Sizes: []Size{{100, 100}}})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if want := "badSizeWidget.MinSize: want type declarative.Size, got string"; !strings.Contains(err.Error(), want) {
+		t.Errorf("got error %q, want %q", err, want)
+	}
+}
+
+func TestLayoutSnapshotFormRowWithoutField(t *testing.T) {
+	root := Composite{
+		Layout: FormLayout{
+			Rows: []FormRow{
+				{Label: "Name:", Field: LineEdit{}},
+				{Label: "City:"},
+			},
+		},
+	}
+
+	_, err := LayoutSnapshot(root, &LayoutSnapshotCfg{Sizes: []Size{{100, 100}}})
+	if err == nil || err.Error() != "FormLayout row 1 has no Field" {
+		t.Errorf("got error %v, want FormLayout row 1 has no Field", err)
+	}
+}
@@ -43,6 +43,7 @@ type ListBox struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
@@ -58,6 +59,7 @@ type ListBox struct {
 	DisplayMember            string
 	Format                   string
 	ItemStyler               walk.ListItemStyler
+	ItemsReorderable         bool
 	Model                    any
 	MultiSelection           bool
 	OnCurrentIndexChanged    walk.EventHandler
@@ -112,6 +114,10 @@ func (lb ListBox) Create(builder *Builder) error {
 			return err
 		}
 
+		if err := w.SetItemsReorderable(lb.ItemsReorderable); err != nil {
+			return err
+		}
+
 		if lb.OnCurrentIndexChanged != nil {
 			w.CurrentIndexChanged().Attach(lb.OnCurrentIndexChanged)
 		}
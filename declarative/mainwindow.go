@@ -39,9 +39,10 @@ type MainWindow struct {
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
-	Layout     Layout
+	Children    []Widget
+	DataBinder  DataBinder
+	Layout      Layout
+	RightToLeft bool
 
 	// Form
 
@@ -105,9 +106,10 @@ func (mw MainWindow) Create() error {
 		Accessibility:      mw.Accessibility,
 
 		// Container
-		Children:   mw.Children,
-		DataBinder: mw.DataBinder,
-		Layout:     mw.Layout,
+		Children:    mw.Children,
+		DataBinder:  mw.DataBinder,
+		Layout:      mw.Layout,
+		RightToLeft: mw.RightToLeft,
 
 		// Form
 		Icon:  mw.Icon,
@@ -20,6 +20,7 @@ type HSpacer struct {
 
 	// Widget
 
+	Anchors       Anchors
 	Column        int
 	ColumnSpan    int
 	Row           int
@@ -59,6 +60,7 @@ type VSpacer struct {
 
 	// Widget
 
+	Anchors       Anchors
 	Column        int
 	ColumnSpan    int
 	Row           int
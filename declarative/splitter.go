@@ -40,6 +40,7 @@ type HSplitter struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
@@ -49,8 +50,9 @@ type HSplitter struct {
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
+	Children    []Widget
+	DataBinder  DataBinder
+	RightToLeft bool
 
 	// Splitter
 
@@ -112,6 +114,7 @@ type VSplitter struct {
 	// Widget
 
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	Row                int
@@ -120,8 +123,9 @@ type VSplitter struct {
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
+	Children    []Widget
+	DataBinder  DataBinder
+	RightToLeft bool
 
 	// Splitter
 
@@ -41,6 +41,7 @@ type TableView struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
@@ -59,13 +60,18 @@ type TableView struct {
 	ColumnsSizable              Property
 	CustomHeaderHeight          int
 	CustomRowHeight             int
+	Editable                    bool
+	FilterBar                   bool
+	Grouping                    bool
 	ItemStateChangedEventDelay  int
+	ItemsReorderable            bool
 	HeaderHidden                bool
 	LastColumnStretched         bool
 	Model                       any
 	MultiSelection              bool
 	NotSortableByHeaderClick    bool
 	OnCurrentIndexChanged       walk.EventHandler
+	OnFilterChanged             walk.EventHandler
 	OnItemActivated             walk.EventHandler
 	OnSelectedIndexesChanged    walk.EventHandler
 	SelectionHiddenWithoutFocus bool
@@ -95,6 +101,42 @@ func (scf styleCellFunc) StyleCell(style *walk.CellStyle) {
 	scf(style)
 }
 
+// tableViewCellStyler returns the CellStyler for a TableView, that combines
+// styler, or else styleCell or else defaultStyler, with the StyleCell funcs of
+// columns. It returns nil if there is nothing to style cells with.
+func tableViewCellStyler(defaultStyler, styler walk.CellStyler, styleCell func(style *walk.CellStyle), columns []TableViewColumn) walk.CellStyler {
+	if styler != nil {
+		defaultStyler = styler
+	}
+
+	if styleCell != nil {
+		defaultStyler = styleCellFunc(styleCell)
+	}
+
+	var hasColStyleFunc bool
+	for _, c := range columns {
+		if c.StyleCell != nil {
+			hasColStyleFunc = true
+			break
+		}
+	}
+
+	if !hasColStyleFunc {
+		return defaultStyler
+	}
+
+	tvs := &tvStyler{
+		dflt:              defaultStyler,
+		colStyleCellFuncs: make([]func(style *walk.CellStyle), len(columns)),
+	}
+
+	for i, c := range columns {
+		tvs.colStyleCellFuncs[i] = c.StyleCell
+	}
+
+	return tvs
+}
+
 func (tv TableView) Create(builder *Builder) error {
 	var w *walk.TableView
 	var err error
@@ -118,46 +160,23 @@ func (tv TableView) Create(builder *Builder) error {
 			}
 		}
 
-		if err := w.SetModel(tv.Model); err != nil {
+		if err := w.SetFilterBarVisible(tv.FilterBar); err != nil {
 			return err
 		}
 
-		defaultStyler, _ := tv.Model.(walk.CellStyler)
-
-		if tv.CellStyler != nil {
-			defaultStyler = tv.CellStyler
+		if err := w.SetGroupingEnabled(tv.Grouping); err != nil {
+			return err
 		}
 
-		if tv.StyleCell != nil {
-			defaultStyler = styleCellFunc(tv.StyleCell)
-		}
+		w.SetEditable(tv.Editable)
 
-		var hasColStyleFunc bool
-		for _, c := range tv.Columns {
-			if c.StyleCell != nil {
-				hasColStyleFunc = true
-				break
-			}
+		if err := w.SetModel(tv.Model); err != nil {
+			return err
 		}
 
-		if defaultStyler != nil || hasColStyleFunc {
-			var styler walk.CellStyler
-
-			if hasColStyleFunc {
-				tvs := &tvStyler{
-					dflt:              defaultStyler,
-					colStyleCellFuncs: make([]func(style *walk.CellStyle), len(tv.Columns)),
-				}
-
-				styler = tvs
-
-				for i, c := range tv.Columns {
-					tvs.colStyleCellFuncs[i] = c.StyleCell
-				}
-			} else {
-				styler = defaultStyler
-			}
+		defaultStyler, _ := tv.Model.(walk.CellStyler)
 
+		if styler := tableViewCellStyler(defaultStyler, tv.CellStyler, tv.StyleCell, tv.Columns); styler != nil {
 			w.SetCellStyler(styler)
 		}
 
@@ -176,10 +195,16 @@ func (tv TableView) Create(builder *Builder) error {
 		if err := w.SetHeaderHidden(tv.HeaderHidden); err != nil {
 			return err
 		}
+		if err := w.SetItemsReorderable(tv.ItemsReorderable); err != nil {
+			return err
+		}
 
 		if tv.OnCurrentIndexChanged != nil {
 			w.CurrentIndexChanged().Attach(tv.OnCurrentIndexChanged)
 		}
+		if tv.OnFilterChanged != nil {
+			w.FilterChanged().Attach(tv.OnFilterChanged)
+		}
 		if tv.OnSelectedIndexesChanged != nil {
 			w.SelectedIndexesChanged().Attach(tv.OnSelectedIndexesChanged)
 		}
@@ -21,18 +21,21 @@ const (
 )
 
 type TableViewColumn struct {
-	Name       string
-	DataMember string
-	Format     string
-	Title      string
-	Alignment  Alignment1D
-	Precision  int
-	Width      int
-	Hidden     bool
-	Frozen     bool
-	StyleCell  func(style *walk.CellStyle)
-	LessFunc   func(i, j int) bool
-	FormatFunc func(value any) string
+	Name        string
+	DataMember  string
+	Format      string
+	Title       string
+	Alignment   Alignment1D
+	Precision   int
+	Width       int
+	Hidden      bool
+	Frozen      bool
+	StyleCell   func(style *walk.CellStyle)
+	LessFunc    func(i, j int) bool
+	CompareFunc func(a, b any) int
+	FormatFunc  func(value any) string
+	Editor      walk.CellEditor
+	Validator   Validator
 }
 
 func (tvc TableViewColumn) Create(tv *walk.TableView) error {
@@ -64,7 +67,16 @@ func (tvc TableViewColumn) Create(tv *walk.TableView) error {
 		return err
 	}
 	w.SetLessFunc(tvc.LessFunc)
+	w.SetCompareFunc(tvc.CompareFunc)
 	w.SetFormatFunc(tvc.FormatFunc)
+	w.SetEditor(tvc.Editor)
+	if tvc.Validator != nil {
+		validator, err := tvc.Validator.Create()
+		if err != nil {
+			return err
+		}
+		w.SetValidator(validator)
+	}
 
 	return tv.Columns().Add(w)
 }
@@ -0,0 +1,134 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package declarative
+
+import (
+	"github.com/wuc656/walk"
+	"github.com/wuc656/win"
+)
+
+type TreeTableView struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipText        Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Column             int
+	ColumnSpan         int
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// TreeTableView
+
+	AlternatingRowBG            bool
+	AssignTo                    **walk.TreeTableView
+	CellStyler                  walk.CellStyler
+	Columns                     []TableViewColumn
+	ColumnsOrderable            Property
+	ColumnsSizable              Property
+	CustomHeaderHeight          int
+	CustomRowHeight             int
+	HeaderHidden                bool
+	LastColumnStretched         bool
+	Model                       walk.TreeModel
+	MultiSelection              bool
+	NotSortableByHeaderClick    bool
+	OnCurrentItemChanged        walk.EventHandler
+	OnExpandedChanged           walk.TreeItemEventHandler
+	OnItemActivated             walk.EventHandler
+	OnSelectedIndexesChanged    walk.EventHandler
+	SelectionHiddenWithoutFocus bool
+	StyleCell                   func(style *walk.CellStyle)
+}
+
+func (ttv TreeTableView) Create(builder *Builder) error {
+	cfg := &walk.TableViewCfg{CustomHeaderHeight: ttv.CustomHeaderHeight, CustomRowHeight: ttv.CustomRowHeight}
+	if ttv.NotSortableByHeaderClick {
+		cfg.Style = win.LVS_NOSORTHEADER
+	}
+
+	w, err := walk.NewTreeTableViewWithCfg(builder.Parent(), cfg)
+	if err != nil {
+		return err
+	}
+
+	if ttv.AssignTo != nil {
+		*ttv.AssignTo = w
+	}
+
+	return builder.InitWidget(ttv, w, func() error {
+		for i := range ttv.Columns {
+			if err := ttv.Columns[i].Create(w.TableView); err != nil {
+				return err
+			}
+		}
+
+		if err := w.SetModel(ttv.Model); err != nil {
+			return err
+		}
+
+		if styler := tableViewCellStyler(nil, ttv.CellStyler, ttv.StyleCell, ttv.Columns); styler != nil {
+			w.SetCellStyler(styler)
+		}
+
+		w.SetAlternatingRowBG(ttv.AlternatingRowBG)
+		if err := w.SetLastColumnStretched(ttv.LastColumnStretched); err != nil {
+			return err
+		}
+		if err := w.SetMultiSelection(ttv.MultiSelection); err != nil {
+			return err
+		}
+		if err := w.SetSelectionHiddenWithoutFocus(ttv.SelectionHiddenWithoutFocus); err != nil {
+			return err
+		}
+		if err := w.SetHeaderHidden(ttv.HeaderHidden); err != nil {
+			return err
+		}
+
+		if ttv.OnCurrentItemChanged != nil {
+			w.CurrentItemChanged().Attach(ttv.OnCurrentItemChanged)
+		}
+		if ttv.OnExpandedChanged != nil {
+			w.ExpandedChanged().Attach(ttv.OnExpandedChanged)
+		}
+		if ttv.OnSelectedIndexesChanged != nil {
+			w.SelectedIndexesChanged().Attach(ttv.OnSelectedIndexesChanged)
+		}
+		if ttv.OnItemActivated != nil {
+			w.ItemActivated().Attach(ttv.OnItemActivated)
+		}
+
+		return nil
+	})
+}
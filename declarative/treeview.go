@@ -40,6 +40,7 @@ type TreeView struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
@@ -51,6 +52,7 @@ type TreeView struct {
 
 	AssignTo             **walk.TreeView
 	ItemHeight           int
+	ItemsReorderable     bool
 	Model                walk.TreeModel
 	OnCurrentItemChanged walk.EventHandler
 	OnExpandedChanged    walk.TreeItemEventHandler
@@ -76,6 +78,10 @@ func (tv TreeView) Create(builder *Builder) error {
 			return err
 		}
 
+		if err := w.SetItemsReorderable(tv.ItemsReorderable); err != nil {
+			return err
+		}
+
 		if tv.OnCurrentItemChanged != nil {
 			w.CurrentItemChanged().Attach(tv.OnCurrentItemChanged)
 		}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package declarative
+
+import (
+	"fmt"
+
+	"github.com/wuc656/walk"
+)
+
+type ValidationSummary struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipText        Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Column             int
+	ColumnSpan         int
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// ValidationSummary
+
+	AssignTo   **walk.ValidationSummary
+	DataBinder string // Name of the DataBinder, if not that of a parent
+}
+
+func (vs ValidationSummary) Create(builder *Builder) error {
+	w, err := walk.NewValidationSummary(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if vs.AssignTo != nil {
+		*vs.AssignTo = w
+	}
+
+	return builder.InitWidget(vs, w, func() error {
+		// DataBinders are created after the widgets they bind, so wait for it.
+		builder.Defer(func() error {
+			db := builder.name2DataBinder[vs.DataBinder]
+			if vs.DataBinder == "" {
+				db = builder.dataBinderFor(w)
+			}
+			if db == nil {
+				return fmt.Errorf("ValidationSummary: no DataBinder '%s'", vs.DataBinder)
+			}
+
+			w.SetDataBinder(db)
+
+			return nil
+		})
+
+		return nil
+	})
+}
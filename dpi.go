@@ -0,0 +1,97 @@
+// Copyright 2010 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// IntFrom96DPI converts from 1/96" units to native pixels.
+func IntFrom96DPI[I constraints.Integer](value I, dpi int) I {
+	return scaleInt(value, float64(dpi)/96.0)
+}
+
+// IntTo96DPI converts from native pixels to 1/96" units.
+func IntTo96DPI[I constraints.Integer](value I, dpi int) I {
+	return scaleInt(value, 96.0/float64(dpi))
+}
+
+func scaleInt[I constraints.Integer](value I, scale float64) I {
+	return I(math.Round(float64(value) * scale))
+}
+
+// MarginsFrom96DPI converts from 1/96" units to native pixels.
+func MarginsFrom96DPI(value Margins, dpi int) Margins {
+	return scaleMargins(value, float64(dpi)/96.0)
+}
+
+// MarginsTo96DPI converts from native pixels to 1/96" units.
+func MarginsTo96DPI(value Margins, dpi int) Margins {
+	return scaleMargins(value, 96.0/float64(dpi))
+}
+
+func scaleMargins(value Margins, scale float64) Margins {
+	return Margins{
+		HNear: scaleInt(value.HNear, scale),
+		VNear: scaleInt(value.VNear, scale),
+		HFar:  scaleInt(value.HFar, scale),
+		VFar:  scaleInt(value.VFar, scale),
+	}
+}
+
+// PointFrom96DPI converts from 1/96" units to native pixels.
+func PointFrom96DPI(value Point, dpi int) Point {
+	return scalePoint(value, float64(dpi)/96.0)
+}
+
+// PointTo96DPI converts from native pixels to 1/96" units.
+func PointTo96DPI(value Point, dpi int) Point {
+	return scalePoint(value, 96.0/float64(dpi))
+}
+
+func scalePoint(value Point, scale float64) Point {
+	return Point{
+		X: scaleInt(value.X, scale),
+		Y: scaleInt(value.Y, scale),
+	}
+}
+
+// RectangleFrom96DPI converts from 1/96" units to native pixels.
+func RectangleFrom96DPI(value Rectangle, dpi int) Rectangle {
+	return scaleRectangle(value, float64(dpi)/96.0)
+}
+
+// RectangleTo96DPI converts from native pixels to 1/96" units.
+func RectangleTo96DPI(value Rectangle, dpi int) Rectangle {
+	return scaleRectangle(value, 96.0/float64(dpi))
+}
+
+func scaleRectangle(value Rectangle, scale float64) Rectangle {
+	return Rectangle{
+		X:      scaleInt(value.X, scale),
+		Y:      scaleInt(value.Y, scale),
+		Width:  scaleInt(value.Width, scale),
+		Height: scaleInt(value.Height, scale),
+	}
+}
+
+// SizeFrom96DPI converts from 1/96" units to native pixels.
+func SizeFrom96DPI(value Size, dpi int) Size {
+	return scaleSize(value, float64(dpi)/96.0)
+}
+
+// SizeTo96DPI converts from native pixels to 1/96" units.
+func SizeTo96DPI(value Size, dpi int) Size {
+	return scaleSize(value, 96.0/float64(dpi))
+}
+
+func scaleSize(value Size, scale float64) Size {
+	return Size{
+		Width:  scaleInt(value.Width, scale),
+		Height: scaleInt(value.Height, scale),
+	}
+}
@@ -0,0 +1,476 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/wuc656/win"
+)
+
+// DropEffect is what a drag and drop operation does with the data. The effects
+// a drag source allows are combined with |.
+type DropEffect uint32
+
+const (
+	DropEffectNone DropEffect = 0
+	DropEffectCopy DropEffect = 1
+	DropEffectMove DropEffect = 2
+	DropEffectLink DropEffect = 4
+)
+
+// dragSourceWindow is the window, that started the drag and drop operation in
+// progress with DoDragDrop.
+var dragSourceWindow Window
+
+// DoDragDrop lets the user drag content, in all the formats it has, to any
+// window of any application, which may do one of the effects allowed with it.
+// It returns, when the data was dropped or the drag canceled, the effect of the
+// drop or DropEffectNone. Start drags from handlers of MouseDown or MouseMove,
+// while a mouse button is down; releasing that button drops.
+func (wb *WindowBase) DoDragDrop(content ClipboardContent, allowed DropEffect) (DropEffect, error) {
+	if err := oleInitialize(); err != nil {
+		return DropEffectNone, processError(err)
+	}
+
+	items, err := content.items()
+	if err != nil {
+		return DropEffectNone, err
+	}
+
+	obj := newDataObject(items)
+	defer obj.ref.release(unsafe.Pointer(obj))
+
+	src := &dropSource{vtbl: dropSourceVtbl, buttons: win.MK_LBUTTON}
+	if win.GetKeyState(win.VK_LBUTTON) >= 0 && win.GetKeyState(win.VK_RBUTTON) < 0 {
+		src.buttons = win.MK_RBUTTON
+	}
+	src.ref.addRef(unsafe.Pointer(src))
+	defer src.ref.release(unsafe.Pointer(src))
+
+	prevSourceWindow := dragSourceWindow
+	dragSourceWindow = wb.window
+	defer func() {
+		dragSourceWindow = prevSourceWindow
+	}()
+
+	var effect uint32
+	hr, _, _ := doDragDrop.Call(
+		uintptr(unsafe.Pointer(obj)),
+		uintptr(unsafe.Pointer(src)),
+		uintptr(allowed),
+		uintptr(unsafe.Pointer(&effect)))
+
+	switch hr {
+	case dragdropSDrop:
+		return DropEffect(effect), nil
+
+	case dragdropSCancel:
+		return DropEffectNone, nil
+	}
+
+	return DropEffectNone, errorFromHRESULT("DoDragDrop", win.HRESULT(hr))
+}
+
+// DragEnter returns a *DragDropEvent that you can attach to for handling a
+// drag entering the *WindowBase. The effect handlers return applies while the
+// drag is over the window, unless there are handlers of DragOver.
+func (wb *WindowBase) DragEnter() *DragDropEvent {
+	return wb.ensureDropTarget().dragEnterPublisher.Event()
+}
+
+// DragOver returns a *DragDropEvent that you can attach to for handling a drag
+// moving over the *WindowBase or the modifier keys changing during it.
+func (wb *WindowBase) DragOver() *DragDropEvent {
+	return wb.ensureDropTarget().dragOverPublisher.Event()
+}
+
+// DragLeave returns an *Event that you can attach to for handling a drag
+// leaving the *WindowBase or being canceled over it. It is published for
+// windows with handlers of DragEnter, DragOver or Drop only.
+func (wb *WindowBase) DragLeave() *Event {
+	return wb.ensureDropTarget().dragLeavePublisher.Event()
+}
+
+// Drop returns a *DragDropEvent that you can attach to for handling a drop on
+// the *WindowBase. Handlers return the effect the drop had.
+func (wb *WindowBase) Drop() *DragDropEvent {
+	return wb.ensureDropTarget().dropPublisher.Event()
+}
+
+func (wb *WindowBase) ensureDropTarget() *dropTarget {
+	if wb.dropTarget == nil {
+		dt := &dropTarget{vtbl: dropTargetVtbl, wb: wb}
+		dt.ref.addRef(unsafe.Pointer(dt))
+
+		// Attach can not return errors, so they are only logged, if enabled.
+		register := func() {
+			if err := dt.registerErr(); err != nil {
+				processErrorNoPanic(err)
+			}
+		}
+		dt.dragEnterPublisher.event.attached = register
+		dt.dragOverPublisher.event.attached = register
+		dt.dropPublisher.event.attached = register
+
+		wb.dropTarget = dt
+	}
+
+	return wb.dropTarget
+}
+
+// DropData is the data of a drag and drop operation, in the formats of
+// ClipboardContent or any other.
+type DropData struct {
+	obj *win.IDataObject
+}
+
+func (dd *DropData) contains(format uint32) bool {
+	return dataObjectContains(dd.obj, format)
+}
+
+func (dd *DropData) data(format uint32) ([]byte, error) {
+	return dataObjectData(dd.obj, format)
+}
+
+// ContainsFormat returns whether the data is available in format.
+func (dd *DropData) ContainsFormat(format ClipboardFormat) bool {
+	return dd.contains(uint32(format))
+}
+
+// Data returns the data in format.
+func (dd *DropData) Data(format ClipboardFormat) ([]byte, error) {
+	return dd.data(uint32(format))
+}
+
+// ContainsText returns whether the data is available as text.
+func (dd *DropData) ContainsText() bool {
+	return dd.contains(win.CF_UNICODETEXT)
+}
+
+// Text returns the data as text.
+func (dd *DropData) Text() (string, error) {
+	data, err := dd.data(win.CF_UNICODETEXT)
+	if err != nil {
+		return "", err
+	}
+
+	return textFromUTF16Data(data, true), nil
+}
+
+// ContainsHTML returns whether the data is available as HTML.
+func (dd *DropData) ContainsHTML() bool {
+	return containsRegistered(dd, htmlClipboardFormatName)
+}
+
+// HTML returns the data as HTML fragment.
+func (dd *DropData) HTML() (string, error) {
+	return readHTML(dd)
+}
+
+// ContainsRTF returns whether the data is available as Rich Text Format
+// document.
+func (dd *DropData) ContainsRTF() bool {
+	return containsRegistered(dd, rtfClipboardFormatName)
+}
+
+// RTF returns the data as Rich Text Format document.
+func (dd *DropData) RTF() (string, error) {
+	return readRTF(dd)
+}
+
+// ContainsImage returns whether the data is available as image.
+func (dd *DropData) ContainsImage() bool {
+	return containsImage(dd)
+}
+
+// Image returns the data as Bitmap at 96dpi.
+func (dd *DropData) Image() (*Bitmap, error) {
+	return readImage(dd)
+}
+
+// ContainsFiles returns whether the data is a list of files, like when
+// dragging files from Explorer.
+func (dd *DropData) ContainsFiles() bool {
+	return dd.contains(win.CF_HDROP)
+}
+
+// Files returns the paths of the files of the data.
+func (dd *DropData) Files() ([]string, error) {
+	return readFiles(dd)
+}
+
+// DragDropEventArgs describes a drag over or a drop on a window.
+type DragDropEventArgs struct {
+	data     *DropData
+	point    Point
+	keyState uint32
+	allowed  DropEffect
+}
+
+// Data returns the data dragged.
+func (a *DragDropEventArgs) Data() *DropData {
+	return a.data
+}
+
+// Point returns the position of the mouse in native pixels, relative to the
+// client area of the window.
+func (a *DragDropEventArgs) Point() Point {
+	return a.point
+}
+
+// AllowedEffects returns the effects the source of the drag allows.
+func (a *DragDropEventArgs) AllowedEffects() DropEffect {
+	return a.allowed
+}
+
+// Modifiers returns the modifier keys held down.
+func (a *DragDropEventArgs) Modifiers() Modifiers {
+	var m Modifiers
+	if a.keyState&win.MK_SHIFT != 0 {
+		m |= ModShift
+	}
+	if a.keyState&win.MK_CONTROL != 0 {
+		m |= ModControl
+	}
+	if a.keyState&mkAlt != 0 {
+		m |= ModAlt
+	}
+
+	return m
+}
+
+// DefaultEffect returns the effect, that a drop has by convention: Ctrl copies,
+// Shift moves, Ctrl+Shift or Alt link, and otherwise the data is moved or, if
+// that is not allowed, copied or linked.
+func (a *DragDropEventArgs) DefaultEffect() DropEffect {
+	var effects []DropEffect
+
+	switch m := a.Modifiers(); {
+	case m&ModAlt != 0 || m&(ModControl|ModShift) == ModControl|ModShift:
+		effects = []DropEffect{DropEffectLink}
+
+	case m&ModControl != 0:
+		effects = []DropEffect{DropEffectCopy}
+
+	case m&ModShift != 0:
+		effects = []DropEffect{DropEffectMove}
+
+	default:
+		effects = []DropEffect{DropEffectMove, DropEffectCopy, DropEffectLink}
+	}
+
+	for _, e := range effects {
+		if a.allowed&e != 0 {
+			return e
+		}
+	}
+
+	return DropEffectNone
+}
+
+// Source returns the window, that started the drag with DoDragDrop, or nil if
+// the drag comes from another application.
+func (a *DragDropEventArgs) Source() Window {
+	return dragSourceWindow
+}
+
+// dropPositioner is implemented by windows, that show where a drag over them
+// would drop, like between the items of a list.
+type dropPositioner interface {
+	// setDropPosition tracks the position p of a drag, before handlers of
+	// DragEnter, DragOver and Drop run.
+	setDropPosition(p Point)
+
+	// dropEffect returns the effect of a drop, given the effect the handlers
+	// returned, and shows where it would drop unless that is DropEffectNone.
+	dropEffect(args *DragDropEventArgs, effect DropEffect) DropEffect
+
+	// endDrag hides where a drag would drop and returns the effect of a
+	// drop, given the effect handlers of Drop returned, if dropped.
+	endDrag(args *DragDropEventArgs, effect DropEffect, dropped bool) DropEffect
+}
+
+// dropTarget is the IDropTarget of a window, that publishes the drag and drop
+// events of it.
+type dropTarget struct {
+	vtbl               *iDropTargetVtbl
+	ref                comRef
+	wb                 *WindowBase
+	registered         bool
+	data               *DropData
+	enterEffect        DropEffect
+	dragEnterPublisher DragDropEventPublisher
+	dragOverPublisher  DragDropEventPublisher
+	dragLeavePublisher EventPublisher
+	dropPublisher      DragDropEventPublisher
+}
+
+// register makes the window accept drops.
+func (dt *dropTarget) register() error {
+	if err := dt.registerErr(); err != nil {
+		return processError(err)
+	}
+
+	return nil
+}
+
+// registerErr is like register, but leaves processing errors to the caller.
+func (dt *dropTarget) registerErr() error {
+	if dt.registered || dt.wb.hWnd == 0 {
+		return nil
+	}
+
+	if err := oleInitialize(); err != nil {
+		return err
+	}
+
+	if hr, _, _ := registerDragDrop.Call(uintptr(dt.wb.hWnd), uintptr(unsafe.Pointer(dt))); win.FAILED(win.HRESULT(hr)) {
+		return newErr(fmt.Sprintf("RegisterDragDrop: Error %d", hr))
+	}
+
+	dt.registered = true
+
+	return nil
+}
+
+func (dt *dropTarget) revoke() {
+	if !dt.registered {
+		return
+	}
+
+	revokeDragDrop.Call(uintptr(dt.wb.hWnd))
+	dt.registered = false
+}
+
+func (dt *dropTarget) args(keyState uint32, pt win.POINT, effect *uint32) *DragDropEventArgs {
+	win.ScreenToClient(dt.wb.hWnd, &pt)
+
+	return &DragDropEventArgs{
+		data:     dt.data,
+		point:    Point{int(pt.X), int(pt.Y)},
+		keyState: keyState,
+		allowed:  DropEffect(*effect),
+	}
+}
+
+func (dt *dropTarget) dragEnter(obj *win.IDataObject, keyState uint32, pt win.POINT, effect *uint32) uintptr {
+	unknownAddRef(unsafe.Pointer(obj))
+	dt.data = &DropData{obj: obj}
+
+	args := dt.args(keyState, pt, effect)
+	positioner, _ := dt.wb.window.(dropPositioner)
+
+	if positioner != nil {
+		positioner.setDropPosition(args.point)
+	}
+
+	dt.enterEffect = dt.dragEnterPublisher.Publish(args) & args.allowed
+
+	*effect = uint32(dt.dropEffect(positioner, args, dt.enterEffect))
+
+	return win.S_OK
+}
+
+func (dt *dropTarget) dragOver(keyState uint32, pt win.POINT, effect *uint32) uintptr {
+	if dt.data == nil {
+		*effect = uint32(DropEffectNone)
+		return win.S_OK
+	}
+
+	args := dt.args(keyState, pt, effect)
+	positioner, _ := dt.wb.window.(dropPositioner)
+
+	if positioner != nil {
+		positioner.setDropPosition(args.point)
+	}
+
+	e := dt.enterEffect
+	if dt.dragOverPublisher.event.hasHandlers() {
+		e = dt.dragOverPublisher.Publish(args) & args.allowed
+	}
+
+	*effect = uint32(dt.dropEffect(positioner, args, e))
+
+	return win.S_OK
+}
+
+func (dt *dropTarget) dropEffect(positioner dropPositioner, args *DragDropEventArgs, effect DropEffect) DropEffect {
+	if positioner == nil {
+		return effect
+	}
+
+	return positioner.dropEffect(args, effect) & args.allowed
+}
+
+func (dt *dropTarget) dragLeave() {
+	if positioner, ok := dt.wb.window.(dropPositioner); ok {
+		positioner.endDrag(nil, DropEffectNone, false)
+	}
+
+	dt.dragLeavePublisher.Publish()
+
+	dt.releaseData()
+}
+
+func (dt *dropTarget) drop(obj *win.IDataObject, keyState uint32, pt win.POINT, effect *uint32) uintptr {
+	defer dt.releaseData()
+
+	if dt.data == nil {
+		*effect = uint32(DropEffectNone)
+		return win.S_OK
+	}
+
+	args := dt.args(keyState, pt, effect)
+	positioner, _ := dt.wb.window.(dropPositioner)
+
+	if positioner != nil {
+		positioner.setDropPosition(args.point)
+	}
+
+	e := dt.dropPublisher.Publish(args) & args.allowed
+
+	if positioner != nil {
+		e = positioner.endDrag(args, e, true) & args.allowed
+	}
+
+	*effect = uint32(e)
+
+	return win.S_OK
+}
+
+func (dt *dropTarget) releaseData() {
+	if dt.data == nil {
+		return
+	}
+
+	unknownRelease(unsafe.Pointer(dt.data.obj))
+	dt.data = nil
+}
+
+// beyondDragThreshold returns whether the mouse moved from p to q far enough
+// to start dragging.
+func beyondDragThreshold(p, q win.POINT) bool {
+	dx, dy := q.X-p.X, q.Y-p.Y
+
+	return max(dx, -dx) > win.GetSystemMetrics(win.SM_CXDRAG) || max(dy, -dy) > win.GetSystemMetrics(win.SM_CYDRAG)
+}
+
+// drawDropIndicator draws the line, that shows where a drag would insert, on
+// top of hwnd at y from left to right, not above top.
+func drawDropIndicator(hwnd win.HWND, left, right, y, top int32, dpi int) {
+	thickness := int32(IntFrom96DPI(2, dpi))
+
+	rc := win.RECT{Left: left, Top: max(y-thickness/2, top), Right: right, Bottom: y - thickness/2 + thickness}
+
+	hdc := win.GetDC(hwnd)
+	defer win.ReleaseDC(hwnd, hdc)
+
+	win.FillRect(hdc, &rc, win.GetSysColorBrush(win.COLOR_WINDOWTEXT))
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+type dragDropEventHandlerInfo struct {
+	handler DragDropEventHandler
+	once    bool
+}
+
+// DragDropEventHandler handles a drag over or a drop on a window. It returns
+// the effect the drop has or would have, or DropEffectNone to reject it.
+type DragDropEventHandler func(args *DragDropEventArgs) DropEffect
+
+// DragDropEvent is an event of a drag and drop operation. The window starts
+// accepting drops when the first handler is attached to it.
+type DragDropEvent struct {
+	handlers []dragDropEventHandlerInfo
+	attached func()
+}
+
+// Attach adds handler to e and will be invoked when the event associated with
+// e is triggered. It returns an integral handle to the event that may be used
+// with Detach.
+func (e *DragDropEvent) Attach(handler DragDropEventHandler) int {
+	if e.attached != nil {
+		e.attached()
+	}
+
+	handlerInfo := dragDropEventHandlerInfo{handler, false}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+// Detach removes the handler specified by handle, which was obtained as the
+// result of a call to Attach.
+func (e *DragDropEvent) Detach(handle int) {
+	e.handlers[handle].handler = nil
+}
+
+// Once is similar to Attach, except that handler is attached as a "one-shot"
+// occurrence; handler will automatically be detached after its first invocation.
+func (e *DragDropEvent) Once(handler DragDropEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+func (e *DragDropEvent) hasHandlers() bool {
+	for _, h := range e.handlers {
+		if h.handler != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DragDropEventPublisher is the event publisher used by any code that supports
+// DragDropEvent.
+type DragDropEventPublisher struct {
+	event DragDropEvent
+}
+
+// Event obtains a pointer to the DragDropEvent associated with p.
+func (p *DragDropEventPublisher) Event() *DragDropEvent {
+	return &p.event
+}
+
+// Publish dispatches the event to all registered handlers and returns the
+// first effect other than DropEffectNone they returned.
+func (p *DragDropEventPublisher) Publish(args *DragDropEventArgs) DropEffect {
+	effect := DropEffectNone
+
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			if e := h.handler(args); effect == DropEffectNone {
+				effect = e
+			}
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+
+	return effect
+}
@@ -71,16 +71,7 @@ func (p *DropFilesEventPublisher) Event(hWnd win.HWND) *DropFilesEvent {
 }
 
 func (p *DropFilesEventPublisher) Publish(hDrop win.HDROP) {
-	var files []string
-
-	n := win.DragQueryFile(hDrop, 0xFFFFFFFF, nil, 0)
-	for i := 0; i < int(n); i++ {
-		bufSize := uint(512)
-		buf := make([]uint16, bufSize)
-		if win.DragQueryFile(hDrop, uint(i), &buf[0], bufSize) > 0 {
-			files = append(files, syscall.UTF16ToString(buf))
-		}
-	}
+	files := dragQueryFiles(hDrop)
 	win.DragFinish(hDrop)
 
 	for i, h := range p.event.handlers {
@@ -93,3 +84,19 @@ func (p *DropFilesEventPublisher) Publish(hDrop win.HDROP) {
 		}
 	}
 }
+
+// dragQueryFiles returns the paths of the files in hDrop.
+func dragQueryFiles(hDrop win.HDROP) []string {
+	var files []string
+
+	n := win.DragQueryFile(hDrop, 0xFFFFFFFF, nil, 0)
+	for i := 0; i < int(n); i++ {
+		bufSize := win.DragQueryFile(hDrop, uint(i), nil, 0) + 1
+		buf := make([]uint16, bufSize)
+		if win.DragQueryFile(hDrop, uint(i), &buf[0], bufSize) > 0 {
+			files = append(files, syscall.UTF16ToString(buf))
+		}
+	}
+
+	return files
+}
@@ -38,7 +38,7 @@ func NewMainWin() (*MainWin, error) {
 
 	err := MainWindow{
 		AssignTo: &mainWin.MainWindow,
-		Icon:     Bind("'../img/' + icon(mainWin.wv.URL) + '.ico'"),
+		Icon:     Bind("'../img/' + icon(wv.URL) + '.ico'"),
 		Title:    "Walk WebView Example (With Events Printing)",
 		MinSize:  Size{800, 600},
 		Layout:   VBox{MarginsZero: true},
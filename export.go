@@ -0,0 +1,200 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// ExportFormat is a format TableView.Export writes rows in.
+type ExportFormat int
+
+const (
+	// ExportCSV writes comma separated values as specified by RFC 4180.
+	ExportCSV ExportFormat = iota
+
+	// ExportTSV writes tab separated values, one row per line. Tabs and line
+	// breaks in values are replaced by spaces.
+	ExportTSV
+
+	// ExportJSON writes an array with an object per row, that maps the titles
+	// of the columns to the values. Titles that are already taken get the
+	// number of their column appended, like "Name (3)".
+	ExportJSON
+
+	// ExportHTML writes an HTML table.
+	ExportHTML
+)
+
+// ExportOptions configures TableView.Export.
+type ExportOptions struct {
+	// SelectedOnly exports the selected rows only.
+	SelectedOnly bool
+
+	// NoHeader omits the row with the titles of the columns. JSON always uses
+	// the titles as keys.
+	NoHeader bool
+}
+
+// exportTable is what TableView.Export writes, the cells already formatted for
+// display.
+type exportTable struct {
+	titles []string
+	rows   [][]string
+	header bool
+	crlf   bool // ends lines of CSV and TSV with \r\n, like the clipboard wants
+}
+
+func (t *exportTable) write(w io.Writer, format ExportFormat) error {
+	switch format {
+	case ExportCSV:
+		return t.writeCSV(w)
+
+	case ExportTSV:
+		return t.writeTSV(w)
+
+	case ExportJSON:
+		return t.writeJSON(w)
+
+	case ExportHTML:
+		return t.writeHTML(w)
+	}
+
+	return errors.New("invalid export format")
+}
+
+func (t *exportTable) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = t.crlf
+
+	if t.header {
+		if err := cw.Write(t.titles); err != nil {
+			return err
+		}
+	}
+
+	if err := cw.WriteAll(t.rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+var tsvReplacer = strings.NewReplacer("\t", " ", "\r\n", " ", "\r", " ", "\n", " ")
+
+func (t *exportTable) writeTSV(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	eol := "\n"
+	if t.crlf {
+		eol = "\r\n"
+	}
+
+	writeRow := func(cells []string) {
+		for i, cell := range cells {
+			if i > 0 {
+				bw.WriteByte('\t')
+			}
+			tsvReplacer.WriteString(bw, cell)
+		}
+		bw.WriteString(eol)
+	}
+
+	if t.header {
+		writeRow(t.titles)
+	}
+	for _, row := range t.rows {
+		writeRow(row)
+	}
+
+	return bw.Flush()
+}
+
+func (t *exportTable) writeJSON(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	writeString := func(s string) {
+		b, _ := json.Marshal(s)
+		bw.Write(b)
+	}
+
+	keys := t.jsonKeys()
+
+	bw.WriteString("[")
+	for i, row := range t.rows {
+		if i > 0 {
+			bw.WriteString(",")
+		}
+		bw.WriteString("\n  {")
+		for j, cell := range row {
+			if j > 0 {
+				bw.WriteString(", ")
+			}
+			writeString(keys[j])
+			bw.WriteString(": ")
+			writeString(cell)
+		}
+		bw.WriteString("}")
+	}
+	if len(t.rows) > 0 {
+		bw.WriteString("\n")
+	}
+	bw.WriteString("]\n")
+
+	return bw.Flush()
+}
+
+// jsonKeys returns the titles, made unique by appending the column number.
+func (t *exportTable) jsonKeys() []string {
+	keys := make([]string, len(t.titles))
+	taken := make(map[string]bool, len(t.titles))
+
+	for i, title := range t.titles {
+		key := title
+		for n := i + 1; taken[key]; n++ {
+			key = fmt.Sprintf("%s (%d)", title, n)
+		}
+
+		keys[i] = key
+		taken[key] = true
+	}
+
+	return keys
+}
+
+func (t *exportTable) writeHTML(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	writeRow := func(tag string, cells []string) {
+		bw.WriteString("<tr>")
+		for _, cell := range cells {
+			bw.WriteString("<" + tag + ">")
+			bw.WriteString(html.EscapeString(cell))
+			bw.WriteString("</" + tag + ">")
+		}
+		bw.WriteString("</tr>\n")
+	}
+
+	bw.WriteString("<table>\n")
+	if t.header {
+		bw.WriteString("<thead>\n")
+		writeRow("th", t.titles)
+		bw.WriteString("</thead>\n")
+	}
+	bw.WriteString("<tbody>\n")
+	for _, row := range t.rows {
+		writeRow("td", row)
+	}
+	bw.WriteString("</tbody>\n</table>\n")
+
+	return bw.Flush()
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"encoding/json"
+	"maps"
+	"strings"
+	"testing"
+)
+
+func exportString(t *testing.T, table *exportTable, format ExportFormat) string {
+	t.Helper()
+
+	var b strings.Builder
+	if err := table.write(&b, format); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	return b.String()
+}
+
+func TestExportCSV(t *testing.T) {
+	table := &exportTable{
+		titles: []string{"Name", "Note"},
+		rows: [][]string{
+			{"Smith, J.", "says \"hi\""},
+			{"<b>", "tab\there\nand a line"},
+		},
+		header: true,
+	}
+
+	got := exportString(t, table, ExportCSV)
+	want := "Name,Note\n\"Smith, J.\",\"says \"\"hi\"\"\"\n<b>,\"tab\there\nand a line\"\n"
+
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestExportTSV(t *testing.T) {
+	table := &exportTable{
+		titles: []string{"Name", "Note"},
+		rows: [][]string{
+			{"Smith, J.", "says \"hi\""},
+			{"<b>", "tab\there\nand a line"},
+		},
+		header: true,
+		crlf:   true,
+	}
+
+	got := exportString(t, table, ExportTSV)
+	want := "Name\tNote\r\nSmith, J.\tsays \"hi\"\r\n<b>\ttab here and a line\r\n"
+
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	table.header = false
+	if got := exportString(t, table, ExportTSV); strings.HasPrefix(got, "Name") {
+		t.Errorf("got %q without header", got)
+	}
+}
+
+func TestExportJSON(t *testing.T) {
+	table := &exportTable{
+		titles: []string{"Name", "Note"},
+		rows: [][]string{
+			{"Smith, J.", "says \"hi\""},
+			{"<b>", "tab\there\nand a line"},
+		},
+	}
+
+	got := exportString(t, table, ExportJSON)
+
+	var rows []map[string]string
+	if err := json.Unmarshal([]byte(got), &rows); err != nil {
+		t.Fatalf("invalid JSON %q: %v", got, err)
+	}
+	if len(rows) != 2 || rows[0]["Name"] != "Smith, J." || rows[1]["Note"] != "tab\there\nand a line" {
+		t.Errorf("got %v", rows)
+	}
+
+	// Keys keep the order of the columns.
+	if i, j := strings.Index(got, `"Name"`), strings.Index(got, `"Note"`); i > j {
+		t.Errorf("keys out of order in %q", got)
+	}
+
+	empty := &exportTable{titles: []string{"Name", "Note"}}
+	if got := exportString(t, empty, ExportJSON); got != "[]\n" {
+		t.Errorf("got %q for no rows", got)
+	}
+
+	duplicates := &exportTable{
+		titles: []string{"Name", "Name (3)", "Name", ""},
+		rows:   [][]string{{"a", "b", "c", "d"}},
+	}
+
+	rows = nil
+	if err := json.Unmarshal([]byte(exportString(t, duplicates, ExportJSON)), &rows); err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"Name": "a", "Name (3)": "b", "Name (4)": "c", "": "d"}; !maps.Equal(rows[0], want) {
+		t.Errorf("got %v for duplicate titles, want %v", rows[0], want)
+	}
+}
+
+func TestExportHTML(t *testing.T) {
+	table := &exportTable{
+		titles: []string{"Name", "Note"},
+		rows: [][]string{
+			{"<b>", "says \"hi\""},
+		},
+		header: true,
+	}
+
+	got := exportString(t, table, ExportHTML)
+
+	for _, want := range []string{"<th>Name</th>", "<td>&lt;b&gt;</td>", "<td>says &#34;hi&#34;</td>"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("%q does not contain %q", got, want)
+		}
+	}
+}
+
+func TestExportInvalidFormat(t *testing.T) {
+	table := &exportTable{titles: []string{"Name"}}
+
+	if err := table.write(new(strings.Builder), ExportFormat(-1)); err == nil {
+		t.Error("no error for invalid format")
+	}
+}
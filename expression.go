@@ -10,8 +10,6 @@ package walk
 import (
 	"log"
 	"reflect"
-
-	_ "github.com/wuc656/govaluate"
 )
 
 type Expression interface {
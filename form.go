@@ -8,8 +8,10 @@
 package walk
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 	"unsafe"
 
 	"github.com/wuc656/walk/idalloc"
@@ -420,6 +422,25 @@ func (fb *FormBase) SetRightToLeftLayout(rtl bool) error {
 	return fb.ensureExtendedStyleBits(win.WS_EX_LAYOUTRTL, rtl)
 }
 
+// SetRightToLeft sets whether the FormBase and its descendants lay out and
+// display their content from right to left, see ContainerBase.SetRightToLeft.
+//
+// The title bar and menu bar of the FormBase are mirrored, too.
+func (fb *FormBase) SetRightToLeft(rtl bool) error {
+	// The widgets of the FormBase are mirrored by their layouts instead of
+	// inheriting the layout of the FormBase.
+	if err := fb.ensureExtendedStyleBits(win.WS_EX_LAYOUTRTL|win.WS_EX_NOINHERITLAYOUT, rtl); err != nil {
+		return err
+	}
+
+	const swpFlags = win.SWP_FRAMECHANGED | win.SWP_NOACTIVATE | win.SWP_NOZORDER | win.SWP_NOMOVE | win.SWP_NOSIZE
+	if !win.SetWindowPos(fb.hWnd, 0, 0, 0, 0, 0, swpFlags) {
+		return lastError("SetWindowPos")
+	}
+
+	return applyRightToLeftToDescendants(fb.clientComposite)
+}
+
 func (fb *FormBase) HandleKeyDown(msg *win.MSG) bool {
 	ret := false
 
@@ -478,6 +499,16 @@ func (fb *FormBase) HandleKeyDown(msg *win.MSG) bool {
 		}
 	}
 
+	// Cell editors of TableViews
+	for hwnd := msg.HWnd; hwnd != 0; hwnd = win.GetParent(hwnd) {
+		if tva, ok := windowFromHandle(hwnd).(interface{ asTableView() *TableView }); ok {
+			if tva.asTableView().handleEditorKeyDown(msg.HWnd, key, mods) {
+				return true
+			}
+			break
+		}
+	}
+
 	// Shortcut actions
 	hwnd := msg.HWnd
 	for hwnd != 0 {
@@ -651,20 +682,98 @@ func (fb *FormBase) SaveState() error {
 		return lastError("GetWindowPlacement")
 	}
 
-	state := fmt.Sprint(
-		wp.Flags, wp.ShowCmd,
-		wp.PtMinPosition.X, wp.PtMinPosition.Y,
-		wp.PtMaxPosition.X, wp.PtMaxPosition.Y,
-		wp.RcNormalPosition.Left, wp.RcNormalPosition.Top,
-		wp.RcNormalPosition.Right, wp.RcNormalPosition.Bottom)
+	monitor := fb.Monitor()
 
-	if err := fb.WriteState(state); err != nil {
+	dpi, err := monitor.DPI()
+	if err != nil {
+		return err
+	}
+
+	g := formGeometry{
+		Minimized: wp.ShowCmd == win.SW_SHOWMINIMIZED,
+		Maximized: wp.ShowCmd == win.SW_SHOWMAXIMIZED ||
+			wp.ShowCmd == win.SW_SHOWMINIMIZED && wp.Flags&win.WPF_RESTORETOMAXIMIZED != 0,
+		Monitor:  monitor.Rectangle(),
+		WorkArea: monitor.WorkArea(),
+		DPI:      dpi,
+	}
+
+	// The normal position is in workspace coordinates, which are relative to
+	// the work area.
+	g.Bounds = rectangleFromRECT(wp.RcNormalPosition)
+	g.Bounds.X += g.WorkArea.X - g.Monitor.X
+	g.Bounds.Y += g.WorkArea.Y - g.Monitor.Y
+
+	if wp.ShowCmd == win.SW_SHOWNORMAL {
+		// A snapped form is shown normal, but not at its normal position.
+		var rc win.RECT
+		if win.GetWindowRect(fb.hWnd, &rc) {
+			if bounds := rectangleFromRECT(rc); bounds != g.Bounds {
+				g.SnappedBounds = bounds
+			}
+		}
+	}
+
+	state, err := json.Marshal(g)
+	if err != nil {
+		return err
+	}
+
+	if err := fb.WriteState(string(state)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// readGeometryState parses the state SaveState wrote, or older versions of it.
+func readGeometryState(state string) (formGeometry, error) {
+	var g formGeometry
+
+	if strings.HasPrefix(state, "{") {
+		err := json.Unmarshal([]byte(state), &g)
+		return g, err
+	}
+
+	var wp win.WINDOWPLACEMENT
+
+	if _, err := fmt.Sscan(state,
+		&wp.Flags, &wp.ShowCmd,
+		&wp.PtMinPosition.X, &wp.PtMinPosition.Y,
+		&wp.PtMaxPosition.X, &wp.PtMaxPosition.Y,
+		&wp.RcNormalPosition.Left, &wp.RcNormalPosition.Top,
+		&wp.RcNormalPosition.Right, &wp.RcNormalPosition.Bottom); err != nil {
+		return g, err
+	}
+
+	g.Bounds = rectangleFromRECT(wp.RcNormalPosition)
+	g.Minimized = wp.ShowCmd == win.SW_SHOWMINIMIZED
+	g.Maximized = wp.ShowCmd == win.SW_SHOWMAXIMIZED || g.Minimized && wp.Flags&win.WPF_RESTORETOMAXIMIZED != 0
+
+	return g, nil
+}
+
+// currentMonitorGeometries returns the monitors forms can be restored on, the
+// primary one first.
+func currentMonitorGeometries() []monitorGeometry {
+	var monitors []monitorGeometry
+
+	for _, m := range Monitors() {
+		dpi, err := m.DPI()
+		if err != nil {
+			continue
+		}
+
+		monitors = append(monitors, monitorGeometry{
+			Bounds:   m.Rectangle(),
+			WorkArea: m.WorkArea(),
+			DPI:      dpi,
+		})
+	}
+
+	return monitors
+}
+
 func (fb *FormBase) RestoreState() error {
 	if fb.isInRestoreState {
 		return nil
@@ -682,19 +791,36 @@ func (fb *FormBase) RestoreState() error {
 		return nil
 	}
 
-	var wp win.WINDOWPLACEMENT
-
-	if _, err := fmt.Sscan(state,
-		&wp.Flags, &wp.ShowCmd,
-		&wp.PtMinPosition.X, &wp.PtMinPosition.Y,
-		&wp.PtMaxPosition.X, &wp.PtMaxPosition.Y,
-		&wp.RcNormalPosition.Left, &wp.RcNormalPosition.Top,
-		&wp.RcNormalPosition.Right, &wp.RcNormalPosition.Bottom); err != nil {
+	g, err := readGeometryState(state)
+	if err != nil {
 		return err
 	}
 
+	g = restoreFormGeometry(g, currentMonitorGeometries())
+
+	var wp win.WINDOWPLACEMENT
+
 	wp.Length = uint32(unsafe.Sizeof(wp))
 
+	switch {
+	case g.Minimized:
+		wp.ShowCmd = win.SW_SHOWMINIMIZED
+		if g.Maximized {
+			wp.Flags = win.WPF_RESTORETOMAXIMIZED
+		}
+
+	case g.Maximized:
+		wp.ShowCmd = win.SW_SHOWMAXIMIZED
+
+	default:
+		wp.ShowCmd = win.SW_SHOWNORMAL
+	}
+
+	bounds := g.Bounds
+	bounds.X -= g.WorkArea.X - g.Monitor.X
+	bounds.Y -= g.WorkArea.Y - g.Monitor.Y
+	wp.RcNormalPosition = bounds.toRECT()
+
 	if layout := fb.Layout(); layout != nil && fb.fixedSize() {
 		layoutItem := CreateLayoutItemsForContainer(fb)
 		minSize := fb.sizeFromClientSizePixels(layoutItem.MinSize())
@@ -703,10 +829,24 @@ func (fb *FormBase) RestoreState() error {
 		wp.RcNormalPosition.Bottom = wp.RcNormalPosition.Top + int32(minSize.Height) - 1
 	}
 
+	dpi := fb.DPI()
+
 	if !win.SetWindowPlacement(fb.hWnd, &wp) {
 		return lastError("SetWindowPlacement")
 	}
 
+	// Moving to a monitor with another DPI rescales the form, which the
+	// bounds already are.
+	if fb.DPI() != dpi && !win.SetWindowPlacement(fb.hWnd, &wp) {
+		return lastError("SetWindowPlacement")
+	}
+
+	if wp.ShowCmd == win.SW_SHOWNORMAL && !g.SnappedBounds.IsZero() && !fb.fixedSize() {
+		if err := fb.window.SetBoundsPixels(g.SnappedBounds); err != nil {
+			return err
+		}
+	}
+
 	return fb.clientComposite.RestoreState()
 }
 
@@ -0,0 +1,114 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+// formGeometry is the placement of a form, that FormBase.SaveState stores.
+// Rectangles are in native pixels of virtual screen coordinates.
+type formGeometry struct {
+	// Bounds are the bounds of the form, while it is neither maximized,
+	// minimized nor snapped.
+	Bounds Rectangle
+
+	// SnappedBounds are the bounds of the form, if it is snapped to an edge
+	// of the monitor, or else zero.
+	SnappedBounds Rectangle
+
+	Maximized bool
+	Minimized bool
+
+	// Monitor, WorkArea and DPI describe the monitor the form was on.
+	Monitor  Rectangle
+	WorkArea Rectangle
+	DPI      int
+}
+
+// monitorGeometry describes a monitor a form can be restored on.
+type monitorGeometry struct {
+	Bounds   Rectangle
+	WorkArea Rectangle
+	DPI      int
+}
+
+// restoreFormGeometry returns g placed on the monitors currently attached,
+// which must start with the primary one. The form stays on the monitor it was
+// on, or else goes to the monitor showing most of it, or else to the primary
+// one. Its position relative to the work area is kept, its size is scaled to
+// the DPI of the monitor and it is clamped to the work area.
+func restoreFormGeometry(g formGeometry, monitors []monitorGeometry) formGeometry {
+	if len(monitors) == 0 {
+		return g
+	}
+
+	m := monitors[formGeometryMonitorIndex(g, monitors)]
+
+	from := g.WorkArea
+	if from.IsZero() {
+		// Without a saved monitor, bounds are taken as they are.
+		from = m.WorkArea
+	}
+
+	g.Bounds = fitRectangle(g.Bounds, from, g.DPI, m.WorkArea, m.DPI)
+	if !g.SnappedBounds.IsZero() {
+		g.SnappedBounds = fitRectangle(g.SnappedBounds, from, g.DPI, m.WorkArea, m.DPI)
+	}
+
+	g.Monitor = m.Bounds
+	g.WorkArea = m.WorkArea
+	g.DPI = m.DPI
+
+	return g
+}
+
+// formGeometryMonitorIndex returns the index of the monitor g is restored on.
+func formGeometryMonitorIndex(g formGeometry, monitors []monitorGeometry) int {
+	if !g.Monitor.IsZero() {
+		for i, m := range monitors {
+			if m.Bounds == g.Monitor {
+				return i
+			}
+		}
+	}
+
+	index, maxArea := 0, 0
+	for i, m := range monitors {
+		if area := intersectionArea(m.Bounds, g.Bounds); area > maxArea {
+			index, maxArea = i, area
+		}
+	}
+
+	return index
+}
+
+func intersectionArea(a, b Rectangle) int {
+	width := min(a.Right(), b.Right()) - max(a.X, b.X) + 1
+	height := min(a.Bottom(), b.Bottom()) - max(a.Y, b.Y) + 1
+
+	if width <= 0 || height <= 0 {
+		return 0
+	}
+
+	return width * height
+}
+
+// fitRectangle moves r from its position relative to the work area from at
+// fromDPI to the same position relative to the work area to at toDPI, scales
+// it to toDPI and clamps it to to.
+func fitRectangle(r, from Rectangle, fromDPI int, to Rectangle, toDPI int) Rectangle {
+	scale := 1.0
+	if fromDPI > 0 && toDPI > 0 {
+		scale = float64(toDPI) / float64(fromDPI)
+	}
+
+	width := min(scaleInt(r.Width, scale), to.Width)
+	height := min(scaleInt(r.Height, scale), to.Height)
+
+	x := to.X + scaleInt(r.X-from.X, scale)
+	y := to.Y + scaleInt(r.Y-from.Y, scale)
+
+	x = max(min(x, to.X+to.Width-width), to.X)
+	y = max(min(y, to.Y+to.Height-height), to.Y)
+
+	return Rectangle{x, y, width, height}
+}
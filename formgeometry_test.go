@@ -0,0 +1,144 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"testing"
+)
+
+func TestRestoreFormGeometry(t *testing.T) {
+	primary := monitorGeometry{
+		Bounds:   Rectangle{0, 0, 1920, 1080},
+		WorkArea: Rectangle{0, 0, 1920, 1040},
+		DPI:      96,
+	}
+	right := monitorGeometry{
+		Bounds:   Rectangle{1920, 0, 2560, 1440},
+		WorkArea: Rectangle{1920, 0, 2560, 1400},
+		DPI:      144,
+	}
+	left := monitorGeometry{
+		Bounds:   Rectangle{-1280, 0, 1280, 1024},
+		WorkArea: Rectangle{-1280, 0, 1280, 984},
+		DPI:      96,
+	}
+
+	savedOn := func(m monitorGeometry, bounds Rectangle) formGeometry {
+		return formGeometry{Bounds: bounds, Monitor: m.Bounds, WorkArea: m.WorkArea, DPI: m.DPI}
+	}
+
+	tests := []struct {
+		name     string
+		saved    formGeometry
+		monitors []monitorGeometry
+		want     Rectangle
+		wantDPI  int
+	}{
+		{
+			"same monitor",
+			savedOn(right, Rectangle{2000, 100, 1200, 900}),
+			[]monitorGeometry{primary, right},
+			Rectangle{2000, 100, 1200, 900},
+			144,
+		},
+		{
+			"monitor unplugged",
+			savedOn(right, Rectangle{2020, 100, 1200, 900}),
+			[]monitorGeometry{primary},
+			Rectangle{67, 67, 800, 600},
+			96,
+		},
+		{
+			"monitor moved",
+			savedOn(left, Rectangle{-1180, 50, 800, 600}),
+			[]monitorGeometry{primary, {Bounds: Rectangle{-1280, -200, 1280, 1024}, WorkArea: Rectangle{-1280, -200, 1280, 984}, DPI: 96}},
+			Rectangle{-1180, -150, 800, 600},
+			96,
+		},
+		{
+			"DPI changed",
+			savedOn(primary, Rectangle{100, 100, 800, 600}),
+			[]monitorGeometry{{Bounds: primary.Bounds, WorkArea: primary.WorkArea, DPI: 120}},
+			Rectangle{125, 125, 1000, 750},
+			120,
+		},
+		{
+			"off-screen",
+			savedOn(primary, Rectangle{1800, 1000, 800, 600}),
+			[]monitorGeometry{primary, right},
+			Rectangle{1120, 440, 800, 600},
+			96,
+		},
+		{
+			"larger than work area",
+			savedOn(primary, Rectangle{-50, -50, 2500, 1200}),
+			[]monitorGeometry{primary},
+			Rectangle{0, 0, 1920, 1040},
+			96,
+		},
+		{
+			"without saved monitor",
+			formGeometry{Bounds: Rectangle{2100, 200, 800, 600}},
+			[]monitorGeometry{primary, right},
+			Rectangle{2100, 200, 800, 600},
+			144,
+		},
+		{
+			"without saved monitor off-screen",
+			formGeometry{Bounds: Rectangle{5000, 200, 800, 600}},
+			[]monitorGeometry{primary, right},
+			Rectangle{1120, 200, 800, 600},
+			96,
+		},
+	}
+
+	for _, test := range tests {
+		got := restoreFormGeometry(test.saved, test.monitors)
+
+		if got.Bounds != test.want {
+			t.Errorf("%s: Bounds = %+v, want %+v", test.name, got.Bounds, test.want)
+		}
+		if got.DPI != test.wantDPI {
+			t.Errorf("%s: DPI = %d, want %d", test.name, got.DPI, test.wantDPI)
+		}
+	}
+}
+
+func TestRestoreFormGeometrySnapped(t *testing.T) {
+	primary := monitorGeometry{
+		Bounds:   Rectangle{0, 0, 1920, 1080},
+		WorkArea: Rectangle{0, 0, 1920, 1040},
+		DPI:      96,
+	}
+	smaller := monitorGeometry{
+		Bounds:   Rectangle{0, 0, 1280, 720},
+		WorkArea: Rectangle{0, 0, 1280, 680},
+		DPI:      96,
+	}
+
+	saved := formGeometry{
+		Bounds:        Rectangle{200, 200, 800, 600},
+		SnappedBounds: Rectangle{960, 0, 960, 1040},
+		Monitor:       primary.Bounds,
+		WorkArea:      primary.WorkArea,
+		DPI:           96,
+	}
+
+	got := restoreFormGeometry(saved, []monitorGeometry{smaller})
+
+	if want := (Rectangle{320, 0, 960, 680}); got.SnappedBounds != want {
+		t.Errorf("SnappedBounds = %+v, want %+v", got.SnappedBounds, want)
+	}
+	if want := (Rectangle{200, 80, 800, 600}); got.Bounds != want {
+		t.Errorf("Bounds = %+v, want %+v", got.Bounds, want)
+	}
+	if got.WorkArea != smaller.WorkArea || got.Monitor != smaller.Bounds {
+		t.Errorf("monitor = %+v, %+v, want %+v, %+v", got.Monitor, got.WorkArea, smaller.Bounds, smaller.WorkArea)
+	}
+
+	if got := restoreFormGeometry(saved, nil); got != saved {
+		t.Errorf("restoreFormGeometry without monitors = %+v, want %+v", got, saved)
+	}
+}
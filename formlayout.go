@@ -0,0 +1,142 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/wuc656/win"
+)
+
+// FormLayout arranges label/field pairs in two columns, one pair per row.
+//
+// The label column is as wide as the widest label. Children that have no label
+// assigned through SetLabel span both columns. Rows are ordered like the
+// fields in the Children of the container. If the container becomes too
+// narrow for two columns, labels are placed above their fields.
+type FormLayout struct {
+	LayoutBase
+	hwnd2Label map[win.HWND]Widget
+}
+
+func NewFormLayout() *FormLayout {
+	l := &FormLayout{
+		LayoutBase: LayoutBase{
+			margins96dpi: Margins{9, 9, 9, 9},
+			spacing96dpi: 6,
+		},
+		hwnd2Label: make(map[win.HWND]Widget),
+	}
+	l.layout = l
+
+	return l
+}
+
+// Label returns the label of field, or nil if it spans both columns.
+func (l *FormLayout) Label(field Widget) Widget {
+	return l.hwnd2Label[field.Handle()]
+}
+
+// SetLabel places label in the label column of the row of field.
+//
+// field is moved directly behind label in tab order, so the mnemonic of the
+// label focuses field. If label has a Text method, its text also becomes the
+// accessible name of field, and its mnemonic the accessible keyboard shortcut.
+func (l *FormLayout) SetLabel(field, label Widget) error {
+	if l.container == nil {
+		return newError("container required")
+	}
+
+	children := l.container.Children()
+
+	handle := field.Handle()
+	if !children.containsHandle(handle) {
+		return newError("unknown widget")
+	}
+
+	if label == nil {
+		delete(l.hwnd2Label, handle)
+
+		l.container.RequestLayout()
+
+		return nil
+	}
+
+	labelHandle := label.Handle()
+	if labelHandle == handle || !children.containsHandle(labelHandle) {
+		return newError("label must be a sibling")
+	}
+	if _, ok := l.hwnd2Label[labelHandle]; ok {
+		return newError("label must not have a label")
+	}
+	for _, other := range l.hwnd2Label {
+		if other.Handle() == handle {
+			return newError("field is label of another field")
+		}
+	}
+
+	l.hwnd2Label[handle] = label
+
+	if !win.SetWindowPos(handle, labelHandle, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_NOACTIVATE) {
+		return lastError("SetWindowPos")
+	}
+
+	if texter, ok := label.(interface{ Text() string }); ok {
+		name, mnemonic := splitMnemonic(texter.Text())
+
+		if err := field.Accessibility().SetName(strings.TrimSuffix(strings.TrimSpace(name), ":")); err != nil {
+			return err
+		}
+
+		if mnemonic != 0 {
+			if err := field.Accessibility().SetAccelerator("Alt+" + string(unicode.ToUpper(mnemonic))); err != nil {
+				return err
+			}
+		}
+	}
+
+	l.container.RequestLayout()
+
+	return nil
+}
+
+func (l *FormLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
+	li := &formLayoutItem{
+		size2MinSize: make(map[Size]Size),
+		field2Label:  make(map[win.HWND]win.HWND, len(l.hwnd2Label)),
+	}
+
+	for handle, label := range l.hwnd2Label {
+		li.field2Label[handle] = label.Handle()
+	}
+
+	return li
+}
+
+// splitMnemonic returns text without mnemonic prefixes and the mnemonic
+// character, or 0 if there is none.
+func splitMnemonic(text string) (string, rune) {
+	var sb strings.Builder
+	var mnemonic rune
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '&' && i+1 < len(runes) {
+			i++
+
+			if runes[i] != '&' && mnemonic == 0 {
+				mnemonic = runes[i]
+			}
+		}
+
+		sb.WriteRune(runes[i])
+	}
+
+	return sb.String(), mnemonic
+}
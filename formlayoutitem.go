@@ -0,0 +1,323 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"sync"
+)
+
+type formLayoutItem struct {
+	ContainerLayoutItemBase
+	mutex        sync.Mutex
+	size2MinSize map[Size]Size // in native pixels
+	field2Label  map[layoutHandle]layoutHandle
+}
+
+type formLayoutRow struct {
+	label   LayoutItem // nil if the row has no label or it is unknown
+	field   LayoutItem
+	labeled bool // false if field spans both columns
+}
+
+func (li *formLayoutItem) LayoutFlags() LayoutFlags {
+	return boxLayoutFlags(Vertical, li.children) | ShrinkableHorz | GrowableHorz
+}
+
+func (li *formLayoutItem) IdealSize() Size {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+
+	rows := li.rows()
+	labelWidth := li.labelColumnWidth(rows)
+
+	var width int
+	for _, row := range rows {
+		w := li.preferredSize(row.field).Width
+		if row.labeled {
+			w += labelWidth + spacing
+		}
+
+		width = maxi(width, w)
+	}
+
+	width = maxi(width+margins.HNear+margins.HFar, li.MinSize().Width)
+
+	return Size{width, li.HeightForWidth(width)}
+}
+
+func (li *formLayoutItem) MinSize() Size {
+	return li.MinSizeForSize(li.geometry.ClientSize)
+}
+
+func (li *formLayoutItem) HasHeightForWidth() bool {
+	return true
+}
+
+func (li *formLayoutItem) HeightForWidth(width int) int {
+	return li.MinSizeForSize(Size{width, li.geometry.ClientSize.Height}).Height
+}
+
+func (li *formLayoutItem) MinSizeForSize(size Size) Size {
+	li.mutex.Lock()
+	defer li.mutex.Unlock()
+
+	if min, ok := li.size2MinSize[size]; ok {
+		return min
+	}
+
+	rows := li.rows()
+
+	width := li.minWidth(rows, true)
+	_, height := li.layoutRows(rows, Size{maxi(width, size.Width), 0})
+
+	s := Size{width, height}
+
+	if s.Width > 0 && s.Height > 0 {
+		li.size2MinSize[size] = s
+	}
+
+	return s
+}
+
+func (li *formLayoutItem) PerformLayout() []LayoutResultItem {
+	items, _ := li.layoutRows(li.rows(), li.geometry.ClientSize)
+
+	return items
+}
+
+// rows returns the rows to lay out, in the order of their fields.
+func (li *formLayoutItem) rows() []formLayoutRow {
+	handle2Item := make(map[layoutHandle]LayoutItem, len(li.children))
+	labels := make(map[layoutHandle]bool, len(li.field2Label))
+
+	for _, child := range li.children {
+		handle2Item[child.Handle()] = child
+	}
+	for _, label := range li.field2Label {
+		labels[label] = true
+	}
+
+	var rows []formLayoutRow
+
+	for _, child := range li.children {
+		if labels[child.Handle()] || !shouldLayoutItem(child) {
+			continue
+		}
+
+		row := formLayoutRow{field: child}
+
+		if label, ok := li.field2Label[child.Handle()]; ok {
+			row.labeled = true
+
+			if item := handle2Item[label]; item != nil && shouldLayoutItem(item) {
+				row.label = item
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// preferredSize returns the size in native pixels item would like to have,
+// ignoring height for width.
+func (li *formLayoutItem) preferredSize(item LayoutItem) Size {
+	min := li.MinSizeEffectiveForChild(item)
+
+	var s Size
+	if is, ok := item.(IdealSizer); ok {
+		s = is.IdealSize()
+	}
+	s = maxSize(s, min)
+
+	if max := item.Geometry().MaxSize; max.Width > 0 || max.Height > 0 {
+		if max.Width > 0 && s.Width > max.Width {
+			s.Width = maxi(max.Width, min.Width)
+		}
+		if max.Height > 0 && s.Height > max.Height {
+			s.Height = maxi(max.Height, min.Height)
+		}
+	}
+
+	return s
+}
+
+// labelColumnWidth returns the width of the widest label in native pixels.
+func (li *formLayoutItem) labelColumnWidth(rows []formLayoutRow) int {
+	var width int
+
+	for _, row := range rows {
+		if row.label != nil {
+			width = maxi(width, li.preferredSize(row.label).Width)
+		}
+	}
+
+	return width
+}
+
+// minWidth returns the minimum width in native pixels, if labels are placed
+// above their fields or not.
+func (li *formLayoutItem) minWidth(rows []formLayoutRow, wrapped bool) int {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+
+	labelWidth := li.labelColumnWidth(rows)
+
+	var width int
+	for _, row := range rows {
+		w := li.MinSizeEffectiveForChild(row.field).Width
+
+		if row.labeled {
+			if wrapped {
+				if row.label != nil {
+					w = maxi(w, li.MinSizeEffectiveForChild(row.label).Width)
+				}
+			} else {
+				w += labelWidth + spacing
+			}
+		}
+
+		width = maxi(width, w)
+	}
+
+	return width + margins.HNear + margins.HFar
+}
+
+// layoutRows lays out rows for size and returns the resulting items and the
+// height they require. If there is not enough room for the label column, labels
+// are placed above their fields. All values are in native pixels.
+func (li *formLayoutItem) layoutRows(rows []formLayoutRow, size Size) ([]LayoutResultItem, int) {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+
+	labelWidth := li.labelColumnWidth(rows)
+	wrapped := size.Width < li.minWidth(rows, false)
+
+	contentWidth := size.Width - margins.HNear - margins.HFar
+
+	type placedRow struct {
+		label  Rectangle
+		field  Rectangle
+		height int
+		greedy bool
+	}
+
+	placedRows := make([]placedRow, len(rows))
+
+	var height int
+	var greedyCount int
+
+	for i, row := range rows {
+		pr := &placedRows[i]
+
+		fieldX, fieldWidth := margins.HNear, contentWidth
+		if row.labeled && !wrapped {
+			fieldX += labelWidth + spacing
+			fieldWidth -= labelWidth + spacing
+		}
+
+		flags := row.field.LayoutFlags()
+		pref := li.preferredSize(row.field)
+
+		pr.field.X = fieldX
+		if flags&GrowableHorz != 0 {
+			pr.field.Width = fieldWidth
+			if max := row.field.Geometry().MaxSize.Width; max > 0 && pr.field.Width > max {
+				pr.field.Width = max
+			}
+		} else {
+			pr.field.Width = mini(pref.Width, fieldWidth)
+		}
+		pr.field.Width = maxi(pr.field.Width, li.MinSizeEffectiveForChild(row.field).Width)
+
+		if hfw, ok := row.field.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+			pr.field.Height = hfw.HeightForWidth(pr.field.Width)
+		} else {
+			pr.field.Height = pref.Height
+		}
+
+		pr.greedy = flags&GreedyVert != 0 && flags&GrowableVert != 0
+
+		if row.label != nil {
+			labelPref := li.preferredSize(row.label)
+
+			pr.label.X = margins.HNear
+			if wrapped {
+				pr.label.Width = mini(labelPref.Width, contentWidth)
+			} else {
+				pr.label.Width = labelPref.Width
+			}
+
+			if hfw, ok := row.label.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+				pr.label.Height = hfw.HeightForWidth(pr.label.Width)
+			} else {
+				pr.label.Height = labelPref.Height
+			}
+		}
+
+		if row.label != nil && wrapped {
+			pr.field.Y = pr.label.Height + spacing
+			pr.height = pr.field.Y + pr.field.Height
+		} else {
+			pr.height = maxi(pr.label.Height, pr.field.Height)
+		}
+
+		if i > 0 {
+			height += spacing
+		}
+		height += pr.height
+
+		if pr.greedy {
+			greedyCount++
+		}
+	}
+
+	height += margins.VNear + margins.VFar
+
+	// Hand out remaining space to fields that want it.
+	if excess := size.Height - height; excess > 0 && greedyCount > 0 {
+		for i := range placedRows {
+			pr := &placedRows[i]
+
+			if !pr.greedy {
+				continue
+			}
+
+			share := excess / greedyCount
+			excess -= share
+			greedyCount--
+
+			pr.field.Height += share
+			pr.height += share
+		}
+	}
+
+	items := make([]LayoutResultItem, 0, len(rows)*2)
+
+	y := margins.VNear
+	for i, row := range rows {
+		pr := &placedRows[i]
+
+		pr.label.Y += y
+		pr.field.Y += y
+
+		var rowItems []LayoutResultItem
+		if row.label != nil {
+			rowItems = append(rowItems, LayoutResultItem{Item: row.label, Bounds: pr.label})
+		}
+		rowItems = append(rowItems, LayoutResultItem{Item: row.field, Bounds: pr.field})
+
+		if !wrapped {
+			alignBaselines(rowItems, y, pr.height, AlignHNearVNear)
+		}
+
+		items = append(items, rowItems...)
+
+		y += pr.height + spacing
+	}
+
+	return items, height
+}
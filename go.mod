@@ -3,7 +3,6 @@ module github.com/wuc656/walk
 go 1.25.0
 
 require (
-	github.com/wuc656/govaluate v1.10.4
 	github.com/wuc656/win v0.0.10
 	github.com/wuc656/wingoes v0.0.11
 	golang.org/x/exp v0.0.0-20260611194520-c48552f49976
@@ -0,0 +1,583 @@
+// Copyright 2011 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"sort"
+	"sync"
+)
+
+type gridLayoutItem struct {
+	ContainerLayoutItemBase
+	mutex                sync.Mutex
+	size2MinSize         map[Size]Size // in native pixels
+	rowStretchFactors    []int
+	columnStretchFactors []int
+	item2Info            map[LayoutItem]*gridLayoutItemInfo
+	cells                [][]gridLayoutItemCell
+	minSize              Size // in native pixels
+}
+
+type gridLayoutItemInfo struct {
+	cell     *gridLayoutItemCell
+	spanHorz int
+	spanVert int
+	minSize  Size // in native pixels
+}
+
+type gridLayoutItemCell struct {
+	row    int
+	column int
+	item   LayoutItem
+}
+
+func (*gridLayoutItem) stretchFactorsTotal(stretchFactors []int) int {
+	total := 0
+
+	for _, v := range stretchFactors {
+		total += maxi(1, v)
+	}
+
+	return total
+}
+
+func (li *gridLayoutItem) LayoutFlags() LayoutFlags {
+	var flags LayoutFlags
+
+	if len(li.children) == 0 {
+		return ShrinkableHorz | ShrinkableVert | GrowableHorz | GrowableVert
+	} else {
+		for _, item := range li.children {
+			if s, ok := item.(*spacerLayoutItem); ok && s.greedyLocallyOnly || !shouldLayoutItem(item) {
+				continue
+			}
+
+			wf := item.LayoutFlags()
+
+			if wf&GreedyHorz != 0 && item.Geometry().MaxSize.Width > 0 {
+				wf &^= GreedyHorz
+			}
+			if wf&GreedyVert != 0 && item.Geometry().MaxSize.Height > 0 {
+				wf &^= GreedyVert
+			}
+
+			flags |= wf
+		}
+	}
+
+	return flags
+}
+
+func (li *gridLayoutItem) IdealSize() Size {
+	return li.MinSize()
+}
+
+func (li *gridLayoutItem) MinSize() Size {
+	if len(li.cells) == 0 {
+		return Size{}
+	}
+
+	return li.MinSizeForSize(li.geometry.ClientSize)
+}
+
+func (li *gridLayoutItem) HeightForWidth(width int) int {
+	return li.MinSizeForSize(Size{width, li.geometry.ClientSize.Height}).Height
+}
+
+func (li *gridLayoutItem) MinSizeForSize(size Size) Size {
+	if len(li.cells) == 0 {
+		return Size{}
+	}
+
+	li.mutex.Lock()
+	defer li.mutex.Unlock()
+
+	if min, ok := li.size2MinSize[size]; ok {
+		return min
+	}
+
+	ws := make([]int, len(li.cells[0]))
+
+	for row := 0; row < len(li.cells); row++ {
+		for col := range ws {
+			item := li.cells[row][col].item
+			if item == nil {
+				continue
+			}
+
+			if !shouldLayoutItem(item) {
+				continue
+			}
+
+			min := li.MinSizeEffectiveForChild(item)
+			info := li.item2Info[item]
+
+			if info.spanHorz == 1 {
+				ws[col] = maxi(ws[col], min.Width)
+			}
+		}
+	}
+
+	widths := li.sectionSizesForSpace(Horizontal, size.Width, nil)
+	heights := li.sectionSizesForSpace(Vertical, size.Height, widths)
+
+	for row := range heights {
+		var wg sync.WaitGroup
+		var mutex sync.Mutex
+		var maxHeight int
+
+		for col := range widths {
+			item := li.cells[row][col].item
+			if item == nil {
+				continue
+			}
+
+			if !shouldLayoutItem(item) {
+				continue
+			}
+
+			if info := li.item2Info[item]; info.spanVert == 1 {
+				if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+
+					// Already in a WaitGroup, so not using App().Go() here.
+					wg.Go(func() {
+						height := hfw.HeightForWidth(li.spannedWidth(info, widths))
+
+						mutex.Lock()
+						maxHeight = maxi(maxHeight, height)
+						mutex.Unlock()
+
+					})
+				} else {
+					height := li.MinSizeEffectiveForChild(item).Height
+
+					mutex.Lock()
+					maxHeight = maxi(maxHeight, height)
+					mutex.Unlock()
+				}
+			}
+		}
+
+		wg.Wait()
+
+		heights[row] = maxHeight
+	}
+
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+
+	width := margins.HNear + margins.HFar
+	height := margins.VNear + margins.VFar
+
+	for i, w := range ws {
+		if w > 0 {
+			if i > 0 {
+				width += spacing
+			}
+			width += w
+		}
+	}
+	for i, h := range heights {
+		if h > 0 {
+			if i > 0 {
+				height += spacing
+			}
+			height += h
+		}
+	}
+
+	if width > 0 && height > 0 {
+		li.size2MinSize[size] = Size{width, height}
+	}
+
+	return Size{width, height}
+}
+
+// spannedWidth returns spanned width in native pixels.
+func (li *gridLayoutItem) spannedWidth(info *gridLayoutItemInfo, widths []int) int {
+	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+
+	var width int
+
+	for i := info.cell.column; i < info.cell.column+info.spanHorz; i++ {
+		if w := widths[i]; w > 0 {
+			width += w
+			if i > info.cell.column {
+				width += spacing
+			}
+		}
+	}
+
+	return width
+}
+
+// spannedHeight returns spanned height in native pixels.
+func (li *gridLayoutItem) spannedHeight(info *gridLayoutItemInfo, heights []int) int {
+	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+
+	var height int
+
+	for i := info.cell.row; i < info.cell.row+info.spanVert; i++ {
+		if h := heights[i]; h > 0 {
+			height += h
+			if i > info.cell.row {
+				height += spacing
+			}
+		}
+	}
+
+	return height
+}
+
+type gridLayoutSectionInfo struct {
+	index              int
+	minSize            int // in native pixels
+	maxSize            int // in native pixels
+	stretch            int
+	hasGreedyNonSpacer bool
+	hasGreedySpacer    bool
+}
+
+type gridLayoutSectionInfoList []gridLayoutSectionInfo
+
+func (l gridLayoutSectionInfoList) Len() int {
+	return len(l)
+}
+
+func (l gridLayoutSectionInfoList) Less(i, j int) bool {
+	if l[i].hasGreedyNonSpacer == l[j].hasGreedyNonSpacer {
+		if l[i].hasGreedySpacer == l[j].hasGreedySpacer {
+			minDiff := l[i].minSize - l[j].minSize
+
+			if minDiff == 0 {
+				return l[i].maxSize/l[i].stretch < l[j].maxSize/l[j].stretch
+			}
+
+			return minDiff > 0
+		}
+
+		return l[i].hasGreedySpacer
+	}
+
+	return l[i].hasGreedyNonSpacer
+}
+
+func (l gridLayoutSectionInfoList) Swap(i, j int) {
+	l[i], l[j] = l[j], l[i]
+}
+
+func (li *gridLayoutItem) PerformLayout() []LayoutResultItem {
+	widths := li.sectionSizesForSpace(Horizontal, li.geometry.ClientSize.Width, nil)
+	heights := li.sectionSizesForSpace(Vertical, li.geometry.ClientSize.Height, widths)
+
+	items := make([]LayoutResultItem, 0, len(li.item2Info))
+
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+
+	for item, info := range li.item2Info {
+		if !shouldLayoutItem(item) {
+			continue
+		}
+
+		x := margins.HNear
+		for i := 0; i < info.cell.column; i++ {
+			if w := widths[i]; w > 0 {
+				x += w + spacing
+			}
+		}
+
+		y := margins.VNear
+		for i := 0; i < info.cell.row; i++ {
+			if h := heights[i]; h > 0 {
+				y += h + spacing
+			}
+		}
+
+		width := li.spannedWidth(info, widths)
+		height := li.spannedHeight(info, heights)
+
+		w := width
+		h := height
+
+		if lf := item.LayoutFlags(); lf&GrowableHorz == 0 || lf&GrowableVert == 0 {
+			var s Size
+			if hfw, ok := item.(HeightForWidther); !ok || !hfw.HasHeightForWidth() {
+				if is, ok := item.(IdealSizer); ok {
+					s = is.IdealSize()
+				}
+			}
+
+			max := item.Geometry().MaxSize
+			if max.Width > 0 && s.Width > max.Width {
+				s.Width = max.Width
+			}
+			if lf&GrowableHorz == 0 {
+				w = s.Width
+			}
+			w = mini(w, width)
+
+			if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+				h = hfw.HeightForWidth(w)
+			} else {
+				if max.Height > 0 && s.Height > max.Height {
+					s.Height = max.Height
+				}
+				if lf&GrowableVert == 0 {
+					h = s.Height
+				}
+			}
+			h = mini(h, height)
+		}
+
+		alignment := item.Geometry().Alignment
+		if alignment == AlignHVDefault {
+			alignment = li.alignment
+		}
+
+		if w != width {
+			switch alignment {
+			case AlignHCenterVNear, AlignHCenterVCenter, AlignHCenterVFar:
+				x += (width - w) / 2
+
+			case AlignHFarVNear, AlignHFarVCenter, AlignHFarVFar:
+				x += width - w
+			}
+		}
+
+		if h != height {
+			switch alignment {
+			case AlignHNearVCenter, AlignHCenterVCenter, AlignHFarVCenter:
+				y += (height - h) / 2
+
+			case AlignHNearVFar, AlignHCenterVFar, AlignHFarVFar:
+				y += height - h
+			}
+		}
+
+		items = append(items, LayoutResultItem{Item: item, Bounds: Rectangle{X: x, Y: y, Width: w, Height: h}})
+	}
+
+	if li.baselineAlignment {
+		li.alignRowBaselines(items, heights)
+	}
+
+	return items
+}
+
+// alignRowBaselines aligns the baselines of items that occupy a single row.
+// heights holds the row heights in native pixels.
+func (li *gridLayoutItem) alignRowBaselines(items []LayoutResultItem, heights []int) {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+
+	row2Indices := make(map[int][]int)
+	for i, item := range items {
+		if info := li.item2Info[item.Item]; info.spanVert == 1 {
+			row2Indices[info.cell.row] = append(row2Indices[info.cell.row], i)
+		}
+	}
+
+	y := margins.VNear
+	for row, height := range heights {
+		if indices := row2Indices[row]; len(indices) > 1 {
+			rowItems := make([]LayoutResultItem, len(indices))
+			for i, index := range indices {
+				rowItems[i] = items[index]
+			}
+
+			alignBaselines(rowItems, y, height, li.alignment)
+
+			for i, index := range indices {
+				items[index] = rowItems[i]
+			}
+		}
+
+		if height > 0 {
+			y += height + spacing
+		}
+	}
+}
+
+// sectionSizesForSpace returns section sizes. Input and outpus is measured in native pixels.
+func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space int, widths []int) []int {
+	var stretchFactors []int
+	if orientation == Horizontal {
+		stretchFactors = li.columnStretchFactors
+	} else {
+		stretchFactors = li.rowStretchFactors
+	}
+
+	var sectionCountWithGreedyNonSpacer int
+	var sectionCountWithGreedySpacer int
+	var stretchFactorsTotal [3]int
+	var minSizesRemaining int
+	minSizes := make([]int, len(stretchFactors))
+	maxSizes := make([]int, len(stretchFactors))
+	sizes := make([]int, len(stretchFactors))
+	sortedSections := gridLayoutSectionInfoList(make([]gridLayoutSectionInfo, len(stretchFactors)))
+
+	for i := 0; i < len(stretchFactors); i++ {
+		var otherAxisCount int
+		if orientation == Horizontal {
+			otherAxisCount = len(li.rowStretchFactors)
+		} else {
+			otherAxisCount = len(li.columnStretchFactors)
+		}
+
+		for j := 0; j < otherAxisCount; j++ {
+			var item LayoutItem
+			if orientation == Horizontal {
+				item = li.cells[j][i].item
+			} else {
+				item = li.cells[i][j].item
+			}
+
+			if item == nil {
+				continue
+			}
+
+			if !shouldLayoutItem(item) {
+				continue
+			}
+
+			info := li.item2Info[item]
+			flags := item.LayoutFlags()
+
+			max := item.Geometry().MaxSize
+
+			var pref Size
+			if hfw, ok := item.(HeightForWidther); !ok || !hfw.HasHeightForWidth() {
+				if is, ok := item.(IdealSizer); ok {
+					pref = is.IdealSize()
+				}
+			}
+
+			if orientation == Horizontal {
+				if info.spanHorz == 1 {
+					minSizes[i] = maxi(minSizes[i], li.MinSizeEffectiveForChild(item).Width)
+				}
+
+				if max.Width > 0 {
+					maxSizes[i] = maxi(maxSizes[i], max.Width)
+				} else if pref.Width > 0 && flags&GrowableHorz == 0 {
+					maxSizes[i] = maxi(maxSizes[i], pref.Width)
+				} else {
+					maxSizes[i] = 32768
+				}
+
+				if info.spanHorz == 1 && flags&GreedyHorz > 0 {
+					if _, isSpacer := item.(*spacerLayoutItem); isSpacer {
+						sortedSections[i].hasGreedySpacer = true
+					} else {
+						sortedSections[i].hasGreedyNonSpacer = true
+					}
+				}
+			} else {
+				if info.spanVert == 1 {
+					if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+						minSizes[i] = maxi(minSizes[i], hfw.HeightForWidth(li.spannedWidth(info, widths)))
+					} else {
+						minSizes[i] = maxi(minSizes[i], li.MinSizeEffectiveForChild(item).Height)
+					}
+				}
+
+				if max.Height > 0 {
+					maxSizes[i] = maxi(maxSizes[i], max.Height)
+				} else if hfw, ok := item.(HeightForWidther); ok && flags&GrowableVert == 0 && hfw.HasHeightForWidth() {
+					maxSizes[i] = minSizes[i]
+				} else if pref.Height > 0 && flags&GrowableVert == 0 {
+					maxSizes[i] = maxi(maxSizes[i], pref.Height)
+				} else {
+					maxSizes[i] = 32768
+				}
+
+				if info.spanVert == 1 && flags&GreedyVert > 0 {
+					if _, isSpacer := item.(*spacerLayoutItem); isSpacer {
+						sortedSections[i].hasGreedySpacer = true
+					} else {
+						sortedSections[i].hasGreedyNonSpacer = true
+					}
+				}
+			}
+		}
+
+		sortedSections[i].index = i
+		sortedSections[i].minSize = minSizes[i]
+		sortedSections[i].maxSize = maxSizes[i]
+		sortedSections[i].stretch = maxi(1, stretchFactors[i])
+
+		minSizesRemaining += minSizes[i]
+
+		if sortedSections[i].hasGreedyNonSpacer {
+			sectionCountWithGreedyNonSpacer++
+			stretchFactorsTotal[0] += stretchFactors[i]
+		} else if sortedSections[i].hasGreedySpacer {
+			sectionCountWithGreedySpacer++
+			stretchFactorsTotal[1] += stretchFactors[i]
+		} else {
+			stretchFactorsTotal[2] += stretchFactors[i]
+		}
+	}
+
+	sort.Stable(sortedSections)
+
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+
+	if orientation == Horizontal {
+		space -= margins.HNear + margins.HFar
+	} else {
+		space -= margins.VNear + margins.VFar
+	}
+
+	var spacingRemaining int
+	for _, max := range maxSizes {
+		if max > 0 {
+			spacingRemaining += spacing
+		}
+	}
+	if spacingRemaining > 0 {
+		spacingRemaining -= spacing
+	}
+
+	offsets := [3]int{0, sectionCountWithGreedyNonSpacer, sectionCountWithGreedyNonSpacer + sectionCountWithGreedySpacer}
+	counts := [3]int{sectionCountWithGreedyNonSpacer, sectionCountWithGreedySpacer, len(stretchFactors) - sectionCountWithGreedyNonSpacer - sectionCountWithGreedySpacer}
+
+	for i := range 3 {
+		stretchFactorsRemaining := stretchFactorsTotal[i]
+
+		for j := 0; j < counts[i]; j++ {
+			info := sortedSections[offsets[i]+j]
+			k := info.index
+
+			stretch := stretchFactors[k]
+			min := info.minSize
+			max := info.maxSize
+			size := min
+
+			if min < max {
+				excessSpace := float64(space - minSizesRemaining - spacingRemaining)
+
+				size += int(excessSpace * float64(stretch) / float64(stretchFactorsRemaining))
+				if size < min {
+					size = min
+				} else if size > max {
+					size = max
+				}
+			}
+
+			sizes[k] = size
+
+			minSizesRemaining -= min
+			stretchFactorsRemaining -= stretch
+
+			space -= (size + spacing)
+			spacingRemaining -= spacing
+		}
+	}
+
+	return sizes
+}
@@ -82,6 +82,10 @@ func NewGroupBox(parent Container) (*GroupBox, error) {
 	}
 	gb.composite.name = "composite"
 
+	if err := gb.applyRightToLeft(gb.RightToLeft()); err != nil {
+		return nil, err
+	}
+
 	win.SetWindowPos(gb.checkBox.hWnd, win.HWND_TOP, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE)
 
 	gb.SetBackground(NullBrush())
@@ -200,6 +204,55 @@ func (gb *GroupBox) applyFont(font *Font) {
 	gb.updateHeaderHeight()
 }
 
+// RightToLeft returns whether the GroupBox lays out and displays its content
+// from right to left.
+func (gb *GroupBox) RightToLeft() bool {
+	if gb.composite != nil && gb.composite.rightToLeft != nil {
+		return *gb.composite.rightToLeft
+	}
+
+	return gb.WidgetBase.RightToLeft()
+}
+
+// SetRightToLeft sets whether the GroupBox and its descendants lay out and
+// display their content from right to left.
+func (gb *GroupBox) SetRightToLeft(rtl bool) error {
+	if err := gb.composite.SetRightToLeft(rtl); err != nil {
+		return err
+	}
+
+	return gb.applyRightToLeft(rtl)
+}
+
+func (gb *GroupBox) applyRightToLeft(rtl bool) error {
+	if err := gb.WidgetBase.applyRightToLeft(rtl); err != nil {
+		return err
+	}
+
+	if gb.checkBox != nil {
+		if err := gb.checkBox.applyRightToLeft(rtl); err != nil {
+			return err
+		}
+	}
+
+	if gb.hWndGroupBox != 0 {
+		if err := ensureWindowLongBits(gb.hWndGroupBox, win.GWL_EXSTYLE, win.WS_EX_LAYOUTRTL|win.WS_EX_RTLREADING, rtl); err != nil {
+			return err
+		}
+		win.InvalidateRect(gb.hWndGroupBox, nil, true)
+	}
+
+	if gb.composite != nil {
+		if err := gb.composite.applyRightToLeft(rtl); err != nil {
+			return err
+		}
+	}
+
+	gb.RequestLayout()
+
+	return nil
+}
+
 func (gb *GroupBox) SetSuspended(suspend bool) {
 	gb.composite.SetSuspended(suspend)
 	gb.WidgetBase.SetSuspended(suspend)
@@ -365,6 +418,9 @@ func (gb *GroupBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 				} else {
 					x = gb.headerHeight * 2 / 3
 				}
+				if gb.RightToLeft() {
+					x = wbcb.Width - x - s.Width
+				}
 				gb.checkBox.SetBoundsPixels(Rectangle{x, gb.headerHeight, s.Width, s.Height})
 			}
 		}
@@ -392,47 +448,3 @@ func (gb *GroupBox) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 
 	return li
 }
-
-type groupBoxLayoutItem struct {
-	ContainerLayoutItemBase
-	compositePos Point // in native pixels
-}
-
-func (li *groupBoxLayoutItem) LayoutFlags() LayoutFlags {
-	return li.children[0].LayoutFlags()
-}
-
-func (li *groupBoxLayoutItem) MinSize() Size {
-	min := li.children[0].(MinSizer).MinSize()
-	min.Width += li.compositePos.X * 2
-	min.Height += li.compositePos.Y + 2
-
-	return min
-}
-
-func (li *groupBoxLayoutItem) MinSizeForSize(size Size) Size {
-	return li.MinSize()
-}
-
-func (li *groupBoxLayoutItem) HasHeightForWidth() bool {
-	return li.children[0].(HeightForWidther).HasHeightForWidth()
-}
-
-func (li *groupBoxLayoutItem) HeightForWidth(width int) int {
-	return li.children[0].(HeightForWidther).HeightForWidth(width-li.compositePos.X*2) + li.compositePos.Y
-}
-
-func (li *groupBoxLayoutItem) IdealSize() Size {
-	size := li.children[0].(IdealSizer).IdealSize()
-	size.Height += li.compositePos.Y
-	return size
-}
-
-func (li *groupBoxLayoutItem) PerformLayout() []LayoutResultItem {
-	return []LayoutResultItem{
-		{
-			Item:   li.children[0],
-			Bounds: Rectangle{X: li.compositePos.X, Y: li.compositePos.Y, Width: li.geometry.Size.Width - li.compositePos.X*2, Height: li.geometry.Size.Height - li.compositePos.Y - 4},
-		},
-	}
-}
@@ -0,0 +1,49 @@
+// Copyright 2012 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+type groupBoxLayoutItem struct {
+	ContainerLayoutItemBase
+	compositePos Point // in native pixels
+}
+
+func (li *groupBoxLayoutItem) LayoutFlags() LayoutFlags {
+	return li.children[0].LayoutFlags()
+}
+
+func (li *groupBoxLayoutItem) MinSize() Size {
+	min := li.children[0].(MinSizer).MinSize()
+	min.Width += li.compositePos.X * 2
+	min.Height += li.compositePos.Y + 2
+
+	return min
+}
+
+func (li *groupBoxLayoutItem) MinSizeForSize(size Size) Size {
+	return li.MinSize()
+}
+
+func (li *groupBoxLayoutItem) HasHeightForWidth() bool {
+	return li.children[0].(HeightForWidther).HasHeightForWidth()
+}
+
+func (li *groupBoxLayoutItem) HeightForWidth(width int) int {
+	return li.children[0].(HeightForWidther).HeightForWidth(width-li.compositePos.X*2) + li.compositePos.Y
+}
+
+func (li *groupBoxLayoutItem) IdealSize() Size {
+	size := li.children[0].(IdealSizer).IdealSize()
+	size.Height += li.compositePos.Y
+	return size
+}
+
+func (li *groupBoxLayoutItem) PerformLayout() []LayoutResultItem {
+	return []LayoutResultItem{
+		{
+			Item:   li.children[0],
+			Bounds: Rectangle{X: li.compositePos.X, Y: li.compositePos.Y, Width: li.geometry.Size.Width - li.compositePos.X*2, Height: li.geometry.Size.Height - li.compositePos.Y - 4},
+		},
+	}
+}
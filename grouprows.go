@@ -0,0 +1,228 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+// groupRowMap is the rowMapper of a grouping proxy. It shows the rows of each
+// group of the source below a header row. Groups appear in the order of their
+// first row in the source, rows keep their order within their group. The rows
+// of collapsed groups are not shown.
+type groupRowMap struct {
+	key       func(row int) any  // the group key of a source row
+	collapsed func(key any) bool // nil collapses no group
+	count     int                // the number of source rows
+	groups    []rowGroup
+	rows      []int // the source row of each row, -1-g for the header of group g
+	viewRows  []int // the row of each source row, -1 if its group is collapsed
+	rowGroups []int // the group of each source row
+}
+
+// rowGroup is a group of a groupRowMap.
+type rowGroup struct {
+	key       any
+	header    int // the row of the header
+	count     int // the number of source rows in the group
+	collapsed bool
+}
+
+// groupRowID identifies a row of a groupRowMap across changes of the source.
+type groupRowID struct {
+	key any // the key of a header
+	row int // the source row, -1 for a header
+}
+
+func (m *groupRowMap) build() {
+	key2Group := make(map[any]int)
+	var members [][]int
+
+	m.groups = m.groups[:0]
+	m.rowGroups = m.rowGroups[:0]
+
+	for row := 0; row < m.count; row++ {
+		key := m.key(row)
+
+		g, ok := key2Group[key]
+		if !ok {
+			g = len(m.groups)
+			key2Group[key] = g
+			m.groups = append(m.groups, rowGroup{key: key})
+			members = append(members, nil)
+		}
+
+		members[g] = append(members[g], row)
+		m.rowGroups = append(m.rowGroups, g)
+	}
+
+	m.rows = m.rows[:0]
+	m.viewRows = m.viewRows[:0]
+	for row := 0; row < m.count; row++ {
+		m.viewRows = append(m.viewRows, -1)
+	}
+
+	for g := range m.groups {
+		group := &m.groups[g]
+		group.header = len(m.rows)
+		group.count = len(members[g])
+		group.collapsed = m.collapsed != nil && m.collapsed(group.key)
+
+		m.rows = append(m.rows, -1-g)
+
+		if group.collapsed {
+			continue
+		}
+
+		for _, row := range members[g] {
+			m.viewRows[row] = len(m.rows)
+			m.rows = append(m.rows, row)
+		}
+	}
+}
+
+func (m *groupRowMap) ids() []groupRowID {
+	ids := make([]groupRowID, len(m.rows))
+	for i, row := range m.rows {
+		if row < 0 {
+			ids[i] = groupRowID{key: m.groups[-1-row].key, row: -1}
+		} else {
+			ids[i] = groupRowID{row: row}
+		}
+	}
+
+	return ids
+}
+
+// groupAt returns the index of the group row belongs to and whether row is the
+// header of that group.
+func (m *groupRowMap) groupAt(row int) (g int, header bool) {
+	if r := m.rows[row]; r >= 0 {
+		return m.rowGroups[r], false
+	}
+
+	return -1 - m.rows[row], true
+}
+
+// groupIndex returns the index of the group with key, or -1 if there is none.
+func (m *groupRowMap) groupIndex(key any) int {
+	for g, group := range m.groups {
+		if group.key == key {
+			return g
+		}
+	}
+
+	return -1
+}
+
+func (m *groupRowMap) reset(count int) {
+	m.count = count
+
+	m.build()
+}
+
+func (m *groupRowMap) len() int {
+	return len(m.rows)
+}
+
+// toSource returns -1 for header rows.
+func (m *groupRowMap) toSource(row int) int {
+	if r := m.rows[row]; r >= 0 {
+		return r
+	}
+
+	return -1
+}
+
+func (m *groupRowMap) fromSource(row int) int {
+	if row < 0 || row >= len(m.viewRows) {
+		return -1
+	}
+
+	return m.viewRows[row]
+}
+
+func (m *groupRowMap) inserted(from, to int) []rowEvent {
+	n := to - from + 1
+
+	return m.update(n, func(row int) (int, bool) {
+		if row >= from {
+			row += n
+		}
+
+		return row, true
+	}, nil)
+}
+
+func (m *groupRowMap) removed(from, to int) []rowEvent {
+	n := to - from + 1
+
+	return m.update(-n, func(row int) (int, bool) {
+		switch {
+		case row > to:
+			row -= n
+
+		case row >= from:
+			return 0, false
+		}
+
+		return row, true
+	}, nil)
+}
+
+func (m *groupRowMap) changed(from, to int) []rowEvent {
+	return m.update(0, func(row int) (int, bool) {
+		return row, true
+	}, func(row int) bool {
+		return row >= from && row <= to
+	})
+}
+
+// update rebuilds the map after the number of source rows changed by delta.
+// translate maps the source rows from before to after the change and reports
+// whether a row still exists. changed reports whether a source row that still
+// exists was changed.
+//
+// Groups may change in ways that do not map to inserting and removing rows,
+// e.g. their order. update then returns a single rowsReset event.
+func (m *groupRowMap) update(delta int, translate func(row int) (int, bool), changed func(row int) bool) []rowEvent {
+	oldIDs := m.ids()
+	oldKeys := make([]any, len(oldIDs))
+	for i, id := range oldIDs {
+		if id.row < 0 {
+			continue
+		}
+
+		oldKeys[i] = m.groups[m.rowGroups[id.row]].key
+
+		if row, ok := translate(id.row); ok {
+			oldIDs[i].row = row
+		} else {
+			// No row has this ID after the change.
+			oldIDs[i].row = -2 - i
+		}
+	}
+
+	oldCounts := make(map[any]int, len(m.groups))
+	for _, group := range m.groups {
+		oldCounts[group.key] = group.count
+	}
+
+	m.count += delta
+	m.build()
+
+	// Rows that moved to another group are removed and inserted.
+	for i, id := range oldIDs {
+		if id.row >= 0 && m.groups[m.rowGroups[id.row]].key != oldKeys[i] {
+			oldIDs[i].row = -2 - i
+		}
+	}
+
+	return diffRows(oldIDs, m.ids(), func(j int) bool {
+		if r := m.rows[j]; r >= 0 {
+			return changed != nil && changed(r)
+		}
+
+		group := m.groups[-1-m.rows[j]]
+
+		return oldCounts[group.key] != group.count
+	})
+}
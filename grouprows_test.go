@@ -0,0 +1,165 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"slices"
+	"testing"
+)
+
+// shownGroupRows returns what m shows for rows named by their group key, with
+// "[key]" for headers.
+func shownGroupRows(m *groupRowMap, keys []string) []string {
+	var shown []string
+	for row := 0; row < m.len(); row++ {
+		if r := m.toSource(row); r >= 0 {
+			shown = append(shown, keys[r])
+		} else {
+			g, _ := m.groupAt(row)
+			shown = append(shown, "["+m.groups[g].key.(string)+"]")
+		}
+	}
+
+	return shown
+}
+
+// applyEvents applies events to shown, taking inserted rows from now.
+func applyEvents(shown, now []string, events []rowEvent) []string {
+	shown = slices.Clone(shown)
+
+	for _, e := range events {
+		switch e.kind {
+		case rowsInserted:
+			shown = slices.Insert(shown, e.from, now[e.from:e.to+1]...)
+
+		case rowsRemoved:
+			shown = slices.Delete(shown, e.from, e.to+1)
+
+		case rowsReset:
+			shown = slices.Clone(now)
+		}
+	}
+
+	return shown
+}
+
+func TestGroupRowMapLayout(t *testing.T) {
+	keys := []string{"b", "a", "b", "c", "a"}
+	collapsed := make(map[any]bool)
+
+	m := &groupRowMap{
+		key:       func(row int) any { return keys[row] },
+		collapsed: func(key any) bool { return collapsed[key] },
+	}
+	m.reset(len(keys))
+
+	if got, want := shownGroupRows(m, keys), []string{"[b]", "b", "b", "[a]", "a", "a", "[c]", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("shown = %v, want %v", got, want)
+	}
+
+	for row := range keys {
+		view := m.fromSource(row)
+		if back := m.toSource(view); back != row {
+			t.Errorf("toSource(fromSource(%d)) = %d", row, back)
+		}
+	}
+
+	if g, header := m.groupAt(4); g != 1 || header {
+		t.Errorf("groupAt(4) = %d, %t, want 1, false", g, header)
+	}
+	if g, header := m.groupAt(6); g != 2 || !header {
+		t.Errorf("groupAt(6) = %d, %t, want 2, true", g, header)
+	}
+	if n := m.groups[0].count; n != 2 {
+		t.Errorf("count of group b = %d, want 2", n)
+	}
+
+	collapsed["a"] = true
+	m.reset(len(keys))
+
+	if got, want := shownGroupRows(m, keys), []string{"[b]", "b", "b", "[a]", "[c]", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("shown with a collapsed = %v, want %v", got, want)
+	}
+	if view := m.fromSource(1); view != -1 {
+		t.Errorf("fromSource(1) = %d, want -1 for a collapsed group", view)
+	}
+	if g := m.groupIndex("c"); g != 2 || m.groups[g].header != 4 {
+		t.Errorf("group c at %d with header %d, want 2 with header 4", g, m.groups[g].header)
+	}
+}
+
+func TestGroupRowMapEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		keys   []string
+		change func(keys *[]string, m *groupRowMap) []rowEvent
+		reset  bool
+	}{
+		{"insert into group", []string{"a", "b", "a"}, func(keys *[]string, m *groupRowMap) []rowEvent {
+			*keys = slices.Insert(*keys, 1, "a")
+			return m.inserted(1, 1)
+		}, false},
+		{"insert new group", []string{"a", "b"}, func(keys *[]string, m *groupRowMap) []rowEvent {
+			*keys = append(*keys, "c", "c")
+			return m.inserted(2, 3)
+		}, false},
+		{"remove last of group", []string{"a", "b", "a", "c"}, func(keys *[]string, m *groupRowMap) []rowEvent {
+			*keys = slices.Delete(*keys, 1, 2)
+			return m.removed(1, 1)
+		}, false},
+		{"remove from collapsed group", []string{"a", "B", "B", "c"}, func(keys *[]string, m *groupRowMap) []rowEvent {
+			*keys = slices.Delete(*keys, 2, 3)
+			return m.removed(2, 2)
+		}, false},
+		{"move to other group", []string{"a", "a", "b"}, func(keys *[]string, m *groupRowMap) []rowEvent {
+			(*keys)[1] = "b"
+			return m.changed(1, 1)
+		}, false},
+		{"reorder groups", []string{"a", "b", "a"}, func(keys *[]string, m *groupRowMap) []rowEvent {
+			*keys = slices.Delete(*keys, 0, 1)
+			return m.removed(0, 0)
+		}, true},
+	}
+
+	for _, test := range tests {
+		keys := slices.Clone(test.keys)
+
+		m := &groupRowMap{
+			key:       func(row int) any { return keys[row] },
+			collapsed: func(key any) bool { return key == "B" },
+		}
+		m.reset(len(keys))
+		before := shownGroupRows(m, keys)
+
+		events := test.change(&keys, m)
+		now := shownGroupRows(m, keys)
+
+		if got := applyEvents(before, now, events); !slices.Equal(got, now) {
+			t.Errorf("%s: events %v turn %v into %v, want %v", test.name, events, before, got, now)
+		}
+
+		if reset := slices.ContainsFunc(events, func(e rowEvent) bool { return e.kind == rowsReset }); reset != test.reset {
+			t.Errorf("%s: reset = %t, want %t", test.name, reset, test.reset)
+		}
+	}
+}
+
+func TestGroupRowMapHeaderCountChanges(t *testing.T) {
+	keys := []string{"a", "b"}
+
+	m := &groupRowMap{
+		key:       func(row int) any { return keys[row] },
+		collapsed: func(key any) bool { return false },
+	}
+	m.reset(len(keys))
+
+	keys = append(keys, "a")
+	events := m.inserted(2, 2)
+
+	want := []rowEvent{{kind: rowsInserted, from: 2, to: 2}, {kind: rowsChanged, from: 0, to: 0}}
+	if !slices.Equal(events, want) {
+		t.Errorf("events = %v, want %v", events, want)
+	}
+}
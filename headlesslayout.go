@@ -0,0 +1,477 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+// LayoutItemCfg describes a LayoutItem that is not backed by a window.
+//
+// Such headless items, together with the containers created by
+// NewBoxLayoutItem, NewGridLayoutItem, NewFlowLayoutItem,
+// NewAnchorLayoutItem, NewFormLayoutItem, NewSplitterLayoutItem and
+// NewGroupBoxLayoutItem, can be laid out using PerformLayoutTree without
+// creating any windows, e.g. to test layouts on any platform.
+type LayoutItemCfg struct {
+	LayoutFlags LayoutFlags
+
+	// MinSize is the minimum size in 1/96" units. If zero, IdealSize is used.
+	MinSize Size
+
+	// IdealSize is the ideal size in 1/96" units.
+	IdealSize Size
+
+	// MaxSize is the maximum size in 1/96" units. Zero means unbounded.
+	MaxSize Size
+
+	Alignment                   Alignment2D
+	Hidden                      bool
+	ConsumingSpaceWhenInvisible bool
+
+	// HeightForWidth, if not nil, returns the height required by the item
+	// for width. Both values are in 1/96" units.
+	HeightForWidth func(width int) int
+
+	// Baseline, if not nil, returns the distance from the top of the item
+	// to the baseline of its text for height. Both values are in 1/96" units.
+	Baseline func(height int) int
+}
+
+// NewLayoutItemWithCfg returns a new headless LayoutItem described by cfg.
+func NewLayoutItemWithCfg(ctx *LayoutContext, cfg *LayoutItemCfg) LayoutItem {
+	li := &headlessLayoutItem{cfg: *cfg}
+
+	initHeadlessLayoutItem(&li.LayoutItemBase, ctx)
+	li.visible = !cfg.Hidden
+	li.geometry.Alignment = cfg.Alignment
+	li.geometry.MaxSize = SizeFrom96DPI(cfg.MaxSize, ctx.dpi)
+	li.geometry.ConsumingSpaceWhenInvisible = cfg.ConsumingSpaceWhenInvisible
+
+	return li
+}
+
+type headlessLayoutItem struct {
+	LayoutItemBase
+	cfg LayoutItemCfg
+}
+
+func (li *headlessLayoutItem) LayoutFlags() LayoutFlags {
+	return li.cfg.LayoutFlags
+}
+
+func (li *headlessLayoutItem) IdealSize() Size {
+	return SizeFrom96DPI(li.cfg.IdealSize, li.ctx.dpi)
+}
+
+func (li *headlessLayoutItem) MinSize() Size {
+	if li.cfg.MinSize.IsZero() {
+		return li.IdealSize()
+	}
+
+	return SizeFrom96DPI(li.cfg.MinSize, li.ctx.dpi)
+}
+
+func (li *headlessLayoutItem) HasHeightForWidth() bool {
+	return li.cfg.HeightForWidth != nil
+}
+
+func (li *headlessLayoutItem) HeightForWidth(width int) int {
+	return IntFrom96DPI(li.cfg.HeightForWidth(IntTo96DPI(width, li.ctx.dpi)), li.ctx.dpi)
+}
+
+func (li *headlessLayoutItem) HasBaseline() bool {
+	return li.cfg.Baseline != nil
+}
+
+func (li *headlessLayoutItem) Baseline(height int) int {
+	return IntFrom96DPI(li.cfg.Baseline(IntTo96DPI(height, li.ctx.dpi)), li.ctx.dpi)
+}
+
+// NewSpacerLayoutItemWithCfg returns a new headless spacer LayoutItem
+// described by cfg.
+func NewSpacerLayoutItemWithCfg(ctx *LayoutContext, cfg *SpacerCfg) LayoutItem {
+	li := &spacerLayoutItem{
+		idealSize96dpi:    cfg.SizeHint,
+		layoutFlags:       cfg.LayoutFlags,
+		greedyLocallyOnly: cfg.GreedyLocallyOnly,
+	}
+
+	initHeadlessLayoutItem(&li.LayoutItemBase, ctx)
+
+	return li
+}
+
+// BoxLayoutItemCfg describes a headless ContainerLayoutItem that arranges its
+// children like a BoxLayout.
+type BoxLayoutItemCfg struct {
+	Orientation Orientation
+	Margins     Margins // in 1/96" units
+	Spacing     int     // in 1/96" units
+	Alignment   Alignment2D
+	Children    []LayoutItem
+
+	// BaselineAlignment lines up the baselines of horizontally arranged
+	// children, see LayoutBase.SetBaselineAlignment.
+	BaselineAlignment bool
+
+	// RightToLeft mirrors the layout horizontally, see
+	// ContainerBase.SetRightToLeft.
+	RightToLeft bool
+
+	// StretchFactors holds the stretch factor of the child at the same index.
+	// Missing or zero values mean 1.
+	StretchFactors []int
+}
+
+// NewBoxLayoutItem returns a new headless ContainerLayoutItem described by cfg.
+func NewBoxLayoutItem(ctx *LayoutContext, cfg *BoxLayoutItemCfg) ContainerLayoutItem {
+	li := &boxLayoutItem{
+		size2MinSize:       make(map[Size]Size),
+		orientation:        cfg.Orientation,
+		hwnd2StretchFactor: headlessStretchFactors(cfg.Children, cfg.StretchFactors),
+	}
+
+	initHeadlessContainerLayoutItem(li, ctx, cfg.Margins, cfg.Spacing, cfg.Alignment, cfg.Children)
+	li.baselineAlignment = cfg.BaselineAlignment
+	li.rightToLeft = cfg.RightToLeft
+
+	return li
+}
+
+// GridLayoutItemCfg describes a headless ContainerLayoutItem that arranges its
+// children like a GridLayout.
+type GridLayoutItemCfg struct {
+	Margins              Margins // in 1/96" units
+	Spacing              int     // in 1/96" units
+	Alignment            Alignment2D
+	BaselineAlignment    bool
+	RightToLeft          bool
+	RowStretchFactors    []int
+	ColumnStretchFactors []int
+	Cells                []GridLayoutCellCfg
+}
+
+// GridLayoutCellCfg places Item in a GridLayoutItemCfg.
+type GridLayoutCellCfg struct {
+	Item LayoutItem
+
+	// Range specifies column, row, column span and row span of Item.
+	Range Rectangle
+}
+
+// NewGridLayoutItem returns a new headless ContainerLayoutItem described by cfg.
+func NewGridLayoutItem(ctx *LayoutContext, cfg *GridLayoutItemCfg) ContainerLayoutItem {
+	var rows, columns int
+	for _, c := range cfg.Cells {
+		rows = maxi(rows, c.Range.Y+maxi(1, c.Range.Height))
+		columns = maxi(columns, c.Range.X+maxi(1, c.Range.Width))
+	}
+
+	stretchFactors := func(factors []int, count int) []int {
+		sf := make([]int, maxi(count, len(factors)))
+		for i := range sf {
+			if i < len(factors) && factors[i] > 0 {
+				sf[i] = factors[i]
+			} else {
+				sf[i] = 1
+			}
+		}
+		return sf
+	}
+
+	li := &gridLayoutItem{
+		size2MinSize:         make(map[Size]Size),
+		rowStretchFactors:    stretchFactors(cfg.RowStretchFactors, rows),
+		columnStretchFactors: stretchFactors(cfg.ColumnStretchFactors, columns),
+		item2Info:            make(map[LayoutItem]*gridLayoutItemInfo, len(cfg.Cells)),
+	}
+
+	li.cells = make([][]gridLayoutItemCell, len(li.rowStretchFactors))
+	for row := range li.cells {
+		li.cells[row] = make([]gridLayoutItemCell, len(li.columnStretchFactors))
+		for col := range li.cells[row] {
+			li.cells[row][col].row = row
+			li.cells[row][col].column = col
+		}
+	}
+
+	children := make([]LayoutItem, 0, len(cfg.Cells))
+	for _, c := range cfg.Cells {
+		r := c.Range
+		r.Width = maxi(1, r.Width)
+		r.Height = maxi(1, r.Height)
+
+		for row := r.Y; row < r.Y+r.Height; row++ {
+			for col := r.X; col < r.X+r.Width; col++ {
+				li.cells[row][col].item = c.Item
+			}
+		}
+
+		li.item2Info[c.Item] = &gridLayoutItemInfo{
+			cell:     &li.cells[r.Y][r.X],
+			spanHorz: r.Width,
+			spanVert: r.Height,
+		}
+
+		children = append(children, c.Item)
+	}
+
+	initHeadlessContainerLayoutItem(li, ctx, cfg.Margins, cfg.Spacing, cfg.Alignment, children)
+	li.baselineAlignment = cfg.BaselineAlignment
+	li.rightToLeft = cfg.RightToLeft
+
+	return li
+}
+
+// FlowLayoutItemCfg describes a headless ContainerLayoutItem that arranges its
+// children like a FlowLayout.
+type FlowLayoutItemCfg struct {
+	Margins   Margins // in 1/96" units
+	Spacing   int     // in 1/96" units
+	Alignment Alignment2D
+	Children  []LayoutItem
+
+	// BaselineAlignment lines up the baselines of the children in each row,
+	// see LayoutBase.SetBaselineAlignment.
+	BaselineAlignment bool
+
+	// RightToLeft mirrors the layout horizontally, see
+	// ContainerBase.SetRightToLeft.
+	RightToLeft bool
+
+	// StretchFactors holds the stretch factor of the child at the same index.
+	// Missing or zero values mean 1.
+	StretchFactors []int
+}
+
+// NewFlowLayoutItem returns a new headless ContainerLayoutItem described by cfg.
+func NewFlowLayoutItem(ctx *LayoutContext, cfg *FlowLayoutItemCfg) ContainerLayoutItem {
+	li := &flowLayoutItem{
+		size2MinSize:       make(map[Size]Size),
+		hwnd2StretchFactor: headlessStretchFactors(cfg.Children, cfg.StretchFactors),
+	}
+
+	initHeadlessContainerLayoutItem(li, ctx, cfg.Margins, cfg.Spacing, cfg.Alignment, cfg.Children)
+	li.baselineAlignment = cfg.BaselineAlignment
+	li.rightToLeft = cfg.RightToLeft
+
+	return li
+}
+
+// AnchorLayoutItemCfg describes a headless ContainerLayoutItem that arranges
+// its children like an AnchorLayout.
+type AnchorLayoutItemCfg struct {
+	Margins  Margins // in 1/96" units
+	Children []LayoutItem
+
+	// Anchors holds the anchors of the child at the same index. Anchor
+	// targets must be LayoutItems from Children.
+	Anchors []Anchors
+}
+
+// NewAnchorLayoutItem returns a new headless ContainerLayoutItem described by
+// cfg.
+func NewAnchorLayoutItem(ctx *LayoutContext, cfg *AnchorLayoutItemCfg) ContainerLayoutItem {
+	li := &anchorLayoutItem{
+		size2MinSize: make(map[Size]Size),
+		hwnd2Anchors: make(map[layoutHandle]anchorsInfo),
+	}
+
+	for i, anchors := range cfg.Anchors {
+		if i < len(cfg.Children) {
+			li.hwnd2Anchors[cfg.Children[i].Handle()] = anchors.info()
+		}
+	}
+
+	initHeadlessContainerLayoutItem(li, ctx, cfg.Margins, 0, AlignHVDefault, cfg.Children)
+
+	return li
+}
+
+// FormLayoutItemCfg describes a headless ContainerLayoutItem that arranges its
+// children like a FormLayout.
+type FormLayoutItemCfg struct {
+	Margins Margins // in 1/96" units
+	Spacing int     // in 1/96" units
+	Rows    []FormLayoutRowCfg
+}
+
+// FormLayoutRowCfg describes a row of a FormLayoutItemCfg.
+type FormLayoutRowCfg struct {
+	// Label is placed in the label column. If nil, Field spans both columns.
+	Label LayoutItem
+	Field LayoutItem
+}
+
+// NewFormLayoutItem returns a new headless ContainerLayoutItem described by
+// cfg.
+func NewFormLayoutItem(ctx *LayoutContext, cfg *FormLayoutItemCfg) ContainerLayoutItem {
+	li := &formLayoutItem{
+		size2MinSize: make(map[Size]Size),
+		field2Label:  make(map[layoutHandle]layoutHandle),
+	}
+
+	var children []LayoutItem
+	for _, row := range cfg.Rows {
+		if row.Label != nil {
+			children = append(children, row.Label)
+			li.field2Label[row.Field.Handle()] = row.Label.Handle()
+		}
+		children = append(children, row.Field)
+	}
+
+	initHeadlessContainerLayoutItem(li, ctx, cfg.Margins, cfg.Spacing, AlignHVDefault, children)
+
+	return li
+}
+
+// SplitterLayoutItemCfg describes a headless ContainerLayoutItem that arranges
+// its children like a Splitter. Splitter handles are inserted between the
+// children automatically.
+type SplitterLayoutItemCfg struct {
+	Orientation Orientation
+	Margins     Margins // in 1/96" units
+	HandleWidth int     // in 1/96" units
+	Children    []LayoutItem
+
+	// StretchFactors holds the stretch factor of the child at the same index.
+	// Missing or zero values mean 1.
+	StretchFactors []int
+}
+
+// NewSplitterLayoutItem returns a new headless ContainerLayoutItem described
+// by cfg.
+func NewSplitterLayoutItem(ctx *LayoutContext, cfg *SplitterLayoutItemCfg) ContainerLayoutItem {
+	li := &splitterContainerLayoutItem{
+		orientation:      cfg.Orientation,
+		hwnd2Item:        make(map[layoutHandle]*splitterLayoutItem),
+		handleWidth96dpi: cfg.HandleWidth,
+		anyNonFixed:      true,
+		resetNeeded:      true,
+	}
+
+	var children []LayoutItem
+	for i, child := range cfg.Children {
+		if i > 0 {
+			handle := &splitterHandleLayoutItem{
+				orientation: cfg.Orientation,
+				handleWidth: cfg.HandleWidth,
+			}
+			initHeadlessLayoutItem(&handle.LayoutItemBase, ctx)
+			children = append(children, handle)
+
+			li.spaceUnavailableToRegularItems += IntFrom96DPI(cfg.HandleWidth, ctx.dpi)
+		}
+
+		sf := 1
+		if i < len(cfg.StretchFactors) && cfg.StretchFactors[i] > 0 {
+			sf = cfg.StretchFactors[i]
+		}
+		li.hwnd2Item[child.Handle()] = &splitterLayoutItem{stretchFactor: sf}
+
+		children = append(children, child)
+	}
+
+	initHeadlessContainerLayoutItem(li, ctx, cfg.Margins, 0, AlignHVDefault, children)
+
+	return li
+}
+
+// GroupBoxLayoutItemCfg describes a headless ContainerLayoutItem that frames
+// Content like a GroupBox.
+type GroupBoxLayoutItemCfg struct {
+	// HeaderHeight is the height of the title area in 1/96" units.
+	HeaderHeight int
+
+	Content ContainerLayoutItem
+}
+
+// NewGroupBoxLayoutItem returns a new headless ContainerLayoutItem described
+// by cfg.
+func NewGroupBoxLayoutItem(ctx *LayoutContext, cfg *GroupBoxLayoutItemCfg) ContainerLayoutItem {
+	li := &groupBoxLayoutItem{
+		compositePos: Point{IntFrom96DPI(1, ctx.dpi), IntFrom96DPI(cfg.HeaderHeight, ctx.dpi)},
+	}
+
+	initHeadlessContainerLayoutItem(li, ctx, Margins{}, 0, AlignHVDefault, []LayoutItem{cfg.Content})
+
+	return li
+}
+
+// SetLayoutItemVisible sets whether the headless item takes part in layout.
+func SetLayoutItemVisible(item LayoutItem, visible bool) {
+	item.AsLayoutItemBase().visible = visible
+}
+
+// PerformLayoutTree synchronously lays out root and all of its descendant
+// containers. size is the client size of root in native pixels.
+//
+// The bounds of the returned items are relative to their containers.
+func PerformLayoutTree(root ContainerLayoutItem, size Size) []LayoutResult {
+	var results []LayoutResult
+
+	var layoutSubtree func(container ContainerLayoutItem, size Size)
+	layoutSubtree = func(container ContainerLayoutItem, size Size) {
+		container.AsContainerLayoutItemBase().geometry.ClientSize = size
+
+		items := performContainerLayout(container)
+
+		results = append(results, LayoutResult{container, items})
+
+		for _, item := range items {
+			item.Item.Geometry().Size = item.Bounds.Size()
+
+			if childContainer, ok := item.Item.(ContainerLayoutItem); ok {
+				layoutSubtree(childContainer, item.Bounds.Size())
+			}
+		}
+	}
+
+	root.Geometry().Size = size
+	layoutSubtree(root, size)
+
+	return results
+}
+
+// Container returns the ContainerLayoutItem that was laid out.
+func (lr LayoutResult) Container() ContainerLayoutItem {
+	return lr.container
+}
+
+// Items returns the resulting bounds of the children of Container.
+func (lr LayoutResult) Items() []LayoutResultItem {
+	return lr.items
+}
+
+func initHeadlessLayoutItem(lib *LayoutItemBase, ctx *LayoutContext) {
+	ctx.lastHeadlessHandle++
+
+	lib.ctx = ctx
+	lib.handle = ctx.lastHeadlessHandle
+	lib.visible = true
+}
+
+func initHeadlessContainerLayoutItem(container ContainerLayoutItem, ctx *LayoutContext, margins96dpi Margins, spacing96dpi int, alignment Alignment2D, children []LayoutItem) {
+	clib := container.AsContainerLayoutItemBase()
+
+	initHeadlessLayoutItem(&clib.LayoutItemBase, ctx)
+	clib.margins96dpi = margins96dpi
+	clib.spacing96dpi = spacing96dpi
+	clib.alignment = alignment
+	clib.children = children
+
+	for _, child := range children {
+		child.AsLayoutItemBase().parent = container
+	}
+}
+
+func headlessStretchFactors(children []LayoutItem, factors []int) map[layoutHandle]int {
+	hwnd2StretchFactor := make(map[layoutHandle]int)
+
+	for i, factor := range factors {
+		if i < len(children) && factor > 0 {
+			hwnd2StretchFactor[children[i].Handle()] = factor
+		}
+	}
+
+	return hwnd2StretchFactor
+}
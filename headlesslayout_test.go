@@ -0,0 +1,520 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"testing"
+)
+
+func boundsOf(t *testing.T, results []LayoutResult, item LayoutItem) Rectangle {
+	t.Helper()
+
+	for _, result := range results {
+		for _, ri := range result.Items() {
+			if ri.Item == item {
+				return ri.Bounds
+			}
+		}
+	}
+
+	t.Fatalf("no layout result for item %p", item)
+	return Rectangle{}
+}
+
+func TestHeadlessBoxLayout(t *testing.T) {
+	testCases := []struct {
+		dpi        int
+		size       Size
+		wantFixed  Rectangle
+		wantGreedy Rectangle
+	}{
+		{96, Size{300, 100}, Rectangle{9, 40, 50, 20}, Rectangle{65, 40, 226, 20}},
+		{192, Size{600, 200}, Rectangle{18, 80, 100, 40}, Rectangle{130, 80, 452, 40}},
+	}
+
+	for _, tc := range testCases {
+		ctx := NewLayoutContext(tc.dpi)
+
+		fixed := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{IdealSize: Size{50, 20}})
+		greedy := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{
+			LayoutFlags: ShrinkableHorz | GrowableHorz | GreedyHorz,
+			IdealSize:   Size{50, 20},
+		})
+
+		root := NewBoxLayoutItem(ctx, &BoxLayoutItemCfg{
+			Orientation: Horizontal,
+			Margins:     Margins{9, 9, 9, 9},
+			Spacing:     6,
+			Children:    []LayoutItem{fixed, greedy},
+		})
+
+		results := PerformLayoutTree(root, tc.size)
+
+		if got := boundsOf(t, results, fixed); got != tc.wantFixed {
+			t.Errorf("dpi %d: fixed bounds: got %v, want %v", tc.dpi, got, tc.wantFixed)
+		}
+		if got := boundsOf(t, results, greedy); got != tc.wantGreedy {
+			t.Errorf("dpi %d: greedy bounds: got %v, want %v", tc.dpi, got, tc.wantGreedy)
+		}
+	}
+}
+
+func TestHeadlessGridLayout(t *testing.T) {
+	ctx := NewLayoutContext(96)
+
+	newLabel := func() LayoutItem {
+		return NewLayoutItemWithCfg(ctx, &LayoutItemCfg{IdealSize: Size{40, 20}})
+	}
+	newField := func() LayoutItem {
+		return NewLayoutItemWithCfg(ctx, &LayoutItemCfg{
+			LayoutFlags: ShrinkableHorz | GrowableHorz | GreedyHorz,
+			IdealSize:   Size{100, 20},
+		})
+	}
+
+	label0, field0, label1, field1 := newLabel(), newField(), newLabel(), newField()
+
+	root := NewGridLayoutItem(ctx, &GridLayoutItemCfg{
+		Margins: Margins{9, 9, 9, 9},
+		Spacing: 6,
+		Cells: []GridLayoutCellCfg{
+			{Item: label0, Range: Rectangle{0, 0, 1, 1}},
+			{Item: field0, Range: Rectangle{1, 0, 1, 1}},
+			{Item: label1, Range: Rectangle{0, 1, 1, 1}},
+			{Item: field1, Range: Rectangle{1, 1, 1, 1}},
+		},
+	})
+
+	results := PerformLayoutTree(root, Size{400, 200})
+
+	for _, tc := range []struct {
+		item LayoutItem
+		want Rectangle
+	}{
+		{label0, Rectangle{9, 9, 40, 20}},
+		{field0, Rectangle{55, 9, 336, 20}},
+		{label1, Rectangle{9, 35, 40, 20}},
+		{field1, Rectangle{55, 35, 336, 20}},
+	} {
+		if got := boundsOf(t, results, tc.item); got != tc.want {
+			t.Errorf("bounds: got %v, want %v", got, tc.want)
+		}
+	}
+}
+
+func TestHeadlessFlowLayoutWraps(t *testing.T) {
+	ctx := NewLayoutContext(96)
+
+	var children []LayoutItem
+	for range 3 {
+		children = append(children, NewLayoutItemWithCfg(ctx, &LayoutItemCfg{IdealSize: Size{100, 20}}))
+	}
+
+	root := NewFlowLayoutItem(ctx, &FlowLayoutItemCfg{
+		Margins:  Margins{9, 9, 9, 9},
+		Spacing:  6,
+		Children: children,
+	})
+
+	results := PerformLayoutTree(root, Size{250, 100})
+
+	for i, wantY := range []int{9, 9, 35} {
+		if got := boundsOf(t, results, children[i]); got.Y != wantY || got.Width != 100 || got.Height != 20 {
+			t.Errorf("child %d: got %v, want Y %d and size 100x20", i, got, wantY)
+		}
+	}
+}
+
+func TestHeadlessSplitterLayout(t *testing.T) {
+	ctx := NewLayoutContext(96)
+
+	newPane := func() LayoutItem {
+		return NewLayoutItemWithCfg(ctx, &LayoutItemCfg{
+			LayoutFlags: ShrinkableHorz | ShrinkableVert | GrowableHorz | GrowableVert | GreedyHorz | GreedyVert,
+			IdealSize:   Size{100, 100},
+		})
+	}
+
+	left, right := newPane(), newPane()
+
+	root := NewSplitterLayoutItem(ctx, &SplitterLayoutItemCfg{
+		Orientation:    Horizontal,
+		HandleWidth:    4,
+		Children:       []LayoutItem{left, right},
+		StretchFactors: []int{1, 3},
+	})
+
+	results := PerformLayoutTree(root, Size{404, 100})
+
+	if got, want := boundsOf(t, results, left), (Rectangle{0, 0, 100, 100}); got != want {
+		t.Errorf("left bounds: got %v, want %v", got, want)
+	}
+	if got, want := boundsOf(t, results, right), (Rectangle{104, 0, 300, 100}); got != want {
+		t.Errorf("right bounds: got %v, want %v", got, want)
+	}
+}
+
+func TestHeadlessNestedLayout(t *testing.T) {
+	ctx := NewLayoutContext(96)
+
+	button := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{IdealSize: Size{75, 23}})
+	row := NewBoxLayoutItem(ctx, &BoxLayoutItemCfg{
+		Orientation: Horizontal,
+		Children:    []LayoutItem{button},
+	})
+	root := NewBoxLayoutItem(ctx, &BoxLayoutItemCfg{
+		Orientation: Vertical,
+		Margins:     Margins{9, 9, 9, 9},
+		Children:    []LayoutItem{row},
+	})
+
+	results := PerformLayoutTree(root, Size{200, 100})
+
+	if len(results) != 2 {
+		t.Fatalf("got %d layout results, want 2", len(results))
+	}
+	if results[1].Container() != row {
+		t.Errorf("second result is not for nested container")
+	}
+	if got := boundsOf(t, results, button).Size(); got != (Size{75, 23}) {
+		t.Errorf("button size: got %v, want 75x23", got)
+	}
+}
+
+func TestHeadlessAnchorLayout(t *testing.T) {
+	ctx := NewLayoutContext(96)
+
+	label := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{
+		IdealSize: Size{40, 15},
+		Baseline:  func(height int) int { return 12 },
+	})
+	edit := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{
+		LayoutFlags: ShrinkableHorz | GrowableHorz | GreedyHorz,
+		IdealSize:   Size{100, 21},
+		Baseline:    func(height int) int { return 15 },
+	})
+	button := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{IdealSize: Size{75, 23}})
+
+	root := NewAnchorLayoutItem(ctx, &AnchorLayoutItemCfg{
+		Margins:  Margins{9, 9, 9, 9},
+		Children: []LayoutItem{label, edit, button},
+		Anchors: []Anchors{
+			{},
+			{
+				Left:     Anchor{Target: label, Edge: AnchorRight, Offset: 6},
+				Right:    Anchor{Edge: AnchorRight},
+				Baseline: Anchor{Target: label, Edge: AnchorBaseline},
+			},
+			{
+				Top:   Anchor{Target: edit, Edge: AnchorBottom, Offset: 6},
+				Right: Anchor{Edge: AnchorRight},
+			},
+		},
+	})
+
+	results := PerformLayoutTree(root, Size{300, 200})
+
+	for _, tc := range []struct {
+		name string
+		item LayoutItem
+		want Rectangle
+	}{
+		{"label", label, Rectangle{9, 9, 40, 15}},
+		{"edit", edit, Rectangle{55, 6, 236, 21}},
+		{"button", button, Rectangle{216, 33, 75, 23}},
+	} {
+		if got := boundsOf(t, results, tc.item); got != tc.want {
+			t.Errorf("%s bounds: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	if got, want := root.MinSize(), (Size{164, 65}); got != want {
+		t.Errorf("min size: got %v, want %v", got, want)
+	}
+}
+
+func TestHeadlessAnchorLayoutCycle(t *testing.T) {
+	ctx := NewLayoutContext(96)
+
+	a := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{IdealSize: Size{20, 20}})
+	b := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{IdealSize: Size{20, 20}})
+
+	root := NewAnchorLayoutItem(ctx, &AnchorLayoutItemCfg{
+		Children: []LayoutItem{a, b},
+		Anchors: []Anchors{
+			{Left: Anchor{Target: b, Edge: AnchorRight}},
+			{Left: Anchor{Target: a, Edge: AnchorRight}},
+		},
+	})
+
+	results := PerformLayoutTree(root, Size{100, 100})
+
+	// The anchor closing the cycle is ignored, so b ends up at the margin.
+	if got, want := boundsOf(t, results, a), (Rectangle{20, 0, 20, 20}); got != want {
+		t.Errorf("a bounds: got %v, want %v", got, want)
+	}
+	if got, want := boundsOf(t, results, b), (Rectangle{0, 0, 20, 20}); got != want {
+		t.Errorf("b bounds: got %v, want %v", got, want)
+	}
+}
+
+func TestHeadlessBaselineAlignment(t *testing.T) {
+	ctx := NewLayoutContext(96)
+
+	newLabel := func() LayoutItem {
+		return NewLayoutItemWithCfg(ctx, &LayoutItemCfg{
+			IdealSize: Size{40, 15},
+			Baseline:  func(height int) int { return 11 },
+		})
+	}
+	newEdit := func() LayoutItem {
+		return NewLayoutItemWithCfg(ctx, &LayoutItemCfg{
+			LayoutFlags: ShrinkableHorz | GrowableHorz | GreedyHorz,
+			IdealSize:   Size{100, 21},
+			Baseline:    func(height int) int { return (height-15)/2 + 12 },
+		})
+	}
+
+	label, edit := newLabel(), newEdit()
+
+	box := NewBoxLayoutItem(ctx, &BoxLayoutItemCfg{
+		Orientation:       Horizontal,
+		Spacing:           6,
+		Children:          []LayoutItem{label, edit},
+		BaselineAlignment: true,
+	})
+
+	results := PerformLayoutTree(box, Size{300, 40})
+
+	if got := boundsOf(t, results, label).Y; got != 13 {
+		t.Errorf("box: label Y: got %d, want 13", got)
+	}
+	if got := boundsOf(t, results, edit).Y; got != 9 {
+		t.Errorf("box: edit Y: got %d, want 9", got)
+	}
+
+	label0, edit0, label1, edit1 := newLabel(), newEdit(), newLabel(), newEdit()
+
+	grid := NewGridLayoutItem(ctx, &GridLayoutItemCfg{
+		Margins:           Margins{9, 9, 9, 9},
+		Spacing:           6,
+		BaselineAlignment: true,
+		Cells: []GridLayoutCellCfg{
+			{Item: label0, Range: Rectangle{0, 0, 1, 1}},
+			{Item: edit0, Range: Rectangle{1, 0, 1, 1}},
+			{Item: label1, Range: Rectangle{0, 1, 1, 1}},
+			{Item: edit1, Range: Rectangle{1, 1, 1, 1}},
+		},
+	})
+
+	results = PerformLayoutTree(grid, Size{300, 100})
+
+	for row, items := range [][2]LayoutItem{{label0, edit0}, {label1, edit1}} {
+		l, e := boundsOf(t, results, items[0]), boundsOf(t, results, items[1])
+
+		if lb, eb := l.Y+11, e.Y+(e.Height-15)/2+12; lb != eb {
+			t.Errorf("grid row %d: label baseline %d, edit baseline %d", row, lb, eb)
+		}
+	}
+}
+
+func TestHeadlessFormLayout(t *testing.T) {
+	ctx := NewLayoutContext(96)
+
+	label := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{
+		IdealSize: Size{40, 15},
+		Baseline:  func(height int) int { return 12 },
+	})
+	field := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{
+		LayoutFlags: ShrinkableHorz | GrowableHorz | GreedyHorz,
+		IdealSize:   Size{100, 21},
+		Baseline:    func(height int) int { return 15 },
+	})
+	check := NewLayoutItemWithCfg(ctx, &LayoutItemCfg{IdealSize: Size{80, 20}})
+
+	newRoot := func() ContainerLayoutItem {
+		return NewFormLayoutItem(ctx, &FormLayoutItemCfg{
+			Margins: Margins{9, 9, 9, 9},
+			Spacing: 6,
+			Rows: []FormLayoutRowCfg{
+				{Label: label, Field: field},
+				{Field: check},
+			},
+		})
+	}
+
+	testCases := []struct {
+		size                            Size
+		wantLabel, wantField, wantCheck Rectangle
+	}{
+		{Size{300, 200}, Rectangle{9, 12, 40, 15}, Rectangle{55, 9, 236, 21}, Rectangle{9, 36, 80, 20}},
+		{Size{150, 200}, Rectangle{9, 9, 40, 15}, Rectangle{9, 30, 132, 21}, Rectangle{9, 57, 80, 20}},
+	}
+
+	for _, tc := range testCases {
+		results := PerformLayoutTree(newRoot(), tc.size)
+
+		if got := boundsOf(t, results, label); got != tc.wantLabel {
+			t.Errorf("%v: label bounds: got %v, want %v", tc.size, got, tc.wantLabel)
+		}
+		if got := boundsOf(t, results, field); got != tc.wantField {
+			t.Errorf("%v: field bounds: got %v, want %v", tc.size, got, tc.wantField)
+		}
+		if got := boundsOf(t, results, check); got != tc.wantCheck {
+			t.Errorf("%v: check bounds: got %v, want %v", tc.size, got, tc.wantCheck)
+		}
+	}
+
+	if got, want := newRoot().(MinSizeForSizer).MinSizeForSize(Size{}).Width, 118; got != want {
+		t.Errorf("min width: got %d, want %d", got, want)
+	}
+}
+
+func TestHeadlessRightToLeft(t *testing.T) {
+	ctx := NewLayoutContext(96)
+
+	newItem := func(width int) LayoutItem {
+		return NewLayoutItemWithCfg(ctx, &LayoutItemCfg{IdealSize: Size{width, 20}})
+	}
+	newGreedy := func() LayoutItem {
+		return NewLayoutItemWithCfg(ctx, &LayoutItemCfg{
+			LayoutFlags: ShrinkableHorz | GrowableHorz | GreedyHorz,
+			IdealSize:   Size{50, 20},
+		})
+	}
+
+	t.Run("box", func(t *testing.T) {
+		fixed, greedy := newItem(50), newGreedy()
+
+		root := NewBoxLayoutItem(ctx, &BoxLayoutItemCfg{
+			Orientation: Horizontal,
+			Margins:     Margins{9, 9, 9, 9},
+			Spacing:     6,
+			RightToLeft: true,
+			Children:    []LayoutItem{fixed, greedy},
+		})
+
+		results := PerformLayoutTree(root, Size{300, 100})
+
+		if got, want := boundsOf(t, results, fixed), (Rectangle{241, 40, 50, 20}); got != want {
+			t.Errorf("fixed bounds: got %v, want %v", got, want)
+		}
+		if got, want := boundsOf(t, results, greedy), (Rectangle{9, 40, 226, 20}); got != want {
+			t.Errorf("greedy bounds: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("grid", func(t *testing.T) {
+		label, field := newItem(40), newGreedy()
+
+		root := NewGridLayoutItem(ctx, &GridLayoutItemCfg{
+			Margins:     Margins{9, 9, 9, 9},
+			Spacing:     6,
+			RightToLeft: true,
+			Cells: []GridLayoutCellCfg{
+				{Item: label, Range: Rectangle{0, 0, 1, 1}},
+				{Item: field, Range: Rectangle{1, 0, 1, 1}},
+			},
+		})
+
+		results := PerformLayoutTree(root, Size{400, 200})
+
+		if got, want := boundsOf(t, results, label), (Rectangle{351, 9, 40, 20}); got != want {
+			t.Errorf("label bounds: got %v, want %v", got, want)
+		}
+		if got, want := boundsOf(t, results, field), (Rectangle{9, 9, 336, 20}); got != want {
+			t.Errorf("field bounds: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("flow", func(t *testing.T) {
+		layout := func(rightToLeft bool) []Rectangle {
+			children := []LayoutItem{newItem(100), newItem(100), newItem(100)}
+
+			root := NewFlowLayoutItem(ctx, &FlowLayoutItemCfg{
+				Margins:     Margins{9, 9, 9, 9},
+				Spacing:     6,
+				Alignment:   AlignHNearVNear,
+				RightToLeft: rightToLeft,
+				Children:    children,
+			})
+
+			results := PerformLayoutTree(root, Size{250, 100})
+
+			var bounds []Rectangle
+			for _, child := range children {
+				bounds = append(bounds, boundsOf(t, results, child))
+			}
+			return bounds
+		}
+
+		ltr, rtl := layout(false), layout(true)
+
+		for i := range ltr {
+			want := ltr[i]
+			want.X = 250 - want.X - want.Width
+
+			if rtl[i] != want {
+				t.Errorf("child %d: got %v, want %v", i, rtl[i], want)
+			}
+		}
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		first, second := newItem(40), newItem(40)
+
+		inner := NewBoxLayoutItem(ctx, &BoxLayoutItemCfg{
+			Orientation: Horizontal,
+			Spacing:     10,
+			Alignment:   AlignHNearVNear,
+			Children:    []LayoutItem{first, second},
+		})
+
+		root := NewBoxLayoutItem(ctx, &BoxLayoutItemCfg{
+			Orientation: Horizontal,
+			RightToLeft: true,
+			Children:    []LayoutItem{inner},
+		})
+
+		results := PerformLayoutTree(root, Size{200, 20})
+
+		// Only the children of containers laying out from right to left are
+		// mirrored.
+		if got, want := boundsOf(t, results, first).X, 0; got != want {
+			t.Errorf("first X: got %d, want %d", got, want)
+		}
+		if got, want := boundsOf(t, results, second).X, 50; got != want {
+			t.Errorf("second X: got %d, want %d", got, want)
+		}
+	})
+}
+
+func TestAlignmentMirrored(t *testing.T) {
+	for _, tc := range []struct {
+		alignment, want Alignment1D
+	}{
+		{AlignDefault, AlignDefault},
+		{AlignNear, AlignFar},
+		{AlignCenter, AlignCenter},
+		{AlignFar, AlignNear},
+	} {
+		if got := tc.alignment.mirrored(); got != tc.want {
+			t.Errorf("%d.mirrored(): got %d, want %d", tc.alignment, got, tc.want)
+		}
+	}
+
+	for _, tc := range []struct {
+		alignment, want Alignment2D
+	}{
+		{AlignHVDefault, AlignHVDefault},
+		{AlignHNearVNear, AlignHFarVNear},
+		{AlignHCenterVCenter, AlignHCenterVCenter},
+		{AlignHFarVFar, AlignHNearVFar},
+	} {
+		if got := tc.alignment.mirrored(); got != tc.want {
+			t.Errorf("%d.mirrored(): got %d, want %d", tc.alignment, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,470 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// jsonSettingsTimestampsName is the name of the object in a settings file,
+// that maps the keys of expiring values to the time they were put.
+const jsonSettingsTimestampsName = "$timestamps"
+
+// JSONFileSettings is a Settings, that stores values in a JSON file.
+//
+// Keys are paths of names separated by "/", like the keys windows store their
+// state under, and map to nested objects in the file. If a key has both a value
+// and keys below it, the value is stored under the empty name in the object of
+// the key. Values, that are not strings in the file, are returned as JSON.
+//
+// Entries of the file are kept as they are, unless they are put or removed.
+// Save replaces the file atomically. If the file was modified by someone else
+// since it was loaded, Save applies the values put and removed since then to
+// the modified file.
+type JSONFileSettings struct {
+	fileName       string
+	portable       bool
+	root           map[string]any
+	timestamps     map[string]time.Time
+	changes        map[string]jsonSettingsChange
+	expireDuration time.Duration
+	fileState      jsonSettingsFileState
+}
+
+// jsonSettingsChange is a value put or removed since the file was loaded or
+// saved.
+type jsonSettingsChange struct {
+	value     string
+	removed   bool
+	timestamp time.Time
+}
+
+// jsonSettingsFileState tells whether the file was modified.
+type jsonSettingsFileState struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+// NewJSONFileSettings returns a new JSONFileSettings, that stores values in
+// the file fileName. If fileName is absolute, it is used as is.
+func NewJSONFileSettings(fileName string) *JSONFileSettings {
+	return &JSONFileSettings{
+		fileName:   fileName,
+		root:       make(map[string]any),
+		timestamps: make(map[string]time.Time),
+		changes:    make(map[string]jsonSettingsChange),
+	}
+}
+
+func (jfs *JSONFileSettings) Get(key string) (string, bool) {
+	names, err := splitSettingsKey(key)
+	if err != nil {
+		return "", false
+	}
+
+	return jsonSettingsGet(jfs.root, names)
+}
+
+func (jfs *JSONFileSettings) Timestamp(key string) (time.Time, bool) {
+	timestamp, ok := jfs.timestamps[key]
+	return timestamp, ok
+}
+
+func (jfs *JSONFileSettings) Put(key, value string) error {
+	return jfs.put(key, value, false)
+}
+
+func (jfs *JSONFileSettings) PutExpiring(key, value string) error {
+	return jfs.put(key, value, true)
+}
+
+func (jfs *JSONFileSettings) put(key, value string, expiring bool) error {
+	names, err := splitSettingsKey(key)
+	if err != nil {
+		return err
+	}
+
+	var timestamp time.Time
+	if expiring {
+		timestamp = time.Now()
+	}
+
+	change := jsonSettingsChange{value: value, timestamp: timestamp}
+
+	jfs.apply(key, names, change)
+	jfs.changes[key] = change
+
+	return nil
+}
+
+func (jfs *JSONFileSettings) Remove(key string) error {
+	names, err := splitSettingsKey(key)
+	if err != nil {
+		return err
+	}
+
+	change := jsonSettingsChange{removed: true}
+
+	jfs.apply(key, names, change)
+	jfs.changes[key] = change
+
+	return nil
+}
+
+func (jfs *JSONFileSettings) apply(key string, names []string, change jsonSettingsChange) {
+	if change.removed {
+		jsonSettingsRemove(jfs.root, names)
+	} else {
+		jsonSettingsPut(jfs.root, names, change.value)
+	}
+
+	if change.timestamp.IsZero() {
+		delete(jfs.timestamps, key)
+	} else {
+		jfs.timestamps[key] = change.timestamp
+	}
+}
+
+func (jfs *JSONFileSettings) ExpireDuration() time.Duration {
+	return jfs.expireDuration
+}
+
+func (jfs *JSONFileSettings) SetExpireDuration(expireDuration time.Duration) {
+	jfs.expireDuration = expireDuration
+}
+
+// Portable returns whether the file is stored relative to the working
+// directory instead of the application data folder.
+func (jfs *JSONFileSettings) Portable() bool {
+	return jfs.portable
+}
+
+// SetPortable sets whether the file is stored relative to the working
+// directory instead of the application data folder.
+func (jfs *JSONFileSettings) SetPortable(portable bool) {
+	jfs.portable = portable
+}
+
+// FilePath returns the path of the file the values are stored in, or "" if it
+// cannot be determined.
+func (jfs *JSONFileSettings) FilePath() string {
+	filePath, _ := jfs.filePath()
+
+	return filePath
+}
+
+func (jfs *JSONFileSettings) filePath() (string, error) {
+	if jfs.portable || filepath.IsAbs(jfs.fileName) {
+		return filepath.Abs(jfs.fileName)
+	}
+
+	return appSettingsFilePath(jfs.fileName)
+}
+
+// Modified returns whether the file was modified by someone else since it was
+// loaded or saved.
+func (jfs *JSONFileSettings) Modified() (bool, error) {
+	filePath, err := jfs.filePath()
+	if err != nil {
+		return false, err
+	}
+
+	state, err := statJSONSettingsFile(filePath)
+	if err != nil {
+		return false, err
+	}
+
+	return state != jfs.fileState, nil
+}
+
+// Load replaces all values by the ones in the file.
+func (jfs *JSONFileSettings) Load() error {
+	filePath, err := jfs.filePath()
+	if err != nil {
+		return err
+	}
+
+	root, timestamps, state, err := readJSONSettingsFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	jfs.root = root
+	jfs.timestamps = timestamps
+	jfs.changes = make(map[string]jsonSettingsChange)
+	jfs.fileState = state
+
+	return nil
+}
+
+// Save writes all values, that have not expired, to the file.
+func (jfs *JSONFileSettings) Save() error {
+	filePath, err := jfs.filePath()
+	if err != nil {
+		return err
+	}
+
+	modified, err := jfs.Modified()
+	if err != nil {
+		return err
+	}
+
+	if modified {
+		root, timestamps, _, err := readJSONSettingsFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		jfs.root = root
+		jfs.timestamps = timestamps
+
+		for key, change := range jfs.changes {
+			if names, err := splitSettingsKey(key); err == nil {
+				jfs.apply(key, names, change)
+			}
+		}
+	}
+
+	if jfs.expireDuration > 0 {
+		for key, timestamp := range maps.Clone(jfs.timestamps) {
+			if time.Since(timestamp) >= jfs.expireDuration {
+				jfs.Remove(key)
+			}
+		}
+	}
+
+	data, err := jfs.marshal()
+	if err != nil {
+		return err
+	}
+
+	if err := writeFileAtomically(filePath, data); err != nil {
+		return err
+	}
+
+	state, err := statJSONSettingsFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	jfs.changes = make(map[string]jsonSettingsChange)
+	jfs.fileState = state
+
+	return nil
+}
+
+func (jfs *JSONFileSettings) marshal() ([]byte, error) {
+	root := jfs.root
+
+	if len(jfs.timestamps) > 0 {
+		root = maps.Clone(root)
+
+		timestamps := make(map[string]any, len(jfs.timestamps))
+		for key, timestamp := range jfs.timestamps {
+			timestamps[key] = timestamp.Format(time.RFC3339)
+		}
+		root[jsonSettingsTimestampsName] = timestamps
+	}
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(root); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// splitSettingsKey returns the names of the nested objects key maps to.
+func splitSettingsKey(key string) ([]string, error) {
+	if key == "" {
+		return nil, errors.New("key must not be empty")
+	}
+
+	names := strings.Split(key, "/")
+	for _, name := range names {
+		if name == "" {
+			return nil, fmt.Errorf("key %q contains an empty name", key)
+		}
+	}
+
+	return names, nil
+}
+
+func jsonSettingsGet(root map[string]any, names []string) (string, bool) {
+	var node any = root
+
+	for _, name := range names {
+		obj, ok := node.(map[string]any)
+		if !ok {
+			return "", false
+		}
+
+		if node, ok = obj[name]; !ok {
+			return "", false
+		}
+	}
+
+	if obj, ok := node.(map[string]any); ok {
+		if node, ok = obj[""]; !ok {
+			return "", false
+		}
+	}
+
+	if value, ok := node.(string); ok {
+		return value, true
+	}
+
+	data, err := json.Marshal(node)
+	if err != nil {
+		return "", false
+	}
+
+	return string(data), true
+}
+
+func jsonSettingsPut(root map[string]any, names []string, value string) {
+	obj := root
+
+	for _, name := range names[:len(names)-1] {
+		child, ok := obj[name].(map[string]any)
+		if !ok {
+			child = make(map[string]any)
+			if old, ok := obj[name]; ok {
+				child[""] = old
+			}
+			obj[name] = child
+		}
+
+		obj = child
+	}
+
+	name := names[len(names)-1]
+
+	if child, ok := obj[name].(map[string]any); ok {
+		child[""] = value
+	} else {
+		obj[name] = value
+	}
+}
+
+func jsonSettingsRemove(obj map[string]any, names []string) {
+	name := names[0]
+
+	child, ok := obj[name].(map[string]any)
+	if !ok {
+		if len(names) == 1 {
+			delete(obj, name)
+		}
+		return
+	}
+
+	if len(names) == 1 {
+		delete(child, "")
+	} else {
+		jsonSettingsRemove(child, names[1:])
+	}
+
+	if len(child) == 0 {
+		delete(obj, name)
+	}
+}
+
+func statJSONSettingsFile(filePath string) (jsonSettingsFileState, error) {
+	info, err := os.Stat(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return jsonSettingsFileState{}, nil
+	}
+	if err != nil {
+		return jsonSettingsFileState{}, err
+	}
+
+	return jsonSettingsFileState{exists: true, modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+// readJSONSettingsFile returns the entries of the settings file at filePath,
+// without the timestamps, which it returns separately.
+func readJSONSettingsFile(filePath string) (root map[string]any, timestamps map[string]time.Time, state jsonSettingsFileState, err error) {
+	root = make(map[string]any)
+	timestamps = make(map[string]time.Time)
+
+	if state, err = statJSONSettingsFile(filePath); err != nil || !state.exists {
+		return
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return
+	}
+
+	if len(bytes.TrimSpace(data)) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+
+		if err = dec.Decode(&root); err != nil {
+			err = fmt.Errorf("%s: %w", filePath, err)
+			return
+		}
+	}
+
+	if obj, ok := root[jsonSettingsTimestampsName].(map[string]any); ok {
+		for key, value := range obj {
+			if s, ok := value.(string); ok {
+				if timestamp, err := time.Parse(time.RFC3339, s); err == nil {
+					timestamps[key] = timestamp
+				}
+			}
+		}
+	}
+	delete(root, jsonSettingsTimestampsName)
+
+	return
+}
+
+// writeFileAtomically replaces the file at filePath with data, by writing a
+// temporary file next to it and renaming that.
+func writeFileAtomically(filePath string, data []byte) (err error) {
+	dirPath := filepath.Dir(filePath)
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(dirPath, filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			file.Close()
+			os.Remove(file.Name())
+		}
+	}()
+
+	if _, err = file.Write(data); err != nil {
+		return err
+	}
+	if err = file.Sync(); err != nil {
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), filePath)
+}
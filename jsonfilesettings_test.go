@@ -0,0 +1,235 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func readJSONFile(t *testing.T, filePath string) map[string]any {
+	t.Helper()
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v map[string]any
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
+
+func TestJSONFileSettingsNestedKeys(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "app", "settings.json")
+
+	jfs := NewJSONFileSettings(filePath)
+	jfs.Put("Theme", "dark")
+	jfs.Put("MainWindow", "state")
+	jfs.Put("MainWindow/TableView", "columns")
+	jfs.Put("Dialog/Splitter/Pos", "120")
+
+	if err := jfs.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]any{
+		"Theme": "dark",
+		"MainWindow": map[string]any{
+			"":          "state",
+			"TableView": "columns",
+		},
+		"Dialog": map[string]any{
+			"Splitter": map[string]any{"Pos": "120"},
+		},
+	}
+	if got := readJSONFile(t, filePath); !reflect.DeepEqual(got, want) {
+		t.Errorf("file contains %v, want %v", got, want)
+	}
+
+	jfs = NewJSONFileSettings(filePath)
+	if err := jfs.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	for key, want := range map[string]string{
+		"Theme":                "dark",
+		"MainWindow":           "state",
+		"MainWindow/TableView": "columns",
+		"Dialog/Splitter/Pos":  "120",
+	} {
+		if got, ok := jfs.Get(key); !ok || got != want {
+			t.Errorf("Get(%q) = %q, %t, want %q", key, got, ok, want)
+		}
+	}
+	if _, ok := jfs.Get("Dialog/Splitter"); ok {
+		t.Error("Get(\"Dialog/Splitter\") found a value")
+	}
+
+	jfs.Remove("MainWindow")
+	jfs.Remove("Dialog/Splitter/Pos")
+
+	if _, ok := jfs.Get("MainWindow"); ok {
+		t.Error("Get(\"MainWindow\") found a removed value")
+	}
+	if got, _ := jfs.Get("MainWindow/TableView"); got != "columns" {
+		t.Errorf("Get(\"MainWindow/TableView\") = %q after removing its parent, want columns", got)
+	}
+	if _, ok := jfs.root["Dialog"]; ok {
+		t.Error("empty objects were kept after removing their last value")
+	}
+
+	if err := jfs.Put("a//b", "x"); err == nil {
+		t.Error("Put with an empty name in the key succeeded")
+	}
+}
+
+func TestJSONFileSettingsPreservesUnknownEntries(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "settings.json")
+
+	if err := os.WriteFile(filePath, []byte(`{"Other": {"Count": 12345678901234567890, "List": [1, "two"]}, "Name": "a"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	jfs := NewJSONFileSettings(filePath)
+	if err := jfs.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, _ := jfs.Get("Other/List"); got != `[1,"two"]` {
+		t.Errorf("Get(\"Other/List\") = %q, want JSON", got)
+	}
+
+	jfs.Put("Name", "b")
+
+	if err := jfs.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]json.RawMessage
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	var other struct {
+		Count json.Number
+		List  []any
+	}
+	if err := json.Unmarshal(got["Other"], &other); err != nil {
+		t.Fatal(err)
+	}
+	if other.Count != "12345678901234567890" || len(other.List) != 2 {
+		t.Errorf("unknown entries changed to %s", got["Other"])
+	}
+	if string(got["Name"]) != `"b"` {
+		t.Errorf("Name = %s, want \"b\"", got["Name"])
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(filePath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("directory contains %d entries after Save, want only the settings file", len(entries))
+	}
+}
+
+func TestJSONFileSettingsExternalModification(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "settings.json")
+
+	jfs := NewJSONFileSettings(filePath)
+	jfs.Put("Mine", "1")
+	jfs.Put("Shared", "mine")
+	if err := jfs.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if modified, err := jfs.Modified(); err != nil || modified {
+		t.Fatalf("Modified() = %t, %v after Save, want false", modified, err)
+	}
+
+	other := NewJSONFileSettings(filePath)
+	if err := other.Load(); err != nil {
+		t.Fatal(err)
+	}
+	other.Put("Theirs", "2")
+	other.Put("Shared", "theirs")
+	other.Put("Long/Value/To/Change/The/Size", "x")
+	if err := other.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if modified, err := jfs.Modified(); err != nil || !modified {
+		t.Fatalf("Modified() = %t, %v after another Save, want true", modified, err)
+	}
+
+	jfs.Put("Mine", "3")
+	jfs.Remove("Shared")
+	if err := jfs.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]any{
+		"Mine":   "3",
+		"Theirs": "2",
+		"Long":   map[string]any{"Value": map[string]any{"To": map[string]any{"Change": map[string]any{"The": map[string]any{"Size": "x"}}}}},
+	}
+	if got := readJSONFile(t, filePath); !reflect.DeepEqual(got, want) {
+		t.Errorf("file contains %v, want %v", got, want)
+	}
+}
+
+func TestJSONFileSettingsExpiring(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "settings.json")
+
+	jfs := NewJSONFileSettings(filePath)
+	jfs.PutExpiring("Old", "a")
+	jfs.PutExpiring("New", "b")
+	jfs.Put("Kept", "c")
+
+	jfs.timestamps["Old"] = time.Now().Add(-48 * time.Hour)
+
+	if err := jfs.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	jfs = NewJSONFileSettings(filePath)
+	if err := jfs.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := jfs.Timestamp("New"); !ok {
+		t.Error("timestamp of New was not kept")
+	}
+	if _, ok := jfs.Get("Old"); !ok {
+		t.Error("Old was removed without an expire duration")
+	}
+
+	jfs.SetExpireDuration(24 * time.Hour)
+	if err := jfs.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]any{
+		"New":                      "b",
+		"Kept":                     "c",
+		jsonSettingsTimestampsName: map[string]any{"New": jfs.timestamps["New"].Format(time.RFC3339)},
+	}
+	if got := readJSONFile(t, filePath); !reflect.DeepEqual(got, want) {
+		t.Errorf("file contains %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package l10n
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Catalog translates messages into the language of a locale.
+type Catalog struct {
+	locale      string
+	key2Message map[string]*Message
+}
+
+// NewCatalog returns a new Catalog, that translates into the language of
+// locale with the messages of f. Messages, that are fuzzy or obsolete, are not
+// used.
+func NewCatalog(locale string, f *File) *Catalog {
+	c := &Catalog{
+		locale:      locale,
+		key2Message: make(map[string]*Message),
+	}
+
+	for _, m := range f.Messages {
+		if !m.Fuzzy && !m.Obsolete {
+			c.key2Message[key(m.Source, m.Context)] = m
+		}
+	}
+
+	return c
+}
+
+// LoadCatalog returns a new Catalog, that translates into the language of
+// locale with the messages of the .tr file at path.
+func LoadCatalog(locale, path string) (*Catalog, error) {
+	f, err := ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCatalog(locale, f), nil
+}
+
+// Locale returns the locale c translates into the language of.
+func (c *Catalog) Locale() string {
+	return c.locale
+}
+
+// message returns the message with source and context. Messages with a
+// context can be translated with the message without one.
+func (c *Catalog) message(source string, context []string) *Message {
+	if m, ok := c.key2Message[key(source, context)]; ok {
+		return m
+	}
+
+	if len(context) > 0 {
+		return c.key2Message[key(source, nil)]
+	}
+
+	return nil
+}
+
+// Translate returns the translation of source in context, or source if there
+// is none.
+func (c *Catalog) Translate(source string, context ...string) string {
+	if m := c.message(source, context); m != nil && m.Translation != "" {
+		return m.Translation
+	}
+
+	return source
+}
+
+// TranslatePlural returns the translation of the plural form for the number n
+// of the message with the singular form source and the plural form plural in
+// context. Without a translation, it returns source if n is 1 and else plural.
+func (c *Catalog) TranslatePlural(source, plural string, n int, context ...string) string {
+	if m := c.message(source, context); m != nil {
+		if form := PluralForm(c.locale, n); form < len(m.Translations) && m.Translations[form] != "" {
+			return m.Translations[form]
+		}
+	}
+
+	if n == 1 {
+		return source
+	}
+
+	return plural
+}
+
+var (
+	mutex          sync.RWMutex
+	locale2Catalog = make(map[string][]*Catalog)
+	currentLocale  string
+)
+
+// Register registers c for its locale. Catalogs registered earlier for the
+// same locale are asked first.
+func Register(c *Catalog) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	l := strings.ToLower(c.locale)
+	locale2Catalog[l] = append(locale2Catalog[l], c)
+}
+
+// LoadDir loads and registers the .tr files named name-<locale>.tr in dir,
+// like walk-de.tr for the locale de.
+func LoadDir(dir, name string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, name+"-*.tr"))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		locale := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), name+"-"), ".tr")
+
+		c, err := LoadCatalog(locale, path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		Register(c)
+	}
+
+	return nil
+}
+
+// Locale returns the locale Translate translates into the language of.
+func Locale() string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	return currentLocale
+}
+
+// SetLocale sets the locale Translate translates into the language of, like
+// "de" or "pt-BR". Without catalogs for a regional locale, the ones for its
+// language are used.
+func SetLocale(locale string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	currentLocale = locale
+}
+
+// SetSystemLocale sets the locale to the preferred UI language of the user. The
+// environment variables LC_ALL, LC_MESSAGES or LANG override it, if set, like
+// "de_DE.UTF-8".
+func SetSystemLocale() {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			locale, _, _ := strings.Cut(value, ".")
+			SetLocale(strings.ReplaceAll(locale, "_", "-"))
+			return
+		}
+	}
+
+	if locale := userLocale(); locale != "" {
+		SetLocale(locale)
+	}
+}
+
+// catalogs returns the catalogs for the current locale.
+func catalogs() []*Catalog {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	l := strings.ToLower(currentLocale)
+	if cs, ok := locale2Catalog[l]; ok {
+		return cs
+	}
+
+	return locale2Catalog[language(l)]
+}
+
+// Translate returns the translation of source in context into the language of
+// the current locale, or source if there is none. It can be passed to
+// walk.SetTranslationFunc.
+func Translate(source string, context ...string) string {
+	for _, c := range catalogs() {
+		if m := c.message(source, context); m != nil && m.Translation != "" {
+			return m.Translation
+		}
+	}
+
+	return source
+}
+
+// TranslatePlural returns the translation of the plural form for the number n
+// of the message with the singular form source and the plural form plural in
+// context into the language of the current locale. Without a translation, it
+// returns source if n is 1 and else plural.
+func TranslatePlural(source, plural string, n int, context ...string) string {
+	for _, c := range catalogs() {
+		if m := c.message(source, context); m != nil && len(m.Translations) > 0 {
+			return c.TranslatePlural(source, plural, n, context...)
+		}
+	}
+
+	if n == 1 {
+		return source
+	}
+
+	return plural
+}
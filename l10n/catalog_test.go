@@ -0,0 +1,101 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package l10n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCatalogTranslate(t *testing.T) {
+	c := NewCatalog("de", &File{Messages: []*Message{
+		{Source: "Open", Translation: "Öffnen"},
+		{Source: "Open", Context: []string{"state"}, Translation: "Offen"},
+		{Source: "Close", Translation: "Schließen", Fuzzy: true},
+		{Source: "Quit", Translation: "Beenden", Obsolete: true},
+		{Source: "Save"},
+	}})
+
+	tests := []struct {
+		source  string
+		context []string
+		want    string
+	}{
+		{"Open", nil, "Öffnen"},
+		{"Open", []string{"state"}, "Offen"},
+		{"Open", []string{"menu"}, "Öffnen"},
+		{"Close", nil, "Close"},
+		{"Quit", nil, "Quit"},
+		{"Save", nil, "Save"},
+		{"Unknown", nil, "Unknown"},
+	}
+
+	for _, test := range tests {
+		if got := c.Translate(test.source, test.context...); got != test.want {
+			t.Errorf("Translate(%q, %q) = %q, want %q", test.source, test.context, got, test.want)
+		}
+	}
+}
+
+func TestCatalogTranslatePlural(t *testing.T) {
+	c := NewCatalog("ru", &File{Messages: []*Message{
+		{Source: "%d file", Plural: "%d files", Translations: []string{"%d файл", "%d файла", "%d файлов"}},
+		{Source: "%d item", Plural: "%d items", Translations: []string{"", "", ""}},
+	}})
+
+	tests := []struct {
+		source, plural string
+		n              int
+		want           string
+	}{
+		{"%d file", "%d files", 1, "%d файл"},
+		{"%d file", "%d files", 3, "%d файла"},
+		{"%d file", "%d files", 5, "%d файлов"},
+		{"%d file", "%d files", 21, "%d файл"},
+		{"%d file", "%d files", 12, "%d файлов"},
+		{"%d item", "%d items", 1, "%d item"},
+		{"%d item", "%d items", 2, "%d items"},
+	}
+
+	for _, test := range tests {
+		if got := c.TranslatePlural(test.source, test.plural, test.n); got != test.want {
+			t.Errorf("TranslatePlural(%q, %q, %d) = %q, want %q", test.source, test.plural, test.n, got, test.want)
+		}
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+
+	f := &File{Messages: []*Message{
+		{Source: "Filter", Context: []string{"walk"}, Translation: "Filter (de)"},
+	}}
+	if err := f.WriteFile(filepath.Join(dir, "app-de.tr")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "other-de.tr"), []byte("invalid"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := LoadDir(dir, "app"); err != nil {
+		t.Fatalf("LoadDir: %v", err)
+	}
+
+	defer SetLocale(Locale())
+
+	SetLocale("de-AT")
+	if got, want := Translate("Filter", "walk"), "Filter (de)"; got != want {
+		t.Errorf("Translate = %q, want %q", got, want)
+	}
+
+	SetLocale("fr")
+	if got, want := Translate("Filter", "walk"), "Filter"; got != want {
+		t.Errorf("Translate without catalog = %q, want %q", got, want)
+	}
+	if got, want := TranslatePlural("%d file", "%d files", 2), "%d files"; got != want {
+		t.Errorf("TranslatePlural without catalog = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package l10n loads the .tr translation catalogs of walk and applications
+// using it, and translates messages with them.
+//
+// A .tr file is a JSON document with the messages of one locale, like
+// walk-de.tr. The trextract command in tools/trextract creates and updates
+// them from the calls of tr functions in Go source files:
+//
+//	tr("Invalid Input")
+//	tr("Filter", "walk")
+//	trn("%d file", "%d files", n, "walk")
+//
+// The optional arguments after the source and plural texts are the context,
+// which tells apart messages with the same source text.
+//
+// Catalogs are registered by locale. Translate, which can be passed to
+// walk.SetTranslationFunc, translates with the catalog of the current locale:
+//
+//	if err := l10n.LoadDir("l10n", "walk"); err != nil {
+//		log.Fatal(err)
+//	}
+//	l10n.SetLocale("de")
+//	walk.SetTranslationFunc(l10n.Translate)
+package l10n
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+)
+
+// File is the content of a .tr file.
+type File struct {
+	Messages []*Message
+}
+
+// Message is a message and its translation.
+type Message struct {
+	// Locations are the places in source files the message is used at.
+	Locations []Location
+
+	// Source is the text of the message, or its singular form if it has a
+	// Plural form.
+	Source string
+
+	// Plural is the plural form of the text of the message, if the text
+	// depends on a number.
+	Plural string `json:",omitempty"`
+
+	// Context tells apart messages with the same Source.
+	Context []string
+
+	// Translation is the translation of Source.
+	Translation string
+
+	// Translations are the translations of the plural forms of the locale, if
+	// the message has a Plural form.
+	Translations []string `json:",omitempty"`
+
+	// Fuzzy is set for translations of a similar message, that were not
+	// reviewed yet. They are not used.
+	Fuzzy bool `json:",omitempty"`
+
+	// Obsolete is set for messages, that are no longer used.
+	Obsolete bool `json:",omitempty"`
+}
+
+// Location is a place in a source file.
+type Location struct {
+	File string
+	Line string
+}
+
+// key returns the key, that identifies a message with source and context.
+func key(source string, context []string) string {
+	var buf bytes.Buffer
+
+	buf.WriteString(source)
+	for _, c := range context {
+		buf.WriteByte(0x04)
+		buf.WriteString(c)
+	}
+
+	return buf.String()
+}
+
+// Parse parses the content of a .tr file.
+func Parse(data []byte) (*File, error) {
+	f := new(File)
+
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// ReadFile reads the .tr file at path.
+func ReadFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data)
+}
+
+// Marshal returns the content of the .tr file of f.
+func (f *File) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "\t")
+
+	if err := enc.Encode(f); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WriteFile writes f to the .tr file at path.
+func (f *File) WriteFile(path string) error {
+	data, err := f.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
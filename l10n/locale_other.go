@@ -0,0 +1,12 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !windows
+
+package l10n
+
+// userLocale returns "", as only the environment variables tell the locale.
+func userLocale() string {
+	return ""
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package l10n
+
+import "golang.org/x/sys/windows"
+
+// userLocale returns the preferred UI language of the user, like "de-DE", or ""
+// if it cannot be determined.
+func userLocale() string {
+	languages, err := windows.GetUserPreferredUILanguages(windows.MUI_LANGUAGE_NAME)
+	if err != nil || len(languages) == 0 {
+		return ""
+	}
+
+	return languages[0]
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package l10n
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Merge updates the messages of f, the catalog of locale, to the messages
+// extracted from source files, in the order they were extracted.
+//
+// Translations of messages, that are still used, are kept. New messages
+// get the translation of a similar message no longer used, marked as fuzzy.
+// Messages, that are no longer used, are kept at the end, marked as obsolete,
+// if they have a translation.
+func (f *File) Merge(extracted []*Message, locale string) {
+	key2Old := make(map[string]*Message, len(f.Messages))
+	for _, m := range f.Messages {
+		key2Old[key(m.Source, m.Context)] = m
+	}
+
+	used := make(map[string]bool, len(extracted))
+	var unmatched []*Message
+
+	messages := make([]*Message, 0, len(extracted))
+	for _, e := range extracted {
+		k := key(e.Source, e.Context)
+		used[k] = true
+
+		m := &Message{
+			Locations: e.Locations,
+			Source:    e.Source,
+			Plural:    e.Plural,
+			Context:   e.Context,
+		}
+
+		if old, ok := key2Old[k]; ok {
+			m.Translation = old.Translation
+			m.Translations = old.Translations
+			m.Fuzzy = old.Fuzzy
+		} else {
+			unmatched = append(unmatched, m)
+		}
+
+		if m.Plural != "" && len(m.Translations) == 0 {
+			m.Translations = make([]string, PluralForms(locale))
+		}
+
+		messages = append(messages, m)
+	}
+
+	var obsolete []*Message
+	for _, old := range f.Messages {
+		if used[key(old.Source, old.Context)] || !old.translated() {
+			continue
+		}
+
+		old.Locations = nil
+		old.Obsolete = true
+		obsolete = append(obsolete, old)
+	}
+
+	for _, m := range unmatched {
+		if similar := similarMessage(m, obsolete); similar != nil {
+			m.Translation = similar.Translation
+			if m.Plural != "" && len(similar.Translations) > 0 {
+				m.Translations = slices.Clone(similar.Translations)
+			}
+			m.Fuzzy = true
+		}
+	}
+
+	f.Messages = append(messages, obsolete...)
+}
+
+func (m *Message) translated() bool {
+	return m.Translation != "" || slices.ContainsFunc(m.Translations, func(t string) bool {
+		return t != ""
+	})
+}
+
+// similarMessage returns the message of candidates in the same context, with
+// the source most similar to the one of m, if it is similar enough.
+func similarMessage(m *Message, candidates []*Message) *Message {
+	var similar *Message
+	minDistance := max(utf8.RuneCountInString(m.Source)/4, 1) + 1
+
+	for _, c := range candidates {
+		if !slices.Equal(c.Context, m.Context) || (c.Plural == "") != (m.Plural == "") {
+			continue
+		}
+
+		if d := editDistance(strings.ToLower(c.Source), strings.ToLower(m.Source)); d < minDistance {
+			similar, minDistance = c, d
+		}
+	}
+
+	return similar
+}
+
+// editDistance returns the Levenshtein distance of a and b in runes.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
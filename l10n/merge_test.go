@@ -0,0 +1,88 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package l10n
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFileMerge(t *testing.T) {
+	f := &File{Messages: []*Message{
+		{Source: "Open", Locations: []Location{{"a.go", "1"}}, Translation: "Öffnen"},
+		{Source: "Invalid Input", Translation: "Ungültige Eingabe"},
+		{Source: "Removed", Translation: "Entfernt"},
+		{Source: "Untranslated"},
+	}}
+
+	f.Merge([]*Message{
+		{Source: "Open", Locations: []Location{{"b.go", "7"}}},
+		{Source: "Invalid input.", Locations: []Location{{"b.go", "9"}}},
+		{Source: "New", Locations: []Location{{"b.go", "11"}}},
+		{Source: "%d file", Plural: "%d files", Locations: []Location{{"b.go", "13"}}},
+	}, "de")
+
+	var sources []string
+	for _, m := range f.Messages {
+		sources = append(sources, m.Source)
+	}
+	if want := []string{"Open", "Invalid input.", "New", "%d file", "Invalid Input", "Removed"}; !slices.Equal(sources, want) {
+		t.Fatalf("sources = %q, want %q", sources, want)
+	}
+
+	open := f.Messages[0]
+	if open.Translation != "Öffnen" || open.Fuzzy || open.Locations[0].File != "b.go" {
+		t.Errorf("kept message = %+v", open)
+	}
+
+	fuzzy := f.Messages[1]
+	if fuzzy.Translation != "Ungültige Eingabe" || !fuzzy.Fuzzy {
+		t.Errorf("similar message = %+v", fuzzy)
+	}
+
+	if m := f.Messages[2]; m.Translation != "" || m.Fuzzy {
+		t.Errorf("new message = %+v", m)
+	}
+
+	if m := f.Messages[3]; len(m.Translations) != 2 {
+		t.Errorf("plural message translations = %q, want 2 forms", m.Translations)
+	}
+
+	for _, m := range f.Messages[4:] {
+		if !m.Obsolete || m.Locations != nil {
+			t.Errorf("obsolete message = %+v", m)
+		}
+	}
+}
+
+func TestFileMergeRevivesObsolete(t *testing.T) {
+	f := &File{Messages: []*Message{
+		{Source: "Back", Translation: "Zurück", Obsolete: true},
+	}}
+
+	f.Merge([]*Message{{Source: "Back", Locations: []Location{{"a.go", "3"}}}}, "de")
+
+	if len(f.Messages) != 1 || f.Messages[0].Obsolete || f.Messages[0].Translation != "Zurück" {
+		t.Errorf("messages = %+v", f.Messages)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"Größe", "Grösse", 2},
+	}
+
+	for _, test := range tests {
+		if got := editDistance(test.a, test.b); got != test.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
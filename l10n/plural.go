@@ -0,0 +1,208 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package l10n
+
+import (
+	"strings"
+)
+
+// pluralRule describes the plural forms of languages.
+type pluralRule struct {
+	forms int
+	form  func(n int) int
+}
+
+var (
+	// pluralRuleOther is the rule of languages without plural forms.
+	pluralRuleOther = pluralRule{1, func(n int) int {
+		return 0
+	}}
+
+	// pluralRuleOne is the rule of languages, like English and German, with
+	// a singular form for 1 only.
+	pluralRuleOne = pluralRule{2, func(n int) int {
+		if n == 1 {
+			return 0
+		}
+		return 1
+	}}
+
+	// pluralRuleZeroOne is the rule of languages, like French, that use the
+	// singular form for 0, too.
+	pluralRuleZeroOne = pluralRule{2, func(n int) int {
+		if n == 0 || n == 1 {
+			return 0
+		}
+		return 1
+	}}
+
+	// pluralRuleSlavic is the rule of East Slavic and most South Slavic
+	// languages.
+	pluralRuleSlavic = pluralRule{3, func(n int) int {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return 0
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return 1
+		}
+		return 2
+	}}
+
+	// pluralRuleCzech is the rule of Czech and Slovak.
+	pluralRuleCzech = pluralRule{3, func(n int) int {
+		switch {
+		case n == 1:
+			return 0
+		case n >= 2 && n <= 4:
+			return 1
+		}
+		return 2
+	}}
+
+	// pluralRulePolish is the rule of Polish.
+	pluralRulePolish = pluralRule{3, func(n int) int {
+		switch {
+		case n == 1:
+			return 0
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return 1
+		}
+		return 2
+	}}
+
+	// pluralRuleArabic is the rule of Arabic, with forms for 0, 1, 2, a few,
+	// many and other numbers.
+	pluralRuleArabic = pluralRule{6, func(n int) int {
+		switch {
+		case n <= 2:
+			return n
+		case n%100 >= 3 && n%100 <= 10:
+			return 3
+		case n%100 >= 11:
+			return 4
+		}
+		return 5
+	}}
+
+	// pluralRuleHebrew is the rule of Hebrew, with a dual form for 2.
+	pluralRuleHebrew = pluralRule{3, func(n int) int {
+		switch n {
+		case 1:
+			return 0
+		case 2:
+			return 1
+		}
+		return 2
+	}}
+
+	// pluralRuleIrish is the rule of Irish.
+	pluralRuleIrish = pluralRule{5, func(n int) int {
+		switch {
+		case n == 1:
+			return 0
+		case n == 2:
+			return 1
+		case n >= 3 && n <= 6:
+			return 2
+		case n >= 7 && n <= 10:
+			return 3
+		}
+		return 4
+	}}
+
+	// pluralRuleLithuanian is the rule of Lithuanian.
+	pluralRuleLithuanian = pluralRule{3, func(n int) int {
+		switch {
+		case n%10 == 1 && (n%100 < 11 || n%100 > 19):
+			return 0
+		case n%10 >= 2 && (n%100 < 11 || n%100 > 19):
+			return 1
+		}
+		return 2
+	}}
+
+	// pluralRuleLatvian is the rule of Latvian, with a form for 0.
+	pluralRuleLatvian = pluralRule{3, func(n int) int {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return 0
+		case n != 0:
+			return 1
+		}
+		return 2
+	}}
+
+	// pluralRuleRomanian is the rule of Romanian.
+	pluralRuleRomanian = pluralRule{3, func(n int) int {
+		switch {
+		case n == 1:
+			return 0
+		case n == 0 || n%100 >= 1 && n%100 <= 19:
+			return 1
+		}
+		return 2
+	}}
+)
+
+var language2PluralRule = map[string]pluralRule{
+	"fr": pluralRuleZeroOne,
+	"pt": pluralRuleZeroOne,
+	"ja": pluralRuleOther,
+	"ko": pluralRuleOther,
+	"zh": pluralRuleOther,
+	"vi": pluralRuleOther,
+	"th": pluralRuleOther,
+	"id": pluralRuleOther,
+	"ms": pluralRuleOther,
+	"tr": pluralRuleOther,
+	"ru": pluralRuleSlavic,
+	"uk": pluralRuleSlavic,
+	"be": pluralRuleSlavic,
+	"sr": pluralRuleSlavic,
+	"hr": pluralRuleSlavic,
+	"bs": pluralRuleSlavic,
+	"cs": pluralRuleCzech,
+	"sk": pluralRuleCzech,
+	"pl": pluralRulePolish,
+	"ar": pluralRuleArabic,
+	"he": pluralRuleHebrew,
+	"iw": pluralRuleHebrew,
+	"ga": pluralRuleIrish,
+	"lt": pluralRuleLithuanian,
+	"lv": pluralRuleLatvian,
+	"ro": pluralRuleRomanian,
+}
+
+// language returns the language of locale, like "de" for "de-AT".
+func language(locale string) string {
+	if i := strings.IndexAny(locale, "-_"); i != -1 {
+		locale = locale[:i]
+	}
+
+	return strings.ToLower(locale)
+}
+
+func pluralRuleForLocale(locale string) pluralRule {
+	if rule, ok := language2PluralRule[language(locale)]; ok {
+		return rule
+	}
+
+	return pluralRuleOne
+}
+
+// PluralForms returns the number of plural forms of the language of locale.
+func PluralForms(locale string) int {
+	return pluralRuleForLocale(locale).forms
+}
+
+// PluralForm returns the index of the plural form of the language of locale
+// to use for the number n.
+func PluralForm(locale string, n int) int {
+	if n < 0 {
+		n = -n
+	}
+
+	return pluralRuleForLocale(locale).form(n)
+}
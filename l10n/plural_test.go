@@ -0,0 +1,42 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package l10n
+
+import (
+	"testing"
+)
+
+func TestPluralForm(t *testing.T) {
+	tests := []struct {
+		locale string
+		forms  int
+		n2form map[int]int
+	}{
+		{"en", 2, map[int]int{0: 1, 1: 0, 2: 1, 11: 1}},
+		{"fr-CA", 2, map[int]int{0: 0, 1: 0, 2: 1}},
+		{"ja", 1, map[int]int{0: 0, 1: 0, 2: 0}},
+		{"ru", 3, map[int]int{1: 0, 3: 1, 5: 2, 11: 2, 12: 2, 21: 0, 22: 1}},
+		{"cs", 3, map[int]int{1: 0, 4: 1, 5: 2, 22: 2}},
+		{"pl", 3, map[int]int{1: 0, 4: 1, 5: 2, 12: 2, 21: 2, 22: 1}},
+		{"ar", 6, map[int]int{0: 0, 1: 1, 2: 2, 3: 3, 10: 3, 11: 4, 99: 4, 100: 5, 102: 5, 103: 3, 111: 4}},
+		{"he-IL", 3, map[int]int{0: 2, 1: 0, 2: 1, 3: 2, 20: 2}},
+		{"ga", 5, map[int]int{0: 4, 1: 0, 2: 1, 3: 2, 6: 2, 7: 3, 10: 3, 11: 4}},
+		{"lt", 3, map[int]int{0: 2, 1: 0, 2: 1, 9: 1, 10: 2, 11: 2, 19: 2, 21: 0, 22: 1, 30: 2}},
+		{"lv", 3, map[int]int{0: 2, 1: 0, 2: 1, 11: 1, 21: 0, 111: 1}},
+		{"ro", 3, map[int]int{0: 1, 1: 0, 2: 1, 19: 1, 20: 2, 101: 1, 120: 2}},
+	}
+
+	for _, test := range tests {
+		if got := PluralForms(test.locale); got != test.forms {
+			t.Errorf("PluralForms(%q) = %d, want %d", test.locale, got, test.forms)
+		}
+
+		for n, want := range test.n2form {
+			if got := PluralForm(test.locale, n); got != want {
+				t.Errorf("PluralForm(%q, %d) = %d, want %d", test.locale, n, got, want)
+			}
+		}
+	}
+}
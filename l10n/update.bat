@@ -1 +1 @@
-polyglot -name="walk" -dir=".." -locales="de,fr,ko"
+go run ../tools/trextract -name=walk -dir=.. -locales=de,fr,ko
@@ -9,7 +9,6 @@ package walk
 
 import (
 	"context"
-	"slices"
 	"sync"
 
 	"github.com/wuc656/win"
@@ -77,8 +76,15 @@ func CreateLayoutItemsForContainerWithContext(container Container, ctx *LayoutCo
 	clib.geometry = cb.geometry
 	clib.geometry.ConsumingSpaceWhenInvisible = cb.AlwaysConsumeSpace()
 
+	// Splitter handles expect the previous widget on their left, so splitters
+	// are never mirrored.
+	if _, ok := containerItem.(*splitterContainerLayoutItem); !ok {
+		clib.rightToLeft = windowRightToLeft(container)
+	}
+
 	if lb := layout.asLayoutBase(); lb != nil {
 		clib.alignment = lb.alignment
+		clib.baselineAlignment = lb.baselineAlignment
 		clib.margins96dpi = lb.margins96dpi
 		clib.spacing96dpi = lb.spacing96dpi
 	}
@@ -247,7 +253,7 @@ func layoutTree(startInfo layoutStartInfo, size Size, cancel chan struct{}, done
 
 				clib.geometry.ClientSize = size
 
-				items := container.PerformLayout()
+				items := performContainerLayout(container)
 
 				select {
 				case <-cancel:
@@ -414,15 +420,6 @@ func applyLayoutResults(results []LayoutResult, stopwatch *stopwatch) error {
 	return nil
 }
 
-// Margins define margins in 1/96" units or native pixels.
-type Margins struct {
-	HNear, VNear, HFar, VFar int
-}
-
-func (m Margins) isZero() bool {
-	return m.HNear == 0 && m.HFar == 0 && m.VNear == 0 && m.VFar == 0
-}
-
 type Layout interface {
 	Container() Container
 	SetContainer(value Container)
@@ -435,15 +432,16 @@ type Layout interface {
 }
 
 type LayoutBase struct {
-	layout       Layout
-	container    Container
-	margins96dpi Margins
-	margins      Margins // in native pixels
-	spacing96dpi int
-	spacing      int // in native pixels
-	alignment    Alignment2D
-	resetNeeded  bool
-	dirty        bool
+	layout            Layout
+	container         Container
+	margins96dpi      Margins
+	margins           Margins // in native pixels
+	spacing96dpi      int
+	spacing           int // in native pixels
+	alignment         Alignment2D
+	baselineAlignment bool
+	resetNeeded       bool
+	dirty             bool
 }
 
 func (l *LayoutBase) asLayoutBase() *LayoutBase {
@@ -557,214 +555,34 @@ func (l *LayoutBase) SetAlignment(alignment Alignment2D) error {
 	return nil
 }
 
-type IdealSizer interface {
-	// IdealSize returns ideal window size in native pixels.
-	IdealSize() Size
-}
-
-type MinSizer interface {
-	// MinSize returns minimum window size in native pixels.
-	MinSize() Size
-}
-
-type MinSizeForSizer interface {
-	// MinSize returns minimum window size for given size. Both sizes are in native pixels.
-	MinSizeForSize(size Size) Size
-}
-
-type HeightForWidther interface {
-	HasHeightForWidth() bool
-
-	// HeightForWidth returns appropriate height if element has given width. width parameter and
-	// return value are in native pixels.
-	HeightForWidth(width int) int
-}
-
-type LayoutContext struct {
-	layoutItem2MinSizeEffective map[LayoutItem]Size // in native pixels
-	dpi                         int
-}
-
-func (ctx *LayoutContext) DPI() int {
-	return ctx.dpi
-}
-
-func newLayoutContext(handle win.HWND) *LayoutContext {
-	return &LayoutContext{
-		layoutItem2MinSizeEffective: make(map[LayoutItem]Size),
-		dpi:                         int(win.GetDpiForWindow(handle)),
-	}
-}
-
-type LayoutItem interface {
-	AsLayoutItemBase() *LayoutItemBase
-	Context() *LayoutContext
-	Handle() win.HWND
-	Geometry() *Geometry
-	Parent() ContainerLayoutItem
-	Visible() bool
-	LayoutFlags() LayoutFlags
-}
-
-type ContainerLayoutItem interface {
-	LayoutItem
-	MinSizer
-	MinSizeForSizer
-	HeightForWidther
-	AsContainerLayoutItemBase() *ContainerLayoutItemBase
-
-	// MinSizeEffectiveForChild returns minimum effective size for a child in native pixels.
-	MinSizeEffectiveForChild(child LayoutItem) Size
-
-	PerformLayout() []LayoutResultItem
-	Children() []LayoutItem
-	containsHandle(handle win.HWND) bool
-}
-
-type LayoutItemBase struct {
-	ctx      *LayoutContext
-	handle   win.HWND
-	geometry Geometry
-	parent   ContainerLayoutItem
-	visible  bool
-}
-
-func (lib *LayoutItemBase) AsLayoutItemBase() *LayoutItemBase {
-	return lib
-}
-
-func (lib *LayoutItemBase) Context() *LayoutContext {
-	return lib.ctx
-}
-
-func (lib *LayoutItemBase) Handle() win.HWND {
-	return lib.handle
-}
-
-func (lib *LayoutItemBase) Geometry() *Geometry {
-	return &lib.geometry
-}
-
-func (lib *LayoutItemBase) Parent() ContainerLayoutItem {
-	return lib.parent
-}
-
-func (lib *LayoutItemBase) Visible() bool {
-	return lib.visible
-}
-
-type ContainerLayoutItemBase struct {
-	LayoutItemBase
-	children     []LayoutItem
-	margins96dpi Margins
-	spacing96dpi int
-	alignment    Alignment2D
-}
-
-func (clib *ContainerLayoutItemBase) AsContainerLayoutItemBase() *ContainerLayoutItemBase {
-	return clib
-}
-
-var clibMinSizeEffectiveForChildMutex sync.Mutex
-
-func (clib *ContainerLayoutItemBase) MinSizeEffectiveForChild(child LayoutItem) Size {
-	// NOTE: This map is pre-populated in startLayoutTree before performing layout.
-	// For other usages it is not pre-populated and we assume this method will then
-	// be called from the main goroutine exclusively.
-	// If we want to do concurrent size measurement, we will need to pre-populate also.
-
-	// FIXME: There seems to be a bug in pre-population, so we use a mutex for now.
-
-	clibMinSizeEffectiveForChildMutex.Lock()
-
-	if clib.ctx != nil {
-		if size, ok := clib.ctx.layoutItem2MinSizeEffective[child]; ok {
-			clibMinSizeEffectiveForChildMutex.Unlock()
-			return size
-		}
-	}
-
-	if clib.ctx == nil {
-		if clib.parent == nil {
-			clib.ctx = newLayoutContext(clib.Handle())
-		} else {
-			clib.ctx = clib.parent.Context()
-		}
-	}
-
-	child.AsLayoutItemBase().ctx = clib.ctx
-
-	clibMinSizeEffectiveForChildMutex.Unlock()
-
-	size := minSizeEffective(child)
-
-	clibMinSizeEffectiveForChildMutex.Lock()
-
-	if clib.ctx != nil {
-		clib.ctx.layoutItem2MinSizeEffective[child] = size
-	}
-
-	clibMinSizeEffectiveForChildMutex.Unlock()
-
-	return size
-}
-
-func (clib *ContainerLayoutItemBase) Children() []LayoutItem {
-	return clib.children
+func (l *LayoutBase) BaselineAlignment() bool {
+	return l.baselineAlignment
 }
 
-func (clib *ContainerLayoutItemBase) SetChildren(children []LayoutItem) {
-	clib.children = children
-}
-
-func (clib *ContainerLayoutItemBase) containsHandle(handle win.HWND) bool {
-	for _, item := range clib.children {
-		if item.Handle() == handle {
-			return true
-		}
-	}
-
-	return false
-}
+// SetBaselineAlignment sets whether widgets that are placed next to each other
+// are aligned on the baseline of their text, e.g. a Label and a LineEdit.
+//
+// This is honoured by BoxLayouts of Horizontal orientation, by GridLayout for
+// each row and by FlowLayout. Widgets that can grow vertically or do not
+// display text keep their regular alignment.
+func (l *LayoutBase) SetBaselineAlignment(value bool) error {
+	if value != l.baselineAlignment {
+		l.baselineAlignment = value
 
-func (clib *ContainerLayoutItemBase) HasHeightForWidth() bool {
-	for _, child := range clib.children {
-		if hfw, ok := child.(HeightForWidther); ok && hfw.HasHeightForWidth() {
-			return true
+		if l.container != nil {
+			l.container.RequestLayout()
 		}
 	}
 
-	return false
-}
-
-type greedyLayoutItem struct {
-	LayoutItemBase
-}
-
-func NewGreedyLayoutItem() LayoutItem {
-	return new(greedyLayoutItem)
-}
-
-func (*greedyLayoutItem) LayoutFlags() LayoutFlags {
-	return ShrinkableHorz | GrowableHorz | GreedyHorz | ShrinkableVert | GrowableVert | GreedyVert
-}
-
-func (li *greedyLayoutItem) IdealSize() Size {
-	return SizeFrom96DPI(Size{100, 100}, li.ctx.dpi)
+	return nil
 }
 
-func (li *greedyLayoutItem) MinSize() Size {
-	return SizeFrom96DPI(Size{50, 50}, li.ctx.dpi)
-}
+// layoutHandle is the type of LayoutItem handles. On Windows it is the HWND
+// of the window backing the item.
+type layoutHandle = win.HWND
 
-type Geometry struct {
-	Alignment                   Alignment2D
-	MinSize                     Size // in native pixels
-	MaxSize                     Size // in native pixels
-	IdealSize                   Size // in native pixels
-	Size                        Size // in native pixels
-	ClientSize                  Size // in native pixels
-	ConsumingSpaceWhenInvisible bool
+func newLayoutContext(handle win.HWND) *LayoutContext {
+	return NewLayoutContext(int(win.GetDpiForWindow(handle)))
 }
 
 type formLayoutResult struct {
@@ -773,93 +591,7 @@ type formLayoutResult struct {
 	results   layoutResultsWithCompletionFuncs
 }
 
-type LayoutResult struct {
-	container ContainerLayoutItem
-	items     []LayoutResultItem
-}
-
 type layoutResultsWithCompletionFuncs struct {
 	results         []LayoutResult
 	completionFuncs []func()
 }
-
-type LayoutResultItem struct {
-	Item   LayoutItem
-	Bounds Rectangle // in native pixels
-}
-
-func shouldLayoutItem(item LayoutItem) bool {
-	if item == nil {
-		return false
-	}
-
-	_, isSpacer := item.(*spacerLayoutItem)
-
-	return isSpacer || item.Visible() || item.Geometry().ConsumingSpaceWhenInvisible
-}
-
-func itemsToLayout(allItems []LayoutItem) []LayoutItem {
-	filteredItems := make([]LayoutItem, 0, len(allItems))
-
-	for i := 0; i < cap(filteredItems); i++ {
-		item := allItems[i]
-
-		if !shouldLayoutItem(item) {
-			continue
-		}
-
-		var idealSize Size
-		if hfw, ok := item.(HeightForWidther); !ok || !hfw.HasHeightForWidth() {
-			if is, ok := item.(IdealSizer); ok {
-				idealSize = is.IdealSize()
-			}
-		}
-		if idealSize.Width == 0 && idealSize.Height == 0 && item.LayoutFlags() == 0 {
-			continue
-		}
-
-		filteredItems = append(filteredItems, item)
-	}
-
-	return filteredItems
-}
-
-func anyVisibleItemInHierarchy(item LayoutItem) bool {
-	if item == nil || !item.Visible() {
-		return false
-	}
-
-	if cli, ok := item.(ContainerLayoutItem); ok {
-		if slices.ContainsFunc(cli.AsContainerLayoutItemBase().children, anyVisibleItemInHierarchy) {
-			return true
-		}
-	} else if _, ok := item.(*spacerLayoutItem); !ok {
-		return true
-	}
-
-	return false
-}
-
-// minSizeEffective returns minimum effective size in native pixels
-func minSizeEffective(item LayoutItem) Size {
-	geometry := item.Geometry()
-
-	var s Size
-	if msh, ok := item.(MinSizer); ok {
-		s = msh.MinSize()
-	} else if is, ok := item.(IdealSizer); ok {
-		s = is.IdealSize()
-	}
-
-	size := maxSize(geometry.MinSize, s)
-
-	max := geometry.MaxSize
-	if max.Width > 0 && size.Width > max.Width {
-		size.Width = max.Width
-	}
-	if max.Height > 0 && size.Height > max.Height {
-		size.Height = max.Height
-	}
-
-	return size
-}
@@ -0,0 +1,486 @@
+// Copyright 2019 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"slices"
+	"sync"
+)
+
+// LayoutFlags specify how a Widget wants to be treated when used with a Layout.
+//
+// These flags are interpreted in respect to Widget.SizeHint.
+type LayoutFlags byte
+
+const (
+	// ShrinkableHorz allows a Widget to be shrunk horizontally.
+	ShrinkableHorz LayoutFlags = 1 << iota
+
+	// ShrinkableVert allows a Widget to be shrunk vertically.
+	ShrinkableVert
+
+	// GrowableHorz allows a Widget to be enlarged horizontally.
+	GrowableHorz
+
+	// GrowableVert allows a Widget to be enlarged vertically.
+	GrowableVert
+
+	// GreedyHorz specifies that the widget prefers to take up as much space as
+	// possible, horizontally.
+	GreedyHorz
+
+	// GreedyVert specifies that the widget prefers to take up as much space as
+	// possible, vertically.
+	GreedyVert
+)
+
+// Margins define margins in 1/96" units or native pixels.
+type Margins struct {
+	HNear, VNear, HFar, VFar int
+}
+
+func (m Margins) isZero() bool {
+	return m.HNear == 0 && m.HFar == 0 && m.VNear == 0 && m.VFar == 0
+}
+
+type IdealSizer interface {
+	// IdealSize returns ideal window size in native pixels.
+	IdealSize() Size
+}
+
+type MinSizer interface {
+	// MinSize returns minimum window size in native pixels.
+	MinSize() Size
+}
+
+type MinSizeForSizer interface {
+	// MinSize returns minimum window size for given size. Both sizes are in native pixels.
+	MinSizeForSize(size Size) Size
+}
+
+type HeightForWidther interface {
+	HasHeightForWidth() bool
+
+	// HeightForWidth returns appropriate height if element has given width. width parameter and
+	// return value are in native pixels.
+	HeightForWidth(width int) int
+}
+
+type Baseliner interface {
+	HasBaseline() bool
+
+	// Baseline returns the distance from the top of the element to the baseline of its text, if
+	// element has given height. height parameter and return value are in native pixels.
+	Baseline(height int) int
+}
+
+type LayoutContext struct {
+	layoutItem2MinSizeEffective map[LayoutItem]Size // in native pixels
+	dpi                         int
+	lastHeadlessHandle          layoutHandle
+}
+
+// NewLayoutContext returns a new LayoutContext for laying out items at dpi.
+func NewLayoutContext(dpi int) *LayoutContext {
+	return &LayoutContext{
+		layoutItem2MinSizeEffective: make(map[LayoutItem]Size),
+		dpi:                         dpi,
+	}
+}
+
+func (ctx *LayoutContext) DPI() int {
+	return ctx.dpi
+}
+
+type LayoutItem interface {
+	AsLayoutItemBase() *LayoutItemBase
+	Context() *LayoutContext
+	Handle() layoutHandle
+	Geometry() *Geometry
+	Parent() ContainerLayoutItem
+	Visible() bool
+	LayoutFlags() LayoutFlags
+}
+
+type ContainerLayoutItem interface {
+	LayoutItem
+	MinSizer
+	MinSizeForSizer
+	HeightForWidther
+	AsContainerLayoutItemBase() *ContainerLayoutItemBase
+
+	// MinSizeEffectiveForChild returns minimum effective size for a child in native pixels.
+	MinSizeEffectiveForChild(child LayoutItem) Size
+
+	PerformLayout() []LayoutResultItem
+	Children() []LayoutItem
+	containsHandle(handle layoutHandle) bool
+}
+
+type LayoutItemBase struct {
+	ctx      *LayoutContext
+	handle   layoutHandle
+	geometry Geometry
+	parent   ContainerLayoutItem
+	visible  bool
+}
+
+func (lib *LayoutItemBase) AsLayoutItemBase() *LayoutItemBase {
+	return lib
+}
+
+func (lib *LayoutItemBase) Context() *LayoutContext {
+	return lib.ctx
+}
+
+func (lib *LayoutItemBase) Handle() layoutHandle {
+	return lib.handle
+}
+
+func (lib *LayoutItemBase) Geometry() *Geometry {
+	return &lib.geometry
+}
+
+func (lib *LayoutItemBase) Parent() ContainerLayoutItem {
+	return lib.parent
+}
+
+func (lib *LayoutItemBase) Visible() bool {
+	return lib.visible
+}
+
+type ContainerLayoutItemBase struct {
+	LayoutItemBase
+	children          []LayoutItem
+	margins96dpi      Margins
+	spacing96dpi      int
+	alignment         Alignment2D
+	baselineAlignment bool
+	rightToLeft       bool
+}
+
+func (clib *ContainerLayoutItemBase) AsContainerLayoutItemBase() *ContainerLayoutItemBase {
+	return clib
+}
+
+var clibMinSizeEffectiveForChildMutex sync.Mutex
+
+func (clib *ContainerLayoutItemBase) MinSizeEffectiveForChild(child LayoutItem) Size {
+	// NOTE: This map is pre-populated in startLayoutTree before performing layout.
+	// For other usages it is not pre-populated and we assume this method will then
+	// be called from the main goroutine exclusively.
+	// If we want to do concurrent size measurement, we will need to pre-populate also.
+
+	// FIXME: There seems to be a bug in pre-population, so we use a mutex for now.
+
+	clibMinSizeEffectiveForChildMutex.Lock()
+
+	if clib.ctx != nil {
+		if size, ok := clib.ctx.layoutItem2MinSizeEffective[child]; ok {
+			clibMinSizeEffectiveForChildMutex.Unlock()
+			return size
+		}
+	}
+
+	if clib.ctx == nil {
+		if clib.parent == nil {
+			clib.ctx = newLayoutContext(clib.Handle())
+		} else {
+			clib.ctx = clib.parent.Context()
+		}
+	}
+
+	child.AsLayoutItemBase().ctx = clib.ctx
+
+	clibMinSizeEffectiveForChildMutex.Unlock()
+
+	size := minSizeEffective(child)
+
+	clibMinSizeEffectiveForChildMutex.Lock()
+
+	if clib.ctx != nil {
+		clib.ctx.layoutItem2MinSizeEffective[child] = size
+	}
+
+	clibMinSizeEffectiveForChildMutex.Unlock()
+
+	return size
+}
+
+func (clib *ContainerLayoutItemBase) Children() []LayoutItem {
+	return clib.children
+}
+
+func (clib *ContainerLayoutItemBase) SetChildren(children []LayoutItem) {
+	clib.children = children
+}
+
+func (clib *ContainerLayoutItemBase) containsHandle(handle layoutHandle) bool {
+	for _, item := range clib.children {
+		if item.Handle() == handle {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (clib *ContainerLayoutItemBase) HasHeightForWidth() bool {
+	for _, child := range clib.children {
+		if hfw, ok := child.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// performContainerLayout returns the bounds of the children of container,
+// mirrored horizontally if container lays out from right to left.
+func performContainerLayout(container ContainerLayoutItem) []LayoutResultItem {
+	items := container.PerformLayout()
+
+	if clib := container.AsContainerLayoutItemBase(); clib.rightToLeft {
+		mirrorLayoutResultItems(items, clib.geometry.ClientSize.Width)
+	}
+
+	return items
+}
+
+// mirrorLayoutResultItems mirrors the bounds of items horizontally within width.
+// All values are in native pixels.
+func mirrorLayoutResultItems(items []LayoutResultItem, width int) {
+	for i := range items {
+		items[i].Bounds.X = width - items[i].Bounds.X - items[i].Bounds.Width
+	}
+}
+
+type greedyLayoutItem struct {
+	LayoutItemBase
+}
+
+func NewGreedyLayoutItem() LayoutItem {
+	return new(greedyLayoutItem)
+}
+
+func (*greedyLayoutItem) LayoutFlags() LayoutFlags {
+	return ShrinkableHorz | GrowableHorz | GreedyHorz | ShrinkableVert | GrowableVert | GreedyVert
+}
+
+func (li *greedyLayoutItem) IdealSize() Size {
+	return SizeFrom96DPI(Size{100, 100}, li.ctx.dpi)
+}
+
+func (li *greedyLayoutItem) MinSize() Size {
+	return SizeFrom96DPI(Size{50, 50}, li.ctx.dpi)
+}
+
+type SpacerCfg struct {
+	LayoutFlags       LayoutFlags
+	SizeHint          Size // in 1/96" units
+	GreedyLocallyOnly bool
+}
+
+type spacerLayoutItem struct {
+	LayoutItemBase
+	idealSize96dpi    Size
+	layoutFlags       LayoutFlags
+	greedyLocallyOnly bool
+}
+
+func (li *spacerLayoutItem) LayoutFlags() LayoutFlags {
+	return li.layoutFlags
+}
+
+func (li *spacerLayoutItem) IdealSize() Size {
+	return SizeFrom96DPI(li.idealSize96dpi, li.ctx.dpi)
+}
+
+func (li *spacerLayoutItem) MinSize() Size {
+	return SizeFrom96DPI(li.idealSize96dpi, li.ctx.dpi)
+}
+
+type Geometry struct {
+	Alignment                   Alignment2D
+	MinSize                     Size // in native pixels
+	MaxSize                     Size // in native pixels
+	IdealSize                   Size // in native pixels
+	Size                        Size // in native pixels
+	ClientSize                  Size // in native pixels
+	ConsumingSpaceWhenInvisible bool
+}
+
+type LayoutResult struct {
+	container ContainerLayoutItem
+	items     []LayoutResultItem
+}
+
+type LayoutResultItem struct {
+	Item   LayoutItem
+	Bounds Rectangle // in native pixels
+}
+
+// textBaseline implements Baseliner for layout items that display text.
+type textBaseline struct {
+	ascent     int         // in native pixels
+	textHeight int         // in native pixels
+	alignment  Alignment1D // vertical position of the text within the item
+}
+
+func (tb textBaseline) HasBaseline() bool {
+	return tb.textHeight > 0
+}
+
+func (tb textBaseline) Baseline(height int) int {
+	switch tb.alignment {
+	case AlignNear:
+		return tb.ascent
+
+	case AlignFar:
+		return height - tb.textHeight + tb.ascent
+	}
+
+	return (height-tb.textHeight)/2 + tb.ascent
+}
+
+// baselineOf returns the baseline of item if it had height height. All values
+// are in native pixels.
+func baselineOf(item LayoutItem, height int) int {
+	if b, ok := item.(Baseliner); ok && b.HasBaseline() {
+		return b.Baseline(height)
+	}
+
+	return height / 2
+}
+
+// alignBaselines moves the items that report a baseline and do not grow
+// vertically, so their baselines line up. The aligned items are kept within the
+// space from top to top+height, where they are placed as a group according to
+// the vertical part of alignment. All values are in native pixels.
+func alignBaselines(items []LayoutResultItem, top, height int, alignment Alignment2D) {
+	var baseline, extent int
+	var count int
+
+	participates := func(item LayoutItem) bool {
+		b, ok := item.(Baseliner)
+
+		return ok && b.HasBaseline() && item.LayoutFlags()&GrowableVert == 0
+	}
+
+	for _, item := range items {
+		if participates(item.Item) {
+			baseline = maxi(baseline, baselineOf(item.Item, item.Bounds.Height))
+			count++
+		}
+	}
+
+	if count < 2 {
+		return
+	}
+
+	for _, item := range items {
+		if participates(item.Item) {
+			extent = maxi(extent, baseline-baselineOf(item.Item, item.Bounds.Height)+item.Bounds.Height)
+		}
+	}
+
+	groupTop := top
+	switch alignment {
+	case AlignHNearVNear, AlignHCenterVNear, AlignHFarVNear:
+		// nop
+
+	case AlignHNearVFar, AlignHCenterVFar, AlignHFarVFar:
+		groupTop += height - extent
+
+	default:
+		groupTop += (height - extent) / 2
+	}
+
+	for i, item := range items {
+		if !participates(item.Item) {
+			continue
+		}
+
+		y := groupTop + baseline - baselineOf(item.Item, item.Bounds.Height)
+
+		items[i].Bounds.Y = maxi(top, mini(y, top+height-item.Bounds.Height))
+	}
+}
+
+func shouldLayoutItem(item LayoutItem) bool {
+	if item == nil {
+		return false
+	}
+
+	_, isSpacer := item.(*spacerLayoutItem)
+
+	return isSpacer || item.Visible() || item.Geometry().ConsumingSpaceWhenInvisible
+}
+
+func itemsToLayout(allItems []LayoutItem) []LayoutItem {
+	filteredItems := make([]LayoutItem, 0, len(allItems))
+
+	for i := 0; i < cap(filteredItems); i++ {
+		item := allItems[i]
+
+		if !shouldLayoutItem(item) {
+			continue
+		}
+
+		var idealSize Size
+		if hfw, ok := item.(HeightForWidther); !ok || !hfw.HasHeightForWidth() {
+			if is, ok := item.(IdealSizer); ok {
+				idealSize = is.IdealSize()
+			}
+		}
+		if idealSize.Width == 0 && idealSize.Height == 0 && item.LayoutFlags() == 0 {
+			continue
+		}
+
+		filteredItems = append(filteredItems, item)
+	}
+
+	return filteredItems
+}
+
+func anyVisibleItemInHierarchy(item LayoutItem) bool {
+	if item == nil || !item.Visible() {
+		return false
+	}
+
+	if cli, ok := item.(ContainerLayoutItem); ok {
+		if slices.ContainsFunc(cli.AsContainerLayoutItemBase().children, anyVisibleItemInHierarchy) {
+			return true
+		}
+	} else if _, ok := item.(*spacerLayoutItem); !ok {
+		return true
+	}
+
+	return false
+}
+
+// minSizeEffective returns minimum effective size in native pixels
+func minSizeEffective(item LayoutItem) Size {
+	geometry := item.Geometry()
+
+	var s Size
+	if msh, ok := item.(MinSizer); ok {
+		s = msh.MinSize()
+	} else if is, ok := item.(IdealSizer); ok {
+		s = is.IdealSize()
+	}
+
+	size := maxSize(geometry.MinSize, s)
+
+	max := geometry.MaxSize
+	if max.Width > 0 && size.Width > max.Width {
+		size.Width = max.Width
+	}
+	if max.Height > 0 && size.Height > max.Height {
+		size.Height = max.Height
+	}
+
+	return size
+}
@@ -349,14 +349,16 @@ func (le *LineEdit) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	}
 
 	return &lineEditLayoutItem{
-		layoutFlags: lf,
-		idealSize:   le.sizeHintForLimit(lineEditGreedyLimit),
-		minSize:     le.sizeHintForLimit(lineEditMinChars),
+		textBaseline: le.newTextBaseline(0, AlignCenter),
+		layoutFlags:  lf,
+		idealSize:    le.sizeHintForLimit(lineEditGreedyLimit),
+		minSize:      le.sizeHintForLimit(lineEditMinChars),
 	}
 }
 
 type lineEditLayoutItem struct {
 	LayoutItemBase
+	textBaseline
 	layoutFlags LayoutFlags
 	idealSize   Size // in native pixels
 	minSize     Size // in native pixels
@@ -32,6 +32,12 @@ func NewLinkLabel(parent Container) (*LinkLabel, error) {
 		return nil, err
 	}
 
+	ll.init()
+
+	return ll, nil
+}
+
+func (ll *LinkLabel) init() {
 	ll.SetBackground(nullBrushSingleton)
 
 	ll.MustRegisterProperty("Text", NewProperty(
@@ -42,8 +48,6 @@ func NewLinkLabel(parent Container) (*LinkLabel, error) {
 			return ll.SetText(assertStringOr(v, ""))
 		},
 		ll.textChangedPublisher.Event()))
-
-	return ll, nil
 }
 
 func (ll *LinkLabel) Text() string {
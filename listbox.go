@@ -47,6 +47,11 @@ type ListBox struct {
 	themeSelectedTextColor          Color
 	themeSelectedNotFocusedBGColor  Color
 	trackingMouseEvent              bool
+	itemsReorderable                bool
+	dragPending                     bool
+	dragStart                       win.POINT
+	dropPosition                    int
+	dropIndex                       int
 }
 
 func NewListBox(parent Container) (*ListBox, error) {
@@ -54,7 +59,7 @@ func NewListBox(parent Container) (*ListBox, error) {
 }
 
 func NewListBoxWithStyle(parent Container, style uint32) (*ListBox, error) {
-	lb := new(ListBox)
+	lb := &ListBox{dropIndex: -1}
 
 	err := InitWidget(
 		lb,
@@ -403,7 +408,7 @@ func (lb *ListBox) SetModel(mdl any) error {
 	}
 
 	lb.model = model
-	lb.bindingValueProvider, _ = model.(BindingValueProvider)
+	lb.bindingValueProvider, _ = passedThrough[BindingValueProvider](model)
 
 	if model != nil {
 		lb.attachModel()
@@ -746,7 +751,34 @@ func (lb *ListBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) ui
 	case win.WM_LBUTTONDOWN:
 		lb.Invalidate()
 
+		lb.dragPending = lb.itemsMover() != nil
+		lb.dragStart = win.POINT{X: win.GET_X_LPARAM(lParam), Y: win.GET_Y_LPARAM(lParam)}
+
+	case win.WM_LBUTTONUP:
+		lb.dragPending = false
+
+	case win.WM_PAINT:
+		if lb.dropIndex == -1 {
+			break
+		}
+
+		result := lb.WidgetBase.WndProc(hwnd, msg, wParam, lParam)
+		lb.drawDropIndicator()
+
+		return result
+
 	case win.WM_MOUSEMOVE:
+		if lb.dragPending && wParam&win.MK_LBUTTON != 0 {
+			pt := win.POINT{X: win.GET_X_LPARAM(lParam), Y: win.GET_Y_LPARAM(lParam)}
+
+			if beyondDragThreshold(lb.dragStart, pt) {
+				lb.dragPending = false
+				lb.beginDrag()
+
+				return 0
+			}
+		}
+
 		if lb.styler == nil {
 			break
 		}
@@ -0,0 +1,219 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"slices"
+	"strings"
+	"unsafe"
+
+	"github.com/wuc656/win"
+)
+
+// ItemsReorderable returns whether the user can reorder the items of the
+// ListBox by drag and drop.
+func (lb *ListBox) ItemsReorderable() bool {
+	return lb.itemsReorderable
+}
+
+// SetItemsReorderable sets whether the user can reorder the items of the
+// ListBox by drag and drop. The model must implement ItemsMover.
+func (lb *ListBox) SetItemsReorderable(reorderable bool) error {
+	lb.itemsReorderable = reorderable
+
+	if reorderable {
+		return lb.ensureDropTarget().register()
+	}
+
+	return nil
+}
+
+// DropIndex returns the index of the item, in front of which a drag over the
+// ListBox would drop, or -1 if there is no drag over it. It is equal to the
+// item count for drops after the last item.
+func (lb *ListBox) DropIndex() int {
+	return lb.dropIndex
+}
+
+// itemsMover returns the ItemsMover items can be reordered with, or nil.
+func (lb *ListBox) itemsMover() ItemsMover {
+	if !lb.itemsReorderable {
+		return nil
+	}
+
+	mover, _ := lb.model.(ItemsMover)
+
+	return mover
+}
+
+// draggedIndexes returns the indexes of the items a drag started from the
+// ListBox moves.
+func (lb *ListBox) draggedIndexes() []int {
+	if lb.hasStyleBits(win.LBS_EXTENDEDSEL) || lb.hasStyleBits(win.LBS_MULTIPLESEL) {
+		return lb.SelectedIndexes()
+	}
+
+	if index := lb.CurrentIndex(); index != -1 {
+		return []int{index}
+	}
+
+	return nil
+}
+
+// beginDrag lets the user drag the selected items to reorder them. They can be
+// dropped on other applications as text.
+func (lb *ListBox) beginDrag() {
+	indexes := lb.draggedIndexes()
+	if len(indexes) == 0 {
+		return
+	}
+
+	// The list box captured the mouse on the button press.
+	win.ReleaseCapture()
+
+	lines := make([]string, len(indexes))
+	for i, index := range indexes {
+		lines[i] = lb.itemString(index)
+	}
+
+	lb.DoDragDrop(ClipboardContent{Text: strings.Join(lines, "\r\n")}, DropEffectCopy|DropEffectMove)
+}
+
+func (lb *ListBox) isReorderDrag(args *DragDropEventArgs) bool {
+	return args != nil && args.Source() == lb.window && lb.itemsMover() != nil
+}
+
+func (lb *ListBox) setDropPosition(p Point) {
+	count := lb.itemCount()
+	if count == 0 {
+		lb.dropPosition = 0
+		return
+	}
+
+	result := uint32(lb.SendMessage(win.LB_ITEMFROMPOINT, 0, uintptr(win.MAKELONG(uint16(p.X), uint16(p.Y)))))
+	index := int(win.LOWORD(result))
+
+	var rc win.RECT
+	lb.SendMessage(win.LB_GETITEMRECT, uintptr(index), uintptr(unsafe.Pointer(&rc)))
+
+	// Drops go to the gap between items nearest to the mouse.
+	if int32(p.Y) > (rc.Top+rc.Bottom)/2 {
+		index++
+	}
+	lb.dropPosition = min(index, count)
+
+	// Scroll, while the mouse is over the first or last visible item.
+	top := int(lb.SendMessage(win.LB_GETTOPINDEX, 0, 0))
+
+	var rcTop, rcClient win.RECT
+	lb.SendMessage(win.LB_GETITEMRECT, uintptr(top), uintptr(unsafe.Pointer(&rcTop)))
+	win.GetClientRect(lb.hWnd, &rcClient)
+
+	height := rcTop.Bottom - rcTop.Top
+
+	if int32(p.Y) < rcTop.Top+height/2 && top > 0 {
+		lb.SendMessage(win.LB_SETTOPINDEX, uintptr(top-1), 0)
+	} else if int32(p.Y) > rcClient.Bottom-height/2 && int32(p.Y) <= rcClient.Bottom {
+		lb.SendMessage(win.LB_SETTOPINDEX, uintptr(top+1), 0)
+	}
+}
+
+func (lb *ListBox) dropEffect(args *DragDropEventArgs, effect DropEffect) DropEffect {
+	if lb.isReorderDrag(args) {
+		effect = DropEffectMove
+	}
+
+	index := -1
+	if effect != DropEffectNone {
+		index = lb.dropPosition
+	}
+	lb.setDropIndex(index)
+
+	return effect
+}
+
+func (lb *ListBox) endDrag(args *DragDropEventArgs, effect DropEffect, dropped bool) DropEffect {
+	index := lb.dropIndex
+	lb.setDropIndex(-1)
+
+	if !dropped || !lb.isReorderDrag(args) || index == -1 {
+		return effect
+	}
+
+	indexes := lb.draggedIndexes()
+	if len(indexes) == 0 {
+		return DropEffectNone
+	}
+
+	if err := lb.itemsMover().MoveItems(indexes, index); err != nil {
+		return DropEffectNone
+	}
+
+	start := movedItemsStart(indexes, index)
+
+	if lb.hasStyleBits(win.LBS_EXTENDEDSEL) || lb.hasStyleBits(win.LBS_MULTIPLESEL) {
+		moved := make([]int, len(slices.Compact(slices.Sorted(slices.Values(indexes)))))
+		for i := range moved {
+			moved[i] = start + i
+		}
+
+		lb.SetSelectedIndexes(moved)
+	} else {
+		lb.SetCurrentIndex(start)
+	}
+
+	return DropEffectMove
+}
+
+func (lb *ListBox) itemCount() int {
+	if lb.model == nil {
+		return 0
+	}
+
+	return lb.model.ItemCount()
+}
+
+func (lb *ListBox) setDropIndex(index int) {
+	if index == lb.dropIndex {
+		return
+	}
+
+	lb.dropIndex = index
+
+	lb.Invalidate()
+}
+
+// drawDropIndicator draws the line between items, where a drag would drop.
+func (lb *ListBox) drawDropIndicator() {
+	if lb.dropIndex == -1 {
+		return
+	}
+
+	count := lb.itemCount()
+
+	var rcClient win.RECT
+	win.GetClientRect(lb.hWnd, &rcClient)
+
+	var y int32
+	switch {
+	case count == 0:
+		y = rcClient.Top
+
+	case lb.dropIndex < count:
+		var rc win.RECT
+		lb.SendMessage(win.LB_GETITEMRECT, uintptr(lb.dropIndex), uintptr(unsafe.Pointer(&rc)))
+		y = rc.Top
+
+	default:
+		var rc win.RECT
+		lb.SendMessage(win.LB_GETITEMRECT, uintptr(count-1), uintptr(unsafe.Pointer(&rc)))
+		y = rc.Bottom
+	}
+
+	drawDropIndicator(lb.hWnd, rcClient.Left, rcClient.Right, y, rcClient.Top, lb.DPI())
+}
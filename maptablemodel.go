@@ -14,9 +14,10 @@ import (
 type mapTableModel struct {
 	TableModelBase
 	SorterBase
-	dataMembers []string
-	dataSource  any
-	items       []map[string]any
+	compareFuncs []func(a, b any) int
+	dataMembers  []string
+	dataSource   any
+	items        []map[string]any
 }
 
 func newMapTableModel(dataSource any) (TableModel, error) {
@@ -28,6 +29,10 @@ func newMapTableModel(dataSource any) (TableModel, error) {
 	return &mapTableModel{dataSource: dataSource, items: items}, nil
 }
 
+func (m *mapTableModel) setCompareFuncs(compareFuncs []func(a, b any) int) {
+	m.compareFuncs = compareFuncs
+}
+
 func (m *mapTableModel) setDataMembers(dataMembers []string) {
 	m.dataMembers = dataMembers
 }
@@ -52,8 +57,25 @@ func (m *mapTableModel) Value(row, col int) any {
 	return m.items[row][m.dataMembers[col]]
 }
 
+// SetValue sets the value col shows of the item at row.
+func (m *mapTableModel) SetValue(row, col int, value any) error {
+	if m.items[row] == nil {
+		return newError("item not populated")
+	}
+
+	m.items[row][m.dataMembers[col]] = value
+
+	m.PublishRowChanged(row)
+
+	return nil
+}
+
 func (m *mapTableModel) Sort(col int, order SortOrder) error {
-	m.col, m.order = col, order
+	return m.SortByKeys(sortKeysFor(col, order))
+}
+
+func (m *mapTableModel) SortByKeys(keys []SortKey) error {
+	m.setSortKeys(keys)
 
 	sort.Stable(m)
 
@@ -67,9 +89,9 @@ func (m *mapTableModel) Len() int {
 }
 
 func (m *mapTableModel) Less(i, j int) bool {
-	col := m.SortedColumn()
-
-	return less(m.Value(i, col), m.Value(j, col), m.SortOrder())
+	return lessByKeys(m.keys, i, j, func(col, i, j int) int {
+		return compareValuesWith(m.compareFuncs, col, m.Value(i, col), m.Value(j, col))
+	})
 }
 
 func (m *mapTableModel) Swap(i, j int) {
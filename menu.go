@@ -201,6 +201,41 @@ func (m *Menu) updateItemsForWindow(window Window) {
 	}
 }
 
+// popupFlags returns the flags for showing m as a popup menu of window with
+// TrackPopupMenuEx, owned by the window with handle owner.
+//
+// For right to left windows the popup menu is right aligned to the position
+// it is shown at and its items are ordered from right to left.
+func (m *Menu) popupFlags(window Window, owner win.HWND) uint32 {
+	rtl := windowRightToLeft(window)
+
+	// Popup menus of mirrored owners are mirrored by the system already.
+	m.setRightToLeft(rtl && !hasWindowLongBits(owner, win.GWL_EXSTYLE, win.WS_EX_LAYOUTRTL))
+
+	flags := uint32(win.TPM_NOANIMATION)
+	if rtl {
+		flags |= win.TPM_RIGHTALIGN
+	}
+
+	return flags
+}
+
+func (m *Menu) setRightToLeft(rtl bool) {
+	if rtl == m.perMenuMetrics.rightToLeft {
+		return
+	}
+
+	m.perMenuMetrics.rightToLeft = rtl
+
+	for _, action := range m.actions.actions {
+		m.onActionChanged(action)
+
+		if action.menu != nil {
+			action.menu.setRightToLeft(rtl)
+		}
+	}
+}
+
 func (m *Menu) resolveDPI() int {
 	switch {
 	case m.getDPI != nil:
@@ -250,6 +285,10 @@ func (m *Menu) initMenuItemInfoFromAction(mii *win.MENUITEMINFO, action *Action)
 		setString = false
 	}
 
+	if m.perMenuMetrics.rightToLeft {
+		mii.FType |= win.MFT_RIGHTORDER
+	}
+
 	if setString {
 		mii.FMask |= win.MIIM_STRING
 		var text string
@@ -44,6 +44,7 @@ type MenuItemDrawContext struct {
 	ThemeFont    *Font     // The Font that the theme expects to be used for this item in its current state.
 	Rectangle    Rectangle // Bounds of the content within Canvas.
 	Padding      int       // Theme-compliant spacing that may be used for positioning between sub-components of the menu content.
+	RightToLeft  bool      // Whether the content should be drawn from right to left. False for menus that are mirrored by the system.
 }
 
 // menuItemLayout contains the computed bounds for each component of an
@@ -65,6 +66,7 @@ type menuItemLayout struct {
 // menuSpecificMetrics contains per-menu (as opposed to per-item) metrics.
 type menuSpecificMetrics struct {
 	maxAccelTextExtent win.SIZE
+	rightToLeft        bool // Items are ordered from right to left, see (*Menu).popupFlags
 }
 
 func (mm *menuSpecificMetrics) reset() {
@@ -391,6 +393,24 @@ func (ml *menuItemLayout) layout(sm *menuSharedMetrics, rect *win.RECT) {
 	stripMargins(&ml.chevronRect, sm.chevronMargins)
 }
 
+// mirror mirrors the bounds of all components of the menu item horizontally
+// within the bounds of the item.
+func (ml *menuItemLayout) mirror() {
+	left, right := ml.selectionRect.Left, ml.selectionRect.Right
+
+	for _, rect := range []*win.RECT{
+		&ml.checkboxRect,
+		&ml.checkboxBgRect,
+		&ml.contentRect,
+		&ml.gutterRect,
+		&ml.separatorRect,
+		&ml.chevronRect,
+		&ml.chevronClipRect,
+	} {
+		rect.Left, rect.Right = left+right-rect.Right, left+right-rect.Left
+	}
+}
+
 // ownerDrawnMenuItemInfo is the per-item data that must be associated with any
 // menu item.
 type ownerDrawnMenuItemInfo struct {
@@ -547,6 +567,13 @@ func (odi *ownerDrawnMenuItemInfo) onDraw(w Window, dis *win.DRAWITEMSTRUCT) {
 
 	odi.layout.layout(sm, &dis.RcItem)
 
+	// Menus of mirrored windows are drawn to mirrored DCs, so we only have to
+	// mirror menus with items ordered from right to left ourselves.
+	rightToLeft := odi.perMenuMetrics != nil && odi.perMenuMetrics.rightToLeft
+	if rightToLeft {
+		odi.layout.mirror()
+	}
+
 	isSubMenu := odi.action.menu != nil
 	if isSubMenu {
 		// Windows unconditionally tries to draw an unthemed submenu chevron atop
@@ -622,6 +649,7 @@ func (odi *ownerDrawnMenuItemInfo) onDraw(w Window, dis *win.DRAWITEMSTRUCT) {
 		BoldFont:     sm.fontBold,
 		Rectangle:    rectangleFromRECT(odi.layout.contentRect),
 		Padding:      int(sm.contentMargins.LeftWidth),
+		RightToLeft:  rightToLeft,
 	}
 
 	if odi.action.Default() {
@@ -661,7 +689,17 @@ func (defaultActionOwnerDrawHandler) OnMeasure(action *Action, mctx *MenuItemMea
 
 // OnDraw by default draws both the menu text and the accelerator text, if any.
 func (defaultActionOwnerDrawHandler) OnDraw(action *Action, dctx *MenuItemDrawContext) {
-	flags := uint32(win.DT_LEFT | win.DT_SINGLELINE)
+	textAlign, accelAlign := uint32(win.DT_LEFT), uint32(win.DT_RIGHT)
+	if dctx.RightToLeft {
+		textAlign, accelAlign = accelAlign, textAlign
+	}
+
+	var readingOrder uint32
+	if dctx.RightToLeft || windowRightToLeft(dctx.Window) {
+		readingOrder = win.DT_RTLREADING
+	}
+
+	flags := textAlign | readingOrder | win.DT_SINGLELINE
 	if (dctx.State & win.ODS_NOACCEL) != 0 {
 		flags |= win.DT_HIDEPREFIX
 	}
@@ -669,7 +707,7 @@ func (defaultActionOwnerDrawHandler) OnDraw(action *Action, dctx *MenuItemDrawCo
 	dctx.Theme.DrawText(dctx.Canvas, dctx.ThemeFont, win.MENU_POPUPITEM, dctx.ThemeStateID, action.Text(), flags, dctx.Rectangle, nil)
 
 	if action.shortcut.Key != 0 {
-		flags = win.DT_RIGHT | win.DT_SINGLELINE | win.DT_HIDEPREFIX
+		flags = accelAlign | win.DT_SINGLELINE | win.DT_HIDEPREFIX
 		dctx.Theme.DrawText(dctx.Canvas, dctx.ThemeFont, win.MENU_POPUPITEM, dctx.ThemeStateID, action.shortcut.String(), flags, dctx.Rectangle, nil)
 	}
 }
@@ -8,6 +8,7 @@
 package walk
 
 import (
+	"slices"
 	"syscall"
 
 	"github.com/wuc656/win"
@@ -157,6 +158,17 @@ type TableModel interface {
 	RowsRemoved() *IntRangeEvent
 }
 
+// EditableTableModel is the interface that a model must implement to support
+// editing cells in place with a widget like TableView.
+type EditableTableModel interface {
+	TableModel
+
+	// SetValue sets the value of the given cell to the value entered by the
+	// user. If it returns an error, the cell stays in editing mode and the error
+	// is shown. SetValue should publish the event returned from RowChanged().
+	SetValue(row, col int, value any) error
+}
+
 // TableModelBase implements the RowsReset and RowChanged methods of the
 // TableModel interface.
 type TableModelBase struct {
@@ -207,6 +219,29 @@ func (tmb *TableModelBase) PublishRowsRemoved(from, to int) {
 	tmb.rowsRemovedPublisher.Publish(from, to)
 }
 
+// publishRowEvents publishes the events of a rowMapper or treeRowMap.
+func (tmb *TableModelBase) publishRowEvents(events []rowEvent) {
+	for _, e := range events {
+		switch e.kind {
+		case rowsInserted:
+			tmb.PublishRowsInserted(e.from, e.to)
+
+		case rowsRemoved:
+			tmb.PublishRowsRemoved(e.from, e.to)
+
+		case rowsChanged:
+			if e.from == e.to {
+				tmb.PublishRowChanged(e.from)
+			} else {
+				tmb.PublishRowsChanged(e.from, e.to)
+			}
+
+		case rowsReset:
+			tmb.PublishRowsReset()
+		}
+	}
+}
+
 // ReflectTableModel provides an alternative to the TableModel interface. It
 // uses reflection to obtain data.
 type ReflectTableModel interface {
@@ -254,19 +289,19 @@ func (rtmb *ReflectTableModelBase) Value(row, col int) any {
 
 type interceptedSorter interface {
 	sorterBase() *SorterBase
-	setSortFunc(sort func(col int, order SortOrder) error)
+	setSortFunc(sort func(keys []SortKey) error)
 }
 
 // SortedReflectTableModelBase implements the RowsReset and RowChanged methods
-// of the ReflectTableModel interface as well as the Sorter interface for
+// of the ReflectTableModel interface as well as the MultiSorter interface for
 // pre-implemented in-memory sorting.
 type SortedReflectTableModelBase struct {
 	ReflectTableModelBase
 	SorterBase
-	sort func(col int, order SortOrder) error
+	sort func(keys []SortKey) error
 }
 
-func (srtmb *SortedReflectTableModelBase) setSortFunc(sort func(col int, order SortOrder) error) {
+func (srtmb *SortedReflectTableModelBase) setSortFunc(sort func(keys []SortKey) error) {
 	srtmb.sort = sort
 }
 
@@ -275,11 +310,19 @@ func (srtmb *SortedReflectTableModelBase) sorterBase() *SorterBase {
 }
 
 func (srtmb *SortedReflectTableModelBase) Sort(col int, order SortOrder) error {
+	return srtmb.SortByKeys(sortKeysFor(col, order))
+}
+
+func (srtmb *SortedReflectTableModelBase) SortByKeys(keys []SortKey) error {
 	if srtmb.sort != nil {
-		return srtmb.sort(col, order)
+		return srtmb.sort(keys)
 	}
 
-	return srtmb.SorterBase.Sort(col, order)
+	srtmb.setSortKeys(keys)
+
+	srtmb.changedPublisher.Publish()
+
+	return nil
 }
 
 // Populator is an interface that can be implemented by Reflect*Models and slice
@@ -307,6 +350,24 @@ type ImageProvider interface {
 	Image(index int) any
 }
 
+// ItemsMover is the interface that a ListModel or TableModel must implement to
+// let the user reorder its items by drag and drop in a ListBox or TableView.
+type ItemsMover interface {
+	// MoveItems moves the items at indexes in front of the item at index to,
+	// keeping their order, and publishes the change. to is an index before the
+	// move and equal to the item count to move the items to the end. MoveItems
+	// does the work for slices.
+	MoveItems(indexes []int, to int) error
+}
+
+// TreeItemMover is the interface that a TreeModel must implement to let the
+// user move its items by drag and drop in a TreeView.
+type TreeItemMover interface {
+	// MoveItem moves item to be the child of parent, nil for the roots, at
+	// index, counted before the move, and publishes the change.
+	MoveItem(item, parent TreeItem, index int) error
+}
+
 // CellStyler is the interface that must be implemented to provide a tabular
 // widget like TableView with cell display style information.
 type CellStyler interface {
@@ -527,17 +588,6 @@ type ItemChecker interface {
 	SetChecked(index int, checked bool) error
 }
 
-// SortOrder specifies the order by which items are sorted.
-type SortOrder int
-
-const (
-	// SortAscending specifies ascending sort order.
-	SortAscending SortOrder = iota
-
-	// SortDescending specifies descending sort order.
-	SortDescending
-)
-
 // Sorter is the interface that a model must implement to support sorting with a
 // widget like TableView.
 type Sorter interface {
@@ -561,16 +611,61 @@ type Sorter interface {
 	SortOrder() SortOrder
 }
 
+// MultiSorter is the interface that a model must implement to support sorting
+// by more than one column with a widget like TableView. SortedColumn and
+// SortOrder of a MultiSorter report the primary key.
+type MultiSorter interface {
+	Sorter
+
+	// SortByKeys sorts by keys, keys[0] being the primary key. Rows that are
+	// equal by all keys must keep their order.
+	//
+	// Sort(col, order) must be the same as sorting by the single key col, or
+	// no key if col is -1. SortByKeys must publish the event returned from
+	// SortChanged() after sorting.
+	SortByKeys(keys []SortKey) error
+
+	// SortKeys returns the keys currently sorted by.
+	SortKeys() []SortKey
+}
+
+// sortKeysOf returns the keys sorter currently sorts by.
+func sortKeysOf(sorter Sorter) []SortKey {
+	if ms, ok := sorter.(MultiSorter); ok {
+		return ms.SortKeys()
+	}
+
+	return sortKeysFor(sorter.SortedColumn(), sorter.SortOrder())
+}
+
+// sortByKeys sorts sorter by keys, or only by the primary key if sorter is no
+// MultiSorter.
+func sortByKeys(sorter Sorter, keys []SortKey) error {
+	if ms, ok := sorter.(MultiSorter); ok {
+		return ms.SortByKeys(keys)
+	}
+
+	if len(keys) == 0 {
+		return sorter.Sort(-1, SortAscending)
+	}
+
+	return sorter.Sort(keys[0].Column, keys[0].Order)
+}
+
 // SorterBase implements the Sorter interface.
 //
 // You still need to provide your own implementation of at least the Sort method
 // to actually sort and reset the model. Your Sort method should call the
 // SorterBase implementation so the SortChanged event, that e.g. a TableView
 // widget depends on, is published.
+//
+// SorterBase also keeps the keys for a MultiSorter, but it does not implement
+// SortByKeys.
 type SorterBase struct {
 	changedPublisher EventPublisher
 	col              int
 	order            SortOrder
+	keys             []SortKey
 }
 
 func (sb *SorterBase) ColumnSortable(col int) bool {
@@ -578,13 +673,28 @@ func (sb *SorterBase) ColumnSortable(col int) bool {
 }
 
 func (sb *SorterBase) Sort(col int, order SortOrder) error {
-	sb.col, sb.order = col, order
+	sb.setSortKeys(sortKeysFor(col, order))
 
 	sb.changedPublisher.Publish()
 
 	return nil
 }
 
+func (sb *SorterBase) setSortKeys(keys []SortKey) {
+	sb.keys = slices.Clone(keys)
+
+	if len(keys) > 0 {
+		sb.col, sb.order = keys[0].Column, keys[0].Order
+	} else {
+		sb.col, sb.order = -1, SortAscending
+	}
+}
+
+// SortKeys returns the keys currently sorted by.
+func (sb *SorterBase) SortKeys() []SortKey {
+	return slices.Clone(sb.keys)
+}
+
 func (sb *SorterBase) SortChanged() *Event {
 	return sb.changedPublisher.Event()
 }
@@ -597,6 +707,51 @@ func (sb *SorterBase) SortOrder() SortOrder {
 	return sb.order
 }
 
+// GroupingTableModel is the interface that a model must implement to show its
+// rows in groups with a widget like TableView. Each group is shown below a
+// header row with its title and number of rows, that collapses and expands the
+// group.
+type GroupingTableModel interface {
+	TableModel
+
+	// GroupKey returns the key of the group of row. Rows with equal keys form
+	// a group. Keys must be comparable.
+	GroupKey(row int) any
+
+	// GroupTitle returns the title of the group with key.
+	GroupTitle(key any) string
+
+	// GroupCollapsed returns whether the group with key is collapsed.
+	GroupCollapsed(key any) bool
+
+	// SetGroupCollapsed sets whether the group with key is collapsed. Use
+	// TableView.SetGroupCollapsed to also update the TableView.
+	SetGroupCollapsed(key any, collapsed bool)
+}
+
+// GroupingTableModelBase implements the collapsed state of a
+// GroupingTableModel. Groups are expanded until collapsed.
+type GroupingTableModelBase struct {
+	collapsed map[any]bool
+}
+
+func (gb *GroupingTableModelBase) GroupCollapsed(key any) bool {
+	return gb.collapsed[key]
+}
+
+func (gb *GroupingTableModelBase) SetGroupCollapsed(key any, collapsed bool) {
+	if !collapsed {
+		delete(gb.collapsed, key)
+		return
+	}
+
+	if gb.collapsed == nil {
+		gb.collapsed = make(map[any]bool)
+	}
+
+	gb.collapsed[key] = true
+}
+
 // Imager provides access to an image of objects like tree items.
 type Imager interface {
 	// Image returns the image to display for an item.
@@ -10,6 +10,7 @@ import (
 	"unsafe"
 
 	"github.com/wuc656/win"
+	"golang.org/x/sys/windows"
 )
 
 // Monitor is a reference to an individual monitor attached to the current machine.
@@ -69,3 +70,36 @@ func (m Monitor) getInfo() (mi win.MONITORINFO) {
 func PrimaryMonitor() Monitor {
 	return Monitor(win.MonitorFromWindow(0, win.MONITOR_DEFAULTTOPRIMARY))
 }
+
+var (
+	enumDisplayMonitors     = modUser32.NewProc("EnumDisplayMonitors")
+	enumDisplayMonitorsProc uintptr
+)
+
+func enumDisplayMonitorsCallback(hMonitor, hdc, lprcMonitor, lParam uintptr) uintptr {
+	monitors := (*[]Monitor)(unsafe.Pointer(lParam))
+	*monitors = append(*monitors, Monitor(hMonitor))
+
+	return win.TRUE
+}
+
+// Monitors obtains all monitors attached to the current machine, the primary
+// monitor first.
+func Monitors() []Monitor {
+	if enumDisplayMonitorsProc == 0 {
+		enumDisplayMonitorsProc = windows.NewCallback(enumDisplayMonitorsCallback)
+	}
+
+	var monitors []Monitor
+	enumDisplayMonitors.Call(0, 0, enumDisplayMonitorsProc, uintptr(unsafe.Pointer(&monitors)))
+
+	for i, m := range monitors {
+		if m.IsPrimary() {
+			copy(monitors[1:i+1], monitors[:i])
+			monitors[0] = m
+			break
+		}
+	}
+
+	return monitors
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"slices"
+)
+
+// MoveItems moves the items at indexes in front of the item at index to,
+// keeping their order. to is an index before the move and may be len(items)
+// to move the items to the end.
+func MoveItems[T any](items []T, indexes []int, to int) {
+	moving := make([]bool, len(items))
+	for _, i := range indexes {
+		moving[i] = true
+	}
+
+	var moved, kept []T
+	for i, item := range items {
+		if moving[i] {
+			moved = append(moved, item)
+		} else {
+			kept = append(kept, item)
+		}
+	}
+
+	at := movedItemsStart(indexes, to)
+
+	n := copy(items, kept[:at])
+	n += copy(items[n:], moved)
+	copy(items[n:], kept[at:])
+}
+
+// movedItemsStart returns the index of the first of the items at indexes after
+// MoveItems moved them in front of the item at index to.
+func movedItemsStart(indexes []int, to int) int {
+	indexes = slices.Compact(slices.Sorted(slices.Values(indexes)))
+
+	before := 0
+	for _, i := range indexes {
+		if i < to {
+			before++
+		}
+	}
+
+	return to - before
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"testing"
+)
+
+func TestMoveItems(t *testing.T) {
+	tests := []struct {
+		indexes []int
+		to      int
+		want    string
+		start   int
+	}{
+		{[]int{0}, 3, "bcadef", 2},
+		{[]int{4}, 1, "aebcdf", 1},
+		{[]int{1, 3}, 0, "bdacef", 0},
+		{[]int{3, 1}, 6, "acefbd", 4},
+		{[]int{1, 4}, 3, "acbedf", 2},
+		{[]int{2}, 2, "abcdef", 2},
+		{[]int{2}, 3, "abcdef", 2},
+		{[]int{0, 0}, 2, "bacdef", 1},
+	}
+
+	for _, test := range tests {
+		items := []byte("abcdef")
+
+		MoveItems(items, test.indexes, test.to)
+
+		if got := string(items); got != test.want {
+			t.Errorf("MoveItems(%v, %d) = %q, want %q", test.indexes, test.to, got, test.want)
+		}
+
+		if got := movedItemsStart(test.indexes, test.to); got != test.start {
+			t.Errorf("movedItemsStart(%v, %d) = %d, want %d", test.indexes, test.to, got, test.start)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !windows
+
+package walk
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// layoutHandle is the type of LayoutItem handles. Without windows, handles
+// only serve to tell headless layout items apart.
+type layoutHandle = uintptr
+
+func newLayoutContext(handle layoutHandle) *LayoutContext {
+	return NewLayoutContext(96)
+}
+
+// appSettingsFilePath returns the path of the settings file fileName in the
+// configuration folder of the user.
+func appSettingsFilePath(fileName string) (string, error) {
+	configPath, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configPath, fileName), nil
+}
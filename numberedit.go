@@ -64,6 +64,9 @@ func NewNumberEdit(parent Container) (*NumberEdit, error) {
 	}
 
 	ne.edit.applyFont(ne.Font())
+	if err = ne.edit.applyRightToLeft(ne.RightToLeft()); err != nil {
+		return nil, err
+	}
 
 	ne.SetRange(-math.MaxFloat64, math.MaxFloat64)
 
@@ -370,7 +373,7 @@ func (ne *NumberEdit) SetSpinButtonsVisible(visible bool) error {
 			0,
 			CachedStringToUTF16Ptr("msctls_updown32"),
 			nil,
-			win.WS_CHILD|win.WS_VISIBLE|win.UDS_ALIGNRIGHT|win.UDS_ARROWKEYS|win.UDS_HOTTRACK,
+			win.WS_CHILD|win.WS_VISIBLE|ne.spinButtonsAlignStyle()|win.UDS_ARROWKEYS|win.UDS_HOTTRACK,
 			0,
 			0,
 			16,
@@ -395,6 +398,37 @@ func (ne *NumberEdit) SetSpinButtonsVisible(visible bool) error {
 	return nil
 }
 
+func (ne *NumberEdit) spinButtonsAlignStyle() uint32 {
+	if ne.RightToLeft() {
+		return win.UDS_ALIGNLEFT
+	}
+
+	return win.UDS_ALIGNRIGHT
+}
+
+func (ne *NumberEdit) applyRightToLeft(rtl bool) error {
+	if err := ne.WidgetBase.applyRightToLeft(rtl); err != nil {
+		return err
+	}
+
+	if ne.edit == nil || ne.hWndUpDown == 0 {
+		return nil
+	}
+
+	if err := setAndClearWindowLongBits(ne.hWndUpDown, win.GWL_STYLE, ne.spinButtonsAlignStyle(), win.UDS_ALIGNLEFT|win.UDS_ALIGNRIGHT); err != nil {
+		return err
+	}
+
+	// The spin buttons move to the other side of the edit on UDM_SETBUDDY.
+	if err := ne.edit.SetBoundsPixels(ne.ClientBoundsPixels()); err != nil {
+		return err
+	}
+
+	win.SendMessage(ne.hWndUpDown, win.UDM_SETBUDDY, uintptr(ne.edit.hWnd), 0)
+
+	return nil
+}
+
 // Background returns the background Brush of the NumberEdit.
 //
 // By default this is nil.
@@ -465,13 +499,15 @@ func (ne *NumberEdit) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr)
 
 func (ne *NumberEdit) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	return &numberEditLayoutItem{
-		idealSize: ne.dialogBaseUnitsToPixels(Size{50, 12}),
-		minSize:   ne.dialogBaseUnitsToPixels(Size{20, 12}),
+		textBaseline: ne.newTextBaseline(0, AlignCenter),
+		idealSize:    ne.dialogBaseUnitsToPixels(Size{50, 12}),
+		minSize:      ne.dialogBaseUnitsToPixels(Size{20, 12}),
 	}
 }
 
 type numberEditLayoutItem struct {
 	LayoutItemBase
+	textBaseline
 	idealSize Size // in native pixels
 	minSize   Size // in native pixels
 }
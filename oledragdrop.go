@@ -0,0 +1,479 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+
+	"github.com/wuc656/win"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	dvaspectContent = 1
+	tymedHGlobal    = 1
+	datadirGet      = 1
+
+	dragdropSDrop              = 0x00040100
+	dragdropSCancel            = 0x00040101
+	dragdropSUseDefaultCursors = 0x00040102
+	dvEFormatEtc               = 0x80040064
+	oleEAdviseNotSupported     = 0x80040003
+	eOutOfMemory               = 0x8007000E
+
+	mkAlt = 0x0020
+)
+
+var (
+	iidIDataObject    = win.IID{Data1: 0x0000010E, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+	iidIDropSource    = win.IID{Data1: 0x00000121, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+	iidIDropTarget    = win.IID{Data1: 0x00000122, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+	iidIEnumFORMATETC = win.IID{Data1: 0x00000103, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+	modOle32          = windows.NewLazySystemDLL("ole32.dll")
+	doDragDrop        = modOle32.NewProc("DoDragDrop")
+	registerDragDrop  = modOle32.NewProc("RegisterDragDrop")
+	revokeDragDrop    = modOle32.NewProc("RevokeDragDrop")
+	releaseStgMedium  = modOle32.NewProc("ReleaseStgMedium")
+	dataObjectVtbl    *win.IDataObjectVtbl
+	enumFormatEtcVtbl *iEnumFORMATETCVtbl
+	dropSourceVtbl    *iDropSourceVtbl
+	dropTargetVtbl    *iDropTargetVtbl
+	liveCOMObjects    = make(map[unsafe.Pointer]bool)
+)
+
+func init() {
+	AppendToWalkInit(func() {
+		dataObjectVtbl = &win.IDataObjectVtbl{
+			IUnknownVtbl: win.IUnknownVtbl{
+				QueryInterface: syscall.NewCallback(dataObject_QueryInterface),
+				AddRef:         syscall.NewCallback(dataObject_AddRef),
+				Release:        syscall.NewCallback(dataObject_Release),
+			},
+			GetData:               syscall.NewCallback(dataObject_GetData),
+			GetDataHere:           syscall.NewCallback(dataObject_GetDataHere),
+			QueryGetData:          syscall.NewCallback(dataObject_QueryGetData),
+			GetCanonicalFormatEtc: syscall.NewCallback(dataObject_GetCanonicalFormatEtc),
+			SetData:               syscall.NewCallback(dataObject_SetData),
+			EnumFormatEtc:         syscall.NewCallback(dataObject_EnumFormatEtc),
+			DAdvise:               syscall.NewCallback(dataObject_DAdvise),
+			DUnadvise:             syscall.NewCallback(dataObject_DUnadvise),
+			EnumDAdvise:           syscall.NewCallback(dataObject_EnumDAdvise),
+		}
+
+		enumFormatEtcVtbl = &iEnumFORMATETCVtbl{
+			QueryInterface: syscall.NewCallback(enumFormatEtc_QueryInterface),
+			AddRef:         syscall.NewCallback(enumFormatEtc_AddRef),
+			Release:        syscall.NewCallback(enumFormatEtc_Release),
+			Next:           syscall.NewCallback(enumFormatEtc_Next),
+			Skip:           syscall.NewCallback(enumFormatEtc_Skip),
+			Reset:          syscall.NewCallback(enumFormatEtc_Reset),
+			Clone:          syscall.NewCallback(enumFormatEtc_Clone),
+		}
+
+		dropSourceVtbl = &iDropSourceVtbl{
+			QueryInterface:    syscall.NewCallback(dropSource_QueryInterface),
+			AddRef:            syscall.NewCallback(dropSource_AddRef),
+			Release:           syscall.NewCallback(dropSource_Release),
+			QueryContinueDrag: syscall.NewCallback(dropSource_QueryContinueDrag),
+			GiveFeedback:      syscall.NewCallback(dropSource_GiveFeedback),
+		}
+
+		dropTargetVtbl = &iDropTargetVtbl{
+			QueryInterface: syscall.NewCallback(dropTarget_QueryInterface),
+			AddRef:         syscall.NewCallback(dropTarget_AddRef),
+			Release:        syscall.NewCallback(dropTarget_Release),
+			DragEnter:      syscall.NewCallback(dropTarget_DragEnter),
+			DragOver:       syscall.NewCallback(dropTarget_DragOver),
+			DragLeave:      syscall.NewCallback(dropTarget_DragLeave),
+			Drop:           syscall.NewCallback(dropTarget_Drop),
+		}
+	})
+}
+
+// oleInitialize initializes OLE for the calling thread, which drag and drop
+// needs. Like in NewWebView, it is never uninitialized. Errors are not
+// processed yet.
+func oleInitialize() error {
+	if hr := win.OleInitialize(); hr != win.S_OK && hr != win.S_FALSE {
+		return newErr(fmt.Sprintf("OleInitialize: Error %d", hr))
+	}
+
+	return nil
+}
+
+type formatEtc struct {
+	cfFormat uint16
+	ptd      uintptr
+	dwAspect uint32
+	lindex   int32
+	tymed    uint32
+}
+
+type stgMedium struct {
+	tymed          uint32
+	hGlobal        win.HGLOBAL
+	pUnkForRelease uintptr
+}
+
+// comRef counts the references to a COM object implemented in Go. While there
+// are any, the object is kept alive by liveCOMObjects.
+type comRef struct {
+	refs uint32
+}
+
+func (r *comRef) addRef(obj unsafe.Pointer) uintptr {
+	if r.refs == 0 {
+		liveCOMObjects[obj] = true
+	}
+	r.refs++
+
+	return uintptr(r.refs)
+}
+
+func (r *comRef) release(obj unsafe.Pointer) uintptr {
+	r.refs--
+	if r.refs == 0 {
+		delete(liveCOMObjects, obj)
+	}
+
+	return uintptr(r.refs)
+}
+
+// dataObject is an IDataObject, that provides clipboardItems as HGLOBALs.
+type dataObject struct {
+	vtbl  *win.IDataObjectVtbl
+	ref   comRef
+	items []clipboardItem
+}
+
+func newDataObject(items []clipboardItem) *dataObject {
+	obj := &dataObject{vtbl: dataObjectVtbl, items: items}
+	obj.ref.addRef(unsafe.Pointer(obj))
+
+	return obj
+}
+
+func (obj *dataObject) item(fe *formatEtc) (clipboardItem, uintptr) {
+	if fe.dwAspect != dvaspectContent {
+		return clipboardItem{}, dvEFormatEtc
+	}
+
+	for _, item := range obj.items {
+		if uint32(fe.cfFormat) == item.format {
+			if fe.tymed&tymedHGlobal == 0 {
+				return clipboardItem{}, dvEFormatEtc
+			}
+
+			return item, win.S_OK
+		}
+	}
+
+	return clipboardItem{}, dvEFormatEtc
+}
+
+func dataObject_QueryInterface(obj *dataObject, riid win.REFIID, ppvObject *unsafe.Pointer) uintptr {
+	if win.EqualREFIID(riid, &win.IID_IUnknown) || win.EqualREFIID(riid, &iidIDataObject) {
+		obj.ref.addRef(unsafe.Pointer(obj))
+		*ppvObject = unsafe.Pointer(obj)
+
+		return win.S_OK
+	}
+
+	*ppvObject = nil
+
+	return win.E_NOINTERFACE
+}
+
+func dataObject_AddRef(obj *dataObject) uintptr {
+	return obj.ref.addRef(unsafe.Pointer(obj))
+}
+
+func dataObject_Release(obj *dataObject) uintptr {
+	return obj.ref.release(unsafe.Pointer(obj))
+}
+
+func dataObject_GetData(obj *dataObject, fe *formatEtc, medium *stgMedium) uintptr {
+	item, hr := obj.item(fe)
+	if hr != win.S_OK {
+		return hr
+	}
+
+	hMem, err := globalAllocData(item.data)
+	if err != nil {
+		return eOutOfMemory
+	}
+
+	*medium = stgMedium{tymed: tymedHGlobal, hGlobal: hMem}
+
+	return win.S_OK
+}
+
+func dataObject_GetDataHere(obj *dataObject, fe *formatEtc, medium *stgMedium) uintptr {
+	return win.E_NOTIMPL
+}
+
+func dataObject_QueryGetData(obj *dataObject, fe *formatEtc) uintptr {
+	_, hr := obj.item(fe)
+
+	return hr
+}
+
+func dataObject_GetCanonicalFormatEtc(obj *dataObject, feIn, feOut *formatEtc) uintptr {
+	feOut.ptd = 0
+
+	return win.E_NOTIMPL
+}
+
+func dataObject_SetData(obj *dataObject, fe *formatEtc, medium *stgMedium, release win.BOOL) uintptr {
+	return win.E_NOTIMPL
+}
+
+func dataObject_EnumFormatEtc(obj *dataObject, direction uint32, ppEnum *unsafe.Pointer) uintptr {
+	if direction != datadirGet {
+		*ppEnum = nil
+		return win.E_NOTIMPL
+	}
+
+	e := &enumFormatEtc{vtbl: enumFormatEtcVtbl, items: obj.items}
+	e.ref.addRef(unsafe.Pointer(e))
+	*ppEnum = unsafe.Pointer(e)
+
+	return win.S_OK
+}
+
+func dataObject_DAdvise(obj *dataObject, fe *formatEtc, advf uint32, sink, connection uintptr) uintptr {
+	return oleEAdviseNotSupported
+}
+
+func dataObject_DUnadvise(obj *dataObject, connection uint32) uintptr {
+	return oleEAdviseNotSupported
+}
+
+func dataObject_EnumDAdvise(obj *dataObject, ppEnum *unsafe.Pointer) uintptr {
+	return oleEAdviseNotSupported
+}
+
+type iEnumFORMATETCVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+	Next           uintptr
+	Skip           uintptr
+	Reset          uintptr
+	Clone          uintptr
+}
+
+// enumFormatEtc is the IEnumFORMATETC of a dataObject.
+type enumFormatEtc struct {
+	vtbl  *iEnumFORMATETCVtbl
+	ref   comRef
+	items []clipboardItem
+	next  int
+}
+
+func enumFormatEtc_QueryInterface(e *enumFormatEtc, riid win.REFIID, ppvObject *unsafe.Pointer) uintptr {
+	if win.EqualREFIID(riid, &win.IID_IUnknown) || win.EqualREFIID(riid, &iidIEnumFORMATETC) {
+		e.ref.addRef(unsafe.Pointer(e))
+		*ppvObject = unsafe.Pointer(e)
+
+		return win.S_OK
+	}
+
+	*ppvObject = nil
+
+	return win.E_NOINTERFACE
+}
+
+func enumFormatEtc_AddRef(e *enumFormatEtc) uintptr {
+	return e.ref.addRef(unsafe.Pointer(e))
+}
+
+func enumFormatEtc_Release(e *enumFormatEtc) uintptr {
+	return e.ref.release(unsafe.Pointer(e))
+}
+
+func enumFormatEtc_Next(e *enumFormatEtc, celt uint32, rgelt *formatEtc, pceltFetched *uint32) uintptr {
+	fes := unsafe.Slice(rgelt, celt)
+
+	var fetched uint32
+	for ; fetched < celt && e.next < len(e.items); fetched++ {
+		fes[fetched] = formatEtc{
+			cfFormat: uint16(e.items[e.next].format),
+			dwAspect: dvaspectContent,
+			lindex:   -1,
+			tymed:    tymedHGlobal,
+		}
+		e.next++
+	}
+
+	if pceltFetched != nil {
+		*pceltFetched = fetched
+	}
+
+	if fetched < celt {
+		return win.S_FALSE
+	}
+
+	return win.S_OK
+}
+
+func enumFormatEtc_Skip(e *enumFormatEtc, celt uint32) uintptr {
+	e.next += int(celt)
+	if e.next > len(e.items) {
+		e.next = len(e.items)
+		return win.S_FALSE
+	}
+
+	return win.S_OK
+}
+
+func enumFormatEtc_Reset(e *enumFormatEtc) uintptr {
+	e.next = 0
+
+	return win.S_OK
+}
+
+func enumFormatEtc_Clone(e *enumFormatEtc, ppEnum *unsafe.Pointer) uintptr {
+	clone := &enumFormatEtc{vtbl: enumFormatEtcVtbl, items: e.items, next: e.next}
+	clone.ref.addRef(unsafe.Pointer(clone))
+	*ppEnum = unsafe.Pointer(clone)
+
+	return win.S_OK
+}
+
+type iDropSourceVtbl struct {
+	QueryInterface    uintptr
+	AddRef            uintptr
+	Release           uintptr
+	QueryContinueDrag uintptr
+	GiveFeedback      uintptr
+}
+
+// dropSource is the IDropSource passed to DoDragDrop. The drag ends, when the
+// mouse button it started with is released.
+type dropSource struct {
+	vtbl    *iDropSourceVtbl
+	ref     comRef
+	buttons uint32
+}
+
+func dropSource_QueryInterface(src *dropSource, riid win.REFIID, ppvObject *unsafe.Pointer) uintptr {
+	if win.EqualREFIID(riid, &win.IID_IUnknown) || win.EqualREFIID(riid, &iidIDropSource) {
+		src.ref.addRef(unsafe.Pointer(src))
+		*ppvObject = unsafe.Pointer(src)
+
+		return win.S_OK
+	}
+
+	*ppvObject = nil
+
+	return win.E_NOINTERFACE
+}
+
+func dropSource_AddRef(src *dropSource) uintptr {
+	return src.ref.addRef(unsafe.Pointer(src))
+}
+
+func dropSource_Release(src *dropSource) uintptr {
+	return src.ref.release(unsafe.Pointer(src))
+}
+
+func dropSource_QueryContinueDrag(src *dropSource, escapePressed win.BOOL, keyState uint32) uintptr {
+	if escapePressed != 0 {
+		return dragdropSCancel
+	}
+
+	if keyState&src.buttons == 0 {
+		return dragdropSDrop
+	}
+
+	return win.S_OK
+}
+
+func dropSource_GiveFeedback(src *dropSource, effect uint32) uintptr {
+	return dragdropSUseDefaultCursors
+}
+
+type iDropTargetVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+	DragEnter      uintptr
+	DragOver       uintptr
+	DragLeave      uintptr
+	Drop           uintptr
+}
+
+func dropTarget_QueryInterface(dt *dropTarget, riid win.REFIID, ppvObject *unsafe.Pointer) uintptr {
+	if win.EqualREFIID(riid, &win.IID_IUnknown) || win.EqualREFIID(riid, &iidIDropTarget) {
+		dt.ref.addRef(unsafe.Pointer(dt))
+		*ppvObject = unsafe.Pointer(dt)
+
+		return win.S_OK
+	}
+
+	*ppvObject = nil
+
+	return win.E_NOINTERFACE
+}
+
+func dropTarget_AddRef(dt *dropTarget) uintptr {
+	return dt.ref.addRef(unsafe.Pointer(dt))
+}
+
+func dropTarget_Release(dt *dropTarget) uintptr {
+	return dt.ref.release(unsafe.Pointer(dt))
+}
+
+func dropTarget_DragLeave(dt *dropTarget) uintptr {
+	dt.dragLeave()
+
+	return win.S_OK
+}
+
+// unknownAddRef and unknownRelease count references to COM objects not
+// implemented in Go.
+func unknownAddRef(obj unsafe.Pointer) {
+	vtbl := *(**win.IUnknownVtbl)(obj)
+	syscall.SyscallN(vtbl.AddRef, uintptr(obj))
+}
+
+func unknownRelease(obj unsafe.Pointer) {
+	vtbl := *(**win.IUnknownVtbl)(obj)
+	syscall.SyscallN(vtbl.Release, uintptr(obj))
+}
+
+// dataObjectContains returns whether obj provides data in format as an
+// HGLOBAL.
+func dataObjectContains(obj *win.IDataObject, format uint32) bool {
+	fe := formatEtc{cfFormat: uint16(format), dwAspect: dvaspectContent, lindex: -1, tymed: tymedHGlobal}
+
+	hr, _, _ := syscall.SyscallN(obj.LpVtbl.QueryGetData, uintptr(unsafe.Pointer(obj)), uintptr(unsafe.Pointer(&fe)))
+
+	return hr == win.S_OK
+}
+
+// dataObjectData returns a copy of the data in format, that obj provides.
+func dataObjectData(obj *win.IDataObject, format uint32) ([]byte, error) {
+	fe := formatEtc{cfFormat: uint16(format), dwAspect: dvaspectContent, lindex: -1, tymed: tymedHGlobal}
+	var medium stgMedium
+
+	hr, _, _ := syscall.SyscallN(obj.LpVtbl.GetData, uintptr(unsafe.Pointer(obj)), uintptr(unsafe.Pointer(&fe)), uintptr(unsafe.Pointer(&medium)))
+	if win.FAILED(win.HRESULT(hr)) {
+		return nil, errorFromHRESULT("IDataObject.GetData", win.HRESULT(hr))
+	}
+	defer releaseStgMedium.Call(uintptr(unsafe.Pointer(&medium)))
+
+	if medium.tymed != tymedHGlobal {
+		return nil, newError("IDataObject.GetData: no HGLOBAL")
+	}
+
+	return globalData(medium.hGlobal)
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows && 386
+// +build windows,386
+
+package walk
+
+import (
+	"github.com/wuc656/win"
+)
+
+// The POINTL arguments of IDropTarget are passed by value on the stack, taking
+// two arguments.
+
+func dropTarget_DragEnter(dt *dropTarget, obj *win.IDataObject, keyState uint32, x, y int32, effect *uint32) uintptr {
+	return dt.dragEnter(obj, keyState, win.POINT{X: x, Y: y}, effect)
+}
+
+func dropTarget_DragOver(dt *dropTarget, keyState uint32, x, y int32, effect *uint32) uintptr {
+	return dt.dragOver(keyState, win.POINT{X: x, Y: y}, effect)
+}
+
+func dropTarget_Drop(dt *dropTarget, obj *win.IDataObject, keyState uint32, x, y int32, effect *uint32) uintptr {
+	return dt.drop(obj, keyState, win.POINT{X: x, Y: y}, effect)
+}
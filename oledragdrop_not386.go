@@ -0,0 +1,30 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows && !386
+// +build windows,!386
+
+package walk
+
+import (
+	"github.com/wuc656/win"
+)
+
+// The POINTL arguments of IDropTarget are passed by value in a single register.
+
+func dropTarget_DragEnter(dt *dropTarget, obj *win.IDataObject, keyState uint32, pt uintptr, effect *uint32) uintptr {
+	return dt.dragEnter(obj, keyState, pointFromPOINTL(pt), effect)
+}
+
+func dropTarget_DragOver(dt *dropTarget, keyState uint32, pt uintptr, effect *uint32) uintptr {
+	return dt.dragOver(keyState, pointFromPOINTL(pt), effect)
+}
+
+func dropTarget_Drop(dt *dropTarget, obj *win.IDataObject, keyState uint32, pt uintptr, effect *uint32) uintptr {
+	return dt.drop(obj, keyState, pointFromPOINTL(pt), effect)
+}
+
+func pointFromPOINTL(pt uintptr) win.POINT {
+	return win.POINT{X: int32(uint32(pt)), Y: int32(uint32(uint64(pt) >> 32))}
+}
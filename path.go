@@ -8,6 +8,7 @@
 package walk
 
 import (
+	"path/filepath"
 	"syscall"
 
 	"github.com/wuc656/win"
@@ -27,6 +28,17 @@ func AppDataPath() (string, error) {
 	return knownFolderPath(win.CSIDL_APPDATA)
 }
 
+// appSettingsFilePath returns the path of the settings file fileName of the
+// application, in the folder of its organization and product in AppDataPath.
+func appSettingsFilePath(fileName string) (string, error) {
+	appDataPath, err := AppDataPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(appDataPath, App().OrganizationName(), App().ProductName(), fileName), nil
+}
+
 func CommonAppDataPath() (string, error) {
 	return knownFolderPath(win.CSIDL_COMMON_APPDATA)
 }
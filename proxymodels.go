@@ -0,0 +1,580 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import "reflect"
+
+// tableModelProxy is implemented by models like FilterTableModel, that wrap
+// another TableModel.
+type tableModelProxy interface {
+	TableModel
+	SourceModel() TableModel
+}
+
+// listModelProxy is implemented by models like FilterListModel, that wrap
+// another ListModel.
+type listModelProxy interface {
+	ListModel
+	SourceModel() ListModel
+}
+
+// selfImplementingProxy is implemented by proxy models that implement some
+// optional interfaces themselves, like SortTableModel implements Sorter,
+// instead of passing the calls on to the model they wrap.
+type selfImplementingProxy interface {
+	implementsItself(iface reflect.Type) bool
+}
+
+// passedThrough returns model as T, if it implements T and, in case model is a
+// proxy model, if the model it wraps does so, too. Proxies implement
+// optional interfaces like ItemChecker unconditionally and pass calls through,
+// but widgets must only use them if the wrapped model supports them. Interfaces
+// a proxy implements itself, see selfImplementingProxy, are always used.
+func passedThrough[T any](model any) (T, bool) {
+	t, ok := model.(T)
+	if !ok {
+		return t, false
+	}
+
+	if p, ok := model.(selfImplementingProxy); ok && p.implementsItself(reflect.TypeFor[T]()) {
+		return t, true
+	}
+
+	var source any
+	switch p := model.(type) {
+	case tableModelProxy:
+		source = p.SourceModel()
+
+	case listModelProxy:
+		source = p.SourceModel()
+
+	default:
+		return t, true
+	}
+
+	if _, ok := passedThrough[T](source); !ok {
+		var zero T
+		return zero, false
+	}
+
+	return t, true
+}
+
+// tableProxyBase implements what FilterTableModel and SortTableModel have in
+// common.
+type tableProxyBase struct {
+	TableModelBase
+	source                    TableModel
+	rows                      rowMapper
+	rowsResetHandlerHandle    int
+	rowChangedHandlerHandle   int
+	rowsChangedHandlerHandle  int
+	rowsInsertedHandlerHandle int
+	rowsRemovedHandlerHandle  int
+}
+
+func (tpb *tableProxyBase) init(source TableModel, rows rowMapper) {
+	tpb.source = source
+	tpb.rows = rows
+
+	rows.reset(source.RowCount())
+
+	tpb.rowsResetHandlerHandle = source.RowsReset().Attach(tpb.reset)
+	tpb.rowChangedHandlerHandle = source.RowChanged().Attach(func(row int) {
+		tpb.publishRowEvents(tpb.rows.changed(row, row))
+	})
+	tpb.rowsChangedHandlerHandle = source.RowsChanged().Attach(func(from, to int) {
+		tpb.publishRowEvents(tpb.rows.changed(from, to))
+	})
+	tpb.rowsInsertedHandlerHandle = source.RowsInserted().Attach(func(from, to int) {
+		tpb.publishRowEvents(tpb.rows.inserted(from, to))
+	})
+	tpb.rowsRemovedHandlerHandle = source.RowsRemoved().Attach(func(from, to int) {
+		tpb.publishRowEvents(tpb.rows.removed(from, to))
+	})
+}
+
+// detach stops the proxy from following its source, for proxies that widgets
+// create internally and drop again.
+func (tpb *tableProxyBase) detach() {
+	tpb.source.RowsReset().Detach(tpb.rowsResetHandlerHandle)
+	tpb.source.RowChanged().Detach(tpb.rowChangedHandlerHandle)
+	tpb.source.RowsChanged().Detach(tpb.rowsChangedHandlerHandle)
+	tpb.source.RowsInserted().Detach(tpb.rowsInsertedHandlerHandle)
+	tpb.source.RowsRemoved().Detach(tpb.rowsRemovedHandlerHandle)
+}
+
+func (tpb *tableProxyBase) reset() {
+	tpb.rows.reset(tpb.source.RowCount())
+
+	tpb.PublishRowsReset()
+}
+
+// SourceModel returns the model the proxy wraps.
+func (tpb *tableProxyBase) SourceModel() TableModel {
+	return tpb.source
+}
+
+// MapToSource returns the row of the source model that is shown as row.
+func (tpb *tableProxyBase) MapToSource(row int) int {
+	return tpb.rows.toSource(row)
+}
+
+// MapFromSource returns the row the row of the source model is shown as, or -1
+// if it is not shown.
+func (tpb *tableProxyBase) MapFromSource(row int) int {
+	return tpb.rows.fromSource(row)
+}
+
+func (tpb *tableProxyBase) RowCount() int {
+	return tpb.rows.len()
+}
+
+func (tpb *tableProxyBase) Value(row, col int) any {
+	return tpb.source.Value(tpb.rows.toSource(row), col)
+}
+
+func (tpb *tableProxyBase) SetValue(row, col int, value any) error {
+	if em, ok := tpb.source.(EditableTableModel); ok {
+		return em.SetValue(tpb.rows.toSource(row), col, value)
+	}
+
+	return newError("source model does not implement EditableTableModel")
+}
+
+func (tpb *tableProxyBase) Checked(row int) bool {
+	if checker, ok := tpb.source.(ItemChecker); ok {
+		return checker.Checked(tpb.rows.toSource(row))
+	}
+
+	return false
+}
+
+func (tpb *tableProxyBase) SetChecked(row int, checked bool) error {
+	if checker, ok := tpb.source.(ItemChecker); ok {
+		return checker.SetChecked(tpb.rows.toSource(row), checked)
+	}
+
+	return nil
+}
+
+func (tpb *tableProxyBase) Image(row int) any {
+	if provider, ok := tpb.source.(ImageProvider); ok {
+		return provider.Image(tpb.rows.toSource(row))
+	}
+
+	return nil
+}
+
+func (tpb *tableProxyBase) StyleCell(style *CellStyle) {
+	if styler, ok := tpb.source.(CellStyler); ok {
+		row := style.row
+		style.row = tpb.rows.toSource(row)
+		defer func() {
+			style.row = row
+		}()
+
+		styler.StyleCell(style)
+	}
+}
+
+// proxySorter passes the calls of the Sorter and MultiSorter interfaces on to
+// the source of a proxy model.
+type proxySorter struct {
+	sorterSource             TableModel
+	sortChangedPublisher     EventPublisher
+	sortChangedHandlerHandle int
+}
+
+// initSorter makes ps pass calls on to source. sortChanged is called after the
+// source was sorted, before ps publishes its SortChanged event.
+func (ps *proxySorter) initSorter(source TableModel, sortChanged func()) {
+	ps.sorterSource = source
+
+	if sorter, ok := source.(Sorter); ok {
+		ps.sortChangedHandlerHandle = sorter.SortChanged().Attach(func() {
+			sortChanged()
+
+			ps.sortChangedPublisher.Publish()
+		})
+	}
+}
+
+func (ps *proxySorter) detachSorter() {
+	if sorter, ok := ps.sorterSource.(Sorter); ok {
+		sorter.SortChanged().Detach(ps.sortChangedHandlerHandle)
+	}
+}
+
+func (ps *proxySorter) ColumnSortable(col int) bool {
+	if sorter, ok := ps.sorterSource.(Sorter); ok {
+		return sorter.ColumnSortable(col)
+	}
+
+	return false
+}
+
+func (ps *proxySorter) Sort(col int, order SortOrder) error {
+	if sorter, ok := ps.sorterSource.(Sorter); ok {
+		return sorter.Sort(col, order)
+	}
+
+	return nil
+}
+
+func (ps *proxySorter) SortByKeys(keys []SortKey) error {
+	if sorter, ok := ps.sorterSource.(Sorter); ok {
+		return sortByKeys(sorter, keys)
+	}
+
+	return nil
+}
+
+func (ps *proxySorter) SortKeys() []SortKey {
+	if sorter, ok := ps.sorterSource.(Sorter); ok {
+		return sortKeysOf(sorter)
+	}
+
+	return nil
+}
+
+func (ps *proxySorter) SortChanged() *Event {
+	return ps.sortChangedPublisher.Event()
+}
+
+func (ps *proxySorter) SortedColumn() int {
+	if sorter, ok := ps.sorterSource.(Sorter); ok {
+		return sorter.SortedColumn()
+	}
+
+	return -1
+}
+
+func (ps *proxySorter) SortOrder() SortOrder {
+	if sorter, ok := ps.sorterSource.(Sorter); ok {
+		return sorter.SortOrder()
+	}
+
+	return SortAscending
+}
+
+// FilterTableModel is a TableModel that shows the rows of another TableModel
+// that pass a filter. It translates the events of its source model, so
+// inserting, removing and changing rows of the source does not reset it.
+//
+// If the source model implements ItemChecker, ImageProvider, CellStyler,
+// EditableTableModel, Sorter or MultiSorter, so does FilterTableModel, as far as
+// widgets are concerned.
+type FilterTableModel struct {
+	tableProxyBase
+	proxySorter
+	filterRows filterRowMap
+}
+
+// NewFilterTableModel returns a FilterTableModel for source that shows all rows
+// until a filter is set.
+func NewFilterTableModel(source TableModel) *FilterTableModel {
+	m := new(FilterTableModel)
+
+	m.init(source, &m.filterRows)
+	m.initSorter(source, func() {
+		// Rows are compared by their index in the source, which sorting has
+		// changed.
+		m.filterRows.reset(source.RowCount())
+	})
+
+	return m
+}
+
+func (m *FilterTableModel) detach() {
+	m.tableProxyBase.detach()
+	m.detachSorter()
+}
+
+// SetFilter sets the function that decides which rows of the source model are
+// shown. It is called with indexes into the source model. A nil filter shows
+// all rows.
+//
+// SetFilter resets the model. Call Refilter, if the outcome of filter changes
+// for other reasons than changes of the source model.
+func (m *FilterTableModel) SetFilter(filter func(row int) bool) {
+	m.filterRows.accept = filter
+
+	m.reset()
+}
+
+// Refilter applies the filter again.
+func (m *FilterTableModel) Refilter() {
+	m.reset()
+}
+
+// SortTableModel is a TableModel that shows the rows of another TableModel
+// sorted by one or more columns, without modifying the source. Rows that
+// compare equal keep their order in the source. It translates the events of its
+// source model, so inserting, removing and changing rows of the source does not
+// reset it.
+//
+// If the source model implements ItemChecker, ImageProvider, CellStyler or
+// EditableTableModel, so does SortTableModel, as far as widgets are concerned.
+// If the source model implements Sorter, it is only asked which columns are
+// sortable. A TableView compares values with the CompareFunc of its columns.
+type SortTableModel struct {
+	tableProxyBase
+	SorterBase
+	sortRows     sortRowMap
+	compareFuncs []func(a, b any) int
+}
+
+// NewSortTableModel returns a SortTableModel for source that shows the rows in
+// source order until Sort is called.
+func NewSortTableModel(source TableModel) *SortTableModel {
+	m := new(SortTableModel)
+	m.col = -1
+
+	m.init(source, &m.sortRows)
+
+	return m
+}
+
+func (m *SortTableModel) implementsItself(iface reflect.Type) bool {
+	return iface == reflect.TypeFor[Sorter]() || iface == reflect.TypeFor[MultiSorter]()
+}
+
+func (m *SortTableModel) ColumnSortable(col int) bool {
+	if sorter, ok := m.source.(Sorter); ok {
+		return sorter.ColumnSortable(col)
+	}
+
+	return true
+}
+
+func (m *SortTableModel) Sort(col int, order SortOrder) error {
+	return m.SortByKeys(sortKeysFor(col, order))
+}
+
+func (m *SortTableModel) SortByKeys(keys []SortKey) error {
+	m.setSortKeys(keys)
+
+	if len(keys) == 0 {
+		m.sortRows.less = nil
+	} else {
+		keys := m.keys
+		m.sortRows.less = func(a, b int) bool {
+			return lessByKeys(keys, a, b, m.compare)
+		}
+	}
+
+	m.sortRows.reset(m.source.RowCount())
+
+	m.changedPublisher.Publish()
+
+	return nil
+}
+
+func (m *SortTableModel) setCompareFuncs(compareFuncs []func(a, b any) int) {
+	m.compareFuncs = compareFuncs
+}
+
+func (m *SortTableModel) compare(col, a, b int) int {
+	return compareValuesWith(m.compareFuncs, col, m.source.Value(a, col), m.source.Value(b, col))
+}
+
+// groupTableModel shows the rows of a GroupingTableModel in groups, each below
+// a header row. A TableView creates one for grouping, its source may be a
+// FilterTableModel wrapping the GroupingTableModel.
+type groupTableModel struct {
+	tableProxyBase
+	proxySorter
+	groupRows groupRowMap
+}
+
+// newGroupTableModel returns a groupTableModel for source. key returns the
+// group key of a row of source, collapsed whether the group with a key is
+// collapsed.
+func newGroupTableModel(source TableModel, key func(row int) any, collapsed func(key any) bool) *groupTableModel {
+	m := new(groupTableModel)
+	m.groupRows.key = key
+	m.groupRows.collapsed = collapsed
+
+	m.init(source, &m.groupRows)
+	m.initSorter(source, func() {
+		m.groupRows.reset(source.RowCount())
+	})
+
+	return m
+}
+
+func (m *groupTableModel) detach() {
+	m.tableProxyBase.detach()
+	m.detachSorter()
+}
+
+// header returns the group, whose header is at row.
+func (m *groupTableModel) header(row int) (*rowGroup, bool) {
+	if row < 0 || row >= m.groupRows.len() {
+		return nil, false
+	}
+
+	g, header := m.groupRows.groupAt(row)
+	if !header {
+		return nil, false
+	}
+
+	return &m.groupRows.groups[g], true
+}
+
+func (m *groupTableModel) Value(row, col int) any {
+	if _, ok := m.header(row); ok {
+		return nil
+	}
+
+	return m.tableProxyBase.Value(row, col)
+}
+
+func (m *groupTableModel) SetValue(row, col int, value any) error {
+	if _, ok := m.header(row); ok {
+		return newError("row is a group header")
+	}
+
+	return m.tableProxyBase.SetValue(row, col, value)
+}
+
+func (m *groupTableModel) Checked(row int) bool {
+	if _, ok := m.header(row); ok {
+		return false
+	}
+
+	return m.tableProxyBase.Checked(row)
+}
+
+func (m *groupTableModel) SetChecked(row int, checked bool) error {
+	if _, ok := m.header(row); ok {
+		return nil
+	}
+
+	return m.tableProxyBase.SetChecked(row, checked)
+}
+
+func (m *groupTableModel) Image(row int) any {
+	if _, ok := m.header(row); ok {
+		return nil
+	}
+
+	return m.tableProxyBase.Image(row)
+}
+
+func (m *groupTableModel) StyleCell(style *CellStyle) {
+	if _, ok := m.header(style.row); ok {
+		return
+	}
+
+	m.tableProxyBase.StyleCell(style)
+}
+
+// FilterListModel is a ListModel that shows the items of another ListModel
+// that pass a filter. It translates the events of its source model like
+// FilterTableModel does.
+//
+// If the source model implements BindingValueProvider, so does
+// FilterListModel, as far as widgets are concerned.
+type FilterListModel struct {
+	ListModelBase
+	source ListModel
+	rows   filterRowMap
+}
+
+// NewFilterListModel returns a FilterListModel for source that shows all items
+// until a filter is set.
+func NewFilterListModel(source ListModel) *FilterListModel {
+	m := &FilterListModel{source: source}
+
+	m.rows.reset(source.ItemCount())
+
+	source.ItemsReset().Attach(m.reset)
+	source.ItemChanged().Attach(func(index int) {
+		m.publish(m.rows.changed(index, index))
+	})
+	source.ItemsInserted().Attach(func(from, to int) {
+		m.publish(m.rows.inserted(from, to))
+	})
+	source.ItemsRemoved().Attach(func(from, to int) {
+		m.publish(m.rows.removed(from, to))
+	})
+
+	return m
+}
+
+func (m *FilterListModel) reset() {
+	m.rows.reset(m.source.ItemCount())
+
+	m.PublishItemsReset()
+}
+
+func (m *FilterListModel) publish(events []rowEvent) {
+	for _, e := range events {
+		switch e.kind {
+		case rowsInserted:
+			m.PublishItemsInserted(e.from, e.to)
+
+		case rowsRemoved:
+			m.PublishItemsRemoved(e.from, e.to)
+
+		case rowsChanged:
+			for i := e.from; i <= e.to; i++ {
+				m.PublishItemChanged(i)
+			}
+		}
+	}
+}
+
+// SetFilter sets the function that decides which items of the source model are
+// shown. It is called with indexes into the source model. A nil filter shows
+// all items.
+func (m *FilterListModel) SetFilter(filter func(index int) bool) {
+	m.rows.accept = filter
+
+	m.reset()
+}
+
+// Refilter applies the filter again.
+func (m *FilterListModel) Refilter() {
+	m.reset()
+}
+
+// SourceModel returns the model m wraps.
+func (m *FilterListModel) SourceModel() ListModel {
+	return m.source
+}
+
+// MapToSource returns the index of the item of the source model that is shown
+// at index.
+func (m *FilterListModel) MapToSource(index int) int {
+	return m.rows.toSource(index)
+}
+
+// MapFromSource returns the index the item of the source model is shown at, or
+// -1 if it is not shown.
+func (m *FilterListModel) MapFromSource(index int) int {
+	return m.rows.fromSource(index)
+}
+
+func (m *FilterListModel) ItemCount() int {
+	return m.rows.len()
+}
+
+func (m *FilterListModel) Value(index int) any {
+	return m.source.Value(m.rows.toSource(index))
+}
+
+func (m *FilterListModel) BindingValue(index int) any {
+	if bvp, ok := m.source.(BindingValueProvider); ok {
+		return bvp.BindingValue(m.rows.toSource(index))
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import "testing"
+
+type plainTableModel struct {
+	TableModelBase
+	values []string
+}
+
+func (m *plainTableModel) RowCount() int {
+	return len(m.values)
+}
+
+func (m *plainTableModel) Value(row, col int) any {
+	return m.values[row]
+}
+
+func TestPassedThroughSortTableModel(t *testing.T) {
+	plain := &plainTableModel{values: []string{"b", "a"}}
+
+	if _, ok := passedThrough[Sorter](NewSortTableModel(plain)); !ok {
+		t.Error("SortTableModel of a plain model is not a Sorter")
+	}
+	if _, ok := passedThrough[MultiSorter](NewSortTableModel(plain)); !ok {
+		t.Error("SortTableModel of a plain model is not a MultiSorter")
+	}
+
+	if _, ok := passedThrough[ItemChecker](NewSortTableModel(plain)); ok {
+		t.Error("SortTableModel of a plain model is an ItemChecker")
+	}
+
+	if _, ok := passedThrough[Sorter](NewFilterTableModel(plain)); ok {
+		t.Error("FilterTableModel of a plain model is a Sorter")
+	}
+}
@@ -0,0 +1,360 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"slices"
+	"sort"
+)
+
+// rowMapper maps the rows of a proxy model, like FilterTableModel, to those of
+// its source model and translates changes of the source into changes of the
+// proxy.
+type rowMapper interface {
+	reset(count int)
+	len() int
+	toSource(row int) int
+	fromSource(row int) int // -1 if the source row is not in the proxy
+
+	// inserted, removed and changed update the map after rows from to to of
+	// the source were inserted, removed or changed. They return the events the
+	// proxy must publish, in order.
+	inserted(from, to int) []rowEvent
+	removed(from, to int) []rowEvent
+	changed(from, to int) []rowEvent
+}
+
+type rowEventKind int
+
+const (
+	rowsInserted rowEventKind = iota
+	rowsRemoved
+	rowsChanged
+	rowsReset // from and to are unused
+)
+
+// rowEvent is a change of the rows from to to of a proxy model.
+type rowEvent struct {
+	kind     rowEventKind
+	from, to int
+}
+
+// appendRowEvent appends e to events, merging it into the last event if that
+// describes the same kind of change of an adjacent range.
+func appendRowEvent(events []rowEvent, e rowEvent) []rowEvent {
+	if n := len(events); n > 0 {
+		last := &events[n-1]
+
+		if last.kind == e.kind {
+			switch e.kind {
+			case rowsInserted:
+				// Inserting right after or right before the rows inserted last.
+				if e.from == last.to+1 || e.from == last.from {
+					last.to += e.to - e.from + 1
+					return events
+				}
+
+			case rowsRemoved:
+				// Removing what followed or what preceded the rows removed last.
+				if e.from == last.from {
+					last.to += e.to - e.from + 1
+					return events
+				}
+				if e.to+1 == last.from {
+					last.from = e.from
+					return events
+				}
+
+			case rowsChanged:
+				if e.from == last.to+1 {
+					last.to = e.to
+					return events
+				}
+			}
+		}
+	}
+
+	return append(events, e)
+}
+
+// diffRows returns the events that turn the rows identified by oldIDs into the
+// rows identified by newIDs. changed reports whether the row at index j in
+// newIDs, that is identified in oldIDs too, changed.
+//
+// Rows identified in both must keep their order, otherwise diffRows returns a
+// single rowsReset event.
+func diffRows[T comparable](oldIDs, newIDs []T, changed func(j int) bool) []rowEvent {
+	newIndexes := make(map[T]int, len(newIDs))
+	for j, id := range newIDs {
+		newIndexes[id] = j
+	}
+
+	kept := make(map[T]bool, len(oldIDs))
+	prev := -1
+	for _, id := range oldIDs {
+		j, ok := newIndexes[id]
+		if !ok {
+			continue
+		}
+		if j < prev {
+			return []rowEvent{{kind: rowsReset}}
+		}
+		prev = j
+
+		kept[id] = true
+	}
+
+	var events []rowEvent
+
+	for i := len(oldIDs) - 1; i >= 0; i-- {
+		if !kept[oldIDs[i]] {
+			events = appendRowEvent(events, rowEvent{kind: rowsRemoved, from: i, to: i})
+		}
+	}
+
+	for j, id := range newIDs {
+		if !kept[id] {
+			events = appendRowEvent(events, rowEvent{kind: rowsInserted, from: j, to: j})
+		}
+	}
+
+	if changed == nil {
+		return events
+	}
+
+	for j, id := range newIDs {
+		if kept[id] && changed(j) {
+			events = appendRowEvent(events, rowEvent{kind: rowsChanged, from: j, to: j})
+		}
+	}
+
+	return events
+}
+
+// filterRowMap is the rowMapper of a filtering proxy.
+type filterRowMap struct {
+	accept func(row int) bool // nil accepts all rows
+	rows   []int              // the source rows that pass, ascending
+}
+
+func (m *filterRowMap) accepts(row int) bool {
+	return m.accept == nil || m.accept(row)
+}
+
+func (m *filterRowMap) reset(count int) {
+	m.rows = m.rows[:0]
+
+	for row := 0; row < count; row++ {
+		if m.accepts(row) {
+			m.rows = append(m.rows, row)
+		}
+	}
+}
+
+func (m *filterRowMap) len() int {
+	return len(m.rows)
+}
+
+func (m *filterRowMap) toSource(row int) int {
+	return m.rows[row]
+}
+
+func (m *filterRowMap) fromSource(row int) int {
+	if i, ok := slices.BinarySearch(m.rows, row); ok {
+		return i
+	}
+
+	return -1
+}
+
+func (m *filterRowMap) inserted(from, to int) []rowEvent {
+	n := to - from + 1
+
+	i, _ := slices.BinarySearch(m.rows, from)
+	for j := i; j < len(m.rows); j++ {
+		m.rows[j] += n
+	}
+
+	var added []int
+	for row := from; row <= to; row++ {
+		if m.accepts(row) {
+			added = append(added, row)
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	m.rows = slices.Insert(m.rows, i, added...)
+
+	return []rowEvent{{kind: rowsInserted, from: i, to: i + len(added) - 1}}
+}
+
+func (m *filterRowMap) removed(from, to int) []rowEvent {
+	n := to - from + 1
+
+	i, _ := slices.BinarySearch(m.rows, from)
+	j, _ := slices.BinarySearch(m.rows, to+1)
+
+	m.rows = slices.Delete(m.rows, i, j)
+	for k := i; k < len(m.rows); k++ {
+		m.rows[k] -= n
+	}
+
+	if i == j {
+		return nil
+	}
+
+	return []rowEvent{{kind: rowsRemoved, from: i, to: j - 1}}
+}
+
+func (m *filterRowMap) changed(from, to int) []rowEvent {
+	var events []rowEvent
+
+	for row := from; row <= to; row++ {
+		i, found := slices.BinarySearch(m.rows, row)
+
+		switch accepted := m.accepts(row); {
+		case found && accepted:
+			events = appendRowEvent(events, rowEvent{kind: rowsChanged, from: i, to: i})
+
+		case found:
+			m.rows = slices.Delete(m.rows, i, i+1)
+			events = appendRowEvent(events, rowEvent{kind: rowsRemoved, from: i, to: i})
+
+		case accepted:
+			m.rows = slices.Insert(m.rows, i, row)
+			events = appendRowEvent(events, rowEvent{kind: rowsInserted, from: i, to: i})
+		}
+	}
+
+	return events
+}
+
+// sortRowMap is the rowMapper of a sorting proxy. Rows that are equal
+// according to less keep their order in the source.
+type sortRowMap struct {
+	less func(a, b int) bool // compares source rows, nil keeps the source order
+	rows []int               // the source row of each row
+}
+
+func (m *sortRowMap) before(a, b int) bool {
+	if m.less != nil {
+		if m.less(a, b) {
+			return true
+		}
+		if m.less(b, a) {
+			return false
+		}
+	}
+
+	return a < b
+}
+
+func (m *sortRowMap) reset(count int) {
+	m.rows = m.rows[:0]
+	for row := 0; row < count; row++ {
+		m.rows = append(m.rows, row)
+	}
+
+	if m.less != nil {
+		sort.Slice(m.rows, func(i, j int) bool {
+			return m.before(m.rows[i], m.rows[j])
+		})
+	}
+}
+
+func (m *sortRowMap) len() int {
+	return len(m.rows)
+}
+
+func (m *sortRowMap) toSource(row int) int {
+	return m.rows[row]
+}
+
+func (m *sortRowMap) fromSource(row int) int {
+	return slices.Index(m.rows, row)
+}
+
+// search returns where source row belongs.
+func (m *sortRowMap) search(row int) int {
+	return sort.Search(len(m.rows), func(i int) bool {
+		return m.before(row, m.rows[i])
+	})
+}
+
+func (m *sortRowMap) inserted(from, to int) []rowEvent {
+	n := to - from + 1
+
+	for i, row := range m.rows {
+		if row >= from {
+			m.rows[i] = row + n
+		}
+	}
+
+	var events []rowEvent
+	for row := from; row <= to; row++ {
+		i := m.search(row)
+		m.rows = slices.Insert(m.rows, i, row)
+		events = appendRowEvent(events, rowEvent{kind: rowsInserted, from: i, to: i})
+	}
+
+	return events
+}
+
+// take removes source rows from to to from the map.
+func (m *sortRowMap) take(from, to int) []rowEvent {
+	var events []rowEvent
+	for i := len(m.rows) - 1; i >= 0; i-- {
+		if row := m.rows[i]; row >= from && row <= to {
+			m.rows = slices.Delete(m.rows, i, i+1)
+			events = appendRowEvent(events, rowEvent{kind: rowsRemoved, from: i, to: i})
+		}
+	}
+
+	return events
+}
+
+func (m *sortRowMap) removed(from, to int) []rowEvent {
+	n := to - from + 1
+
+	events := m.take(from, to)
+
+	for i, row := range m.rows {
+		if row > to {
+			m.rows[i] = row - n
+		}
+	}
+
+	return events
+}
+
+func (m *sortRowMap) changed(from, to int) []rowEvent {
+	if from == to {
+		i := m.fromSource(from)
+		m.rows = slices.Delete(m.rows, i, i+1)
+
+		j := m.search(from)
+		m.rows = slices.Insert(m.rows, j, from)
+
+		if i == j {
+			return []rowEvent{{kind: rowsChanged, from: i, to: i}}
+		}
+
+		return []rowEvent{{kind: rowsRemoved, from: i, to: i}, {kind: rowsInserted, from: j, to: j}}
+	}
+
+	// The other changed rows may be out of order, so searching only works
+	// once they are all out of the way.
+	events := m.take(from, to)
+
+	for row := from; row <= to; row++ {
+		i := m.search(row)
+		m.rows = slices.Insert(m.rows, i, row)
+		events = appendRowEvent(events, rowEvent{kind: rowsInserted, from: i, to: i})
+	}
+
+	return events
+}
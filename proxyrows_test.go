@@ -0,0 +1,197 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type proxyRowsTestItem struct {
+	id, value int
+}
+
+// checkRowMapper applies random changes to a source and checks that the events
+// returned by m turn the rows of the proxy before into those after.
+func checkRowMapper(t *testing.T, m rowMapper, source *[]proxyRowsTestItem, rebuilt func() rowMapper) {
+	t.Helper()
+
+	const placeholder = -1
+
+	rnd := rand.New(rand.NewSource(1))
+	nextID := len(*source)
+
+	ids := func(m rowMapper) []int {
+		ids := make([]int, m.len())
+		for i := range ids {
+			ids[i] = (*source)[m.toSource(i)].id
+		}
+		return ids
+	}
+
+	m.reset(len(*source))
+	proxy := ids(m)
+
+	for step := 0; step < 500; step++ {
+		var events []rowEvent
+
+		switch op := rnd.Intn(3); {
+		case op == 0 || len(*source) == 0:
+			from := rnd.Intn(len(*source) + 1)
+			n := 1 + rnd.Intn(3)
+
+			var items []proxyRowsTestItem
+			for i := 0; i < n; i++ {
+				items = append(items, proxyRowsTestItem{id: nextID, value: rnd.Intn(10)})
+				nextID++
+			}
+			*source = slices.Insert(*source, from, items...)
+
+			events = m.inserted(from, from+n-1)
+
+		case op == 1:
+			from := rnd.Intn(len(*source))
+			to := min(from+rnd.Intn(3), len(*source)-1)
+			*source = slices.Delete(*source, from, to+1)
+
+			events = m.removed(from, to)
+
+		default:
+			from := rnd.Intn(len(*source))
+			to := min(from+rnd.Intn(3), len(*source)-1)
+			for i := from; i <= to; i++ {
+				(*source)[i].value = rnd.Intn(10)
+			}
+
+			events = m.changed(from, to)
+		}
+
+		for _, e := range events {
+			if e.from < 0 || e.to < e.from {
+				t.Fatalf("step %d: bad event %+v", step, e)
+			}
+
+			n := e.to - e.from + 1
+
+			switch e.kind {
+			case rowsInserted:
+				if e.from > len(proxy) {
+					t.Fatalf("step %d: insert %+v beyond %d rows", step, e, len(proxy))
+				}
+				proxy = slices.Insert(proxy, e.from, slices.Repeat([]int{placeholder}, n)...)
+
+			case rowsRemoved:
+				if e.to >= len(proxy) {
+					t.Fatalf("step %d: remove %+v beyond %d rows", step, e, len(proxy))
+				}
+				proxy = slices.Delete(proxy, e.from, e.to+1)
+
+			case rowsChanged:
+				if e.to >= len(proxy) {
+					t.Fatalf("step %d: change %+v beyond %d rows", step, e, len(proxy))
+				}
+				for i := e.from; i <= e.to; i++ {
+					proxy[i] = placeholder
+				}
+			}
+		}
+
+		want := ids(rebuilt())
+		if got := ids(m); !slices.Equal(got, want) {
+			t.Fatalf("step %d: got rows %v, want %v", step, got, want)
+		}
+
+		if len(proxy) != len(want) {
+			t.Fatalf("step %d: events lead to %d rows, want %d", step, len(proxy), len(want))
+		}
+		for i, id := range proxy {
+			if id != placeholder && id != want[i] {
+				t.Fatalf("step %d: events lead to %v, want %v", step, proxy, want)
+			}
+		}
+
+		proxy = want
+
+		for i := range want {
+			if got := m.fromSource(m.toSource(i)); got != i {
+				t.Fatalf("step %d: fromSource(toSource(%d)) = %d", step, i, got)
+			}
+		}
+	}
+}
+
+func TestFilterRowMap(t *testing.T) {
+	var source []proxyRowsTestItem
+
+	accept := func(row int) bool {
+		return source[row].value%2 == 0
+	}
+
+	m := &filterRowMap{accept: accept}
+
+	checkRowMapper(t, m, &source, func() rowMapper {
+		m := &filterRowMap{accept: accept}
+		m.reset(len(source))
+		return m
+	})
+}
+
+func TestSortRowMap(t *testing.T) {
+	var source []proxyRowsTestItem
+
+	less := func(a, b int) bool {
+		return source[a].value < source[b].value
+	}
+
+	m := &sortRowMap{less: less}
+
+	checkRowMapper(t, m, &source, func() rowMapper {
+		m := &sortRowMap{less: less}
+		m.reset(len(source))
+		return m
+	})
+}
+
+func TestAppendRowEvent(t *testing.T) {
+	var events []rowEvent
+	for _, i := range []int{5, 4, 3} {
+		events = appendRowEvent(events, rowEvent{kind: rowsRemoved, from: i, to: i})
+	}
+	for _, i := range []int{2, 2} {
+		events = appendRowEvent(events, rowEvent{kind: rowsRemoved, from: i, to: i})
+	}
+
+	// Rows 5, 4, 3 and 2, then the row that followed them.
+	want := []rowEvent{{kind: rowsRemoved, from: 2, to: 6}}
+	if !slices.Equal(events, want) {
+		t.Errorf("got %+v, want %+v", events, want)
+	}
+}
+
+func TestDiffRows(t *testing.T) {
+	tests := []struct {
+		old, new []string
+	}{
+		{[]string{"a", "b", "c"}, []string{"a", "c"}},
+		{[]string{"a", "c"}, []string{"x", "a", "b", "c", "d"}},
+		{[]string{"a", "b", "c"}, []string{"b", "x"}},
+		{nil, []string{"a"}},
+		{[]string{"a", "b"}, []string{"b", "a"}},
+	}
+
+	for _, test := range tests {
+		events := diffRows(test.old, test.new, nil)
+
+		if got := applyEvents(test.old, test.new, events); !slices.Equal(got, test.new) {
+			t.Errorf("diffRows(%v, %v) = %v, turns old into %v", test.old, test.new, events, got)
+		}
+	}
+
+	events := diffRows([]string{"a", "b"}, []string{"b", "a"}, nil)
+	if len(events) != 1 || events[0].kind != rowsReset {
+		t.Errorf("reordering: events = %v, want a reset", events)
+	}
+}
@@ -246,7 +246,8 @@ func (pb *PushButton) idealSize() Size {
 func (pb *PushButton) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	return &pushButtonLayoutItem{
 		buttonLayoutItem: buttonLayoutItem{
-			idealSize: pb.idealSize(),
+			textBaseline: pb.newTextBaseline(0, AlignCenter),
+			idealSize:    pb.idealSize(),
 		},
 		layoutFlags: pb.layoutFlags,
 	}
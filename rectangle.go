@@ -2,15 +2,8 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build windows
-// +build windows
-
 package walk
 
-import (
-	"github.com/wuc656/win"
-)
-
 // Rectangle defines upper left corner with width and height region in 1/96" units, or native
 // pixels, or grid rows and columns.
 type Rectangle struct {
@@ -21,20 +14,6 @@ func (r Rectangle) IsZero() bool {
 	return r.X == 0 && r.Y == 0 && r.Width == 0 && r.Height == 0
 }
 
-// RectangleFromRECT converts r from a win.RECT to a Rectangle.
-func RectangleFromRECT(r win.RECT) Rectangle {
-	return rectangleFromRECT(r)
-}
-
-func rectangleFromRECT(r win.RECT) Rectangle {
-	return Rectangle{
-		X:      int(r.Left),
-		Y:      int(r.Top),
-		Width:  int(r.Right - r.Left),
-		Height: int(r.Bottom - r.Top),
-	}
-}
-
 func (r Rectangle) Left() int {
 	return r.X
 }
@@ -72,12 +51,3 @@ func (r *Rectangle) SetSize(s Size) Rectangle {
 
 	return *r
 }
-
-func (r Rectangle) toRECT() win.RECT {
-	return win.RECT{
-		Left: int32(r.X),
-		Top: int32(r.Y),
-		Right: int32(r.X + r.Width),
-		Bottom: int32(r.Y + r.Height),
-	}
-}
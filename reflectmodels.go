@@ -101,18 +101,23 @@ type lessFuncsSetter interface {
 	setLessFuncs(lessFuncs []func(i, j int) bool)
 }
 
+type compareFuncsSetter interface {
+	setCompareFuncs(compareFuncs []func(a, b any) int)
+}
+
 type dataMembersSetter interface {
 	setDataMembers(dataMembers []string)
 }
 
 type reflectTableModel struct {
 	TableModelBase
-	sorterBase  *SorterBase
-	lessFuncs   []func(i, j int) bool
-	dataMembers []string
-	dataSource  any
-	items       any
-	value       reflect.Value
+	sorterBase   *SorterBase
+	lessFuncs    []func(i, j int) bool
+	compareFuncs []func(a, b any) int
+	dataMembers  []string
+	dataSource   any
+	items        any
+	value        reflect.Value
 }
 
 func newReflectTableModel(dataSource any) (TableModel, error) {
@@ -143,8 +148,7 @@ func newReflectTableModel(dataSource any) (TableModel, error) {
 			m.PublishRowsReset()
 
 			if is, ok := dataSource.(interceptedSorter); ok {
-				sb := is.sorterBase()
-				m.sort(sb.SortedColumn(), sb.SortOrder())
+				m.sortByKeys(is.sorterBase().SortKeys())
 			}
 		})
 
@@ -171,9 +175,7 @@ func newReflectTableModel(dataSource any) (TableModel, error) {
 
 	if is, ok := dataSource.(interceptedSorter); ok {
 		m.sorterBase = is.sorterBase()
-		is.setSortFunc(func(col int, order SortOrder) error {
-			return m.sort(col, order)
-		})
+		is.setSortFunc(m.sortByKeys)
 	}
 
 	_, isImageProvider := dataSource.(ImageProvider)
@@ -198,6 +200,10 @@ func (m *reflectTableModel) setLessFuncs(lessFuncs []func(i, j int) bool) {
 	m.lessFuncs = lessFuncs
 }
 
+func (m *reflectTableModel) setCompareFuncs(compareFuncs []func(a, b any) int) {
+	m.compareFuncs = compareFuncs
+}
+
 func (m *reflectTableModel) setDataMembers(dataMembers []string) {
 	m.dataMembers = dataMembers
 }
@@ -210,6 +216,31 @@ func (m *reflectTableModel) Value(row, col int) any {
 	return valueFromSlice(m.dataSource, m.value, m.dataMembers[col], row)
 }
 
+// SetValue sets the field col shows of the item at row.
+func (m *reflectTableModel) SetValue(row, col int, value any) error {
+	v := m.value.Index(row)
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return newError("item not populated")
+	}
+
+	field, err := dataFieldFromPath(v, m.dataMembers[col])
+	if err != nil {
+		return err
+	}
+
+	if !field.CanSet() {
+		return newError(fmt.Sprintf("%s cannot be set", m.dataMembers[col]))
+	}
+
+	if err := field.Set(value); err != nil {
+		return err
+	}
+
+	m.PublishRowChanged(row)
+
+	return nil
+}
+
 func (m *reflectTableModel) Checked(row int) bool {
 	if m.value.Index(row).IsNil() {
 		return false
@@ -278,9 +309,21 @@ func (m *reflectTableModel) SortOrder() SortOrder {
 	return SortAscending
 }
 
-func (m *reflectTableModel) sort(col int, order SortOrder) error {
+func (m *reflectTableModel) SortKeys() []SortKey {
+	if sorter, ok := m.dataSource.(Sorter); ok {
+		return sortKeysOf(sorter)
+	}
+
+	if m.sorterBase != nil {
+		return m.sorterBase.SortKeys()
+	}
+
+	return nil
+}
+
+func (m *reflectTableModel) sortByKeys(keys []SortKey) error {
 	if sb := m.sorterBase; sb != nil {
-		sb.col, sb.order = col, order
+		sb.setSortKeys(keys)
 
 		sort.Stable(m)
 
@@ -290,7 +333,7 @@ func (m *reflectTableModel) sort(col int, order SortOrder) error {
 	}
 
 	if sorter, ok := m.dataSource.(Sorter); ok {
-		return sorter.Sort(col, order)
+		return sortByKeys(sorter, keys)
 	}
 
 	return nil
@@ -301,19 +344,25 @@ func (m *reflectTableModel) Len() int {
 }
 
 func (m *reflectTableModel) Less(i, j int) bool {
-	col := m.SortedColumn()
+	return lessByKeys(m.sorterBase.keys, i, j, m.compare)
+}
 
-	if lt := m.lessFuncs[col]; lt != nil {
-		ls := lt(i, j)
+func (m *reflectTableModel) compare(col, i, j int) int {
+	if col < len(m.lessFuncs) {
+		if lt := m.lessFuncs[col]; lt != nil {
+			switch {
+			case lt(i, j):
+				return -1
 
-		if m.SortOrder() == SortAscending {
-			return ls
-		} else {
-			return !ls
+			case lt(j, i):
+				return 1
+			}
+
+			return 0
 		}
 	}
 
-	return less(m.Value(i, col), m.Value(j, col), m.SortOrder())
+	return compareValuesWith(m.compareFuncs, col, m.Value(i, col), m.Value(j, col))
 }
 
 func (m *reflectTableModel) Swap(i, j int) {
@@ -344,7 +393,11 @@ type sortedReflectTableModel struct {
 }
 
 func (m *sortedReflectTableModel) Sort(col int, order SortOrder) error {
-	return m.reflectTableModel.sort(col, order)
+	return m.reflectTableModel.sortByKeys(sortKeysFor(col, order))
+}
+
+func (m *sortedReflectTableModel) SortByKeys(keys []SortKey) error {
+	return m.reflectTableModel.sortByKeys(keys)
 }
 
 type sortedImageReflectTableModel struct {
@@ -352,7 +405,11 @@ type sortedImageReflectTableModel struct {
 }
 
 func (m *sortedImageReflectTableModel) Sort(col int, order SortOrder) error {
-	return m.reflectTableModel.sort(col, order)
+	return m.reflectTableModel.sortByKeys(sortKeysFor(col, order))
+}
+
+func (m *sortedImageReflectTableModel) SortByKeys(keys []SortKey) error {
+	return m.reflectTableModel.sortByKeys(keys)
 }
 
 func (m *sortedImageReflectTableModel) Image(index int) any {
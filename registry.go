@@ -8,10 +8,15 @@
 package walk
 
 import (
+	"context"
+	"errors"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/wuc656/win"
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
 )
 
 type RegistryKey struct {
@@ -102,3 +107,365 @@ func RegistryKeyUint32(rootKey *RegistryKey, subKeyPath, valueName string) (valu
 
 	return
 }
+
+// openRegistryKey opens the subkey subKeyPath of rootKey with access.
+func openRegistryKey(rootKey *RegistryKey, subKeyPath string, access uint32) (registry.Key, error) {
+	key, err := registry.OpenKey(registry.Key(rootKey.hKey), subKeyPath, access)
+	if err != nil {
+		return 0, wrapError(err)
+	}
+
+	return key, nil
+}
+
+// withRegistryValue calls f with the subkey subKeyPath of rootKey opened for
+// reading values.
+func withRegistryValue(rootKey *RegistryKey, subKeyPath string, f func(key registry.Key) error) error {
+	key, err := openRegistryKey(rootKey, subKeyPath, registry.QUERY_VALUE)
+	if err != nil {
+		return err
+	}
+	defer key.Close()
+
+	if err := f(key); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+// withCreatedRegistryKey calls f with the subkey subKeyPath of rootKey, which
+// it creates if it does not exist, opened for writing values.
+func withCreatedRegistryKey(rootKey *RegistryKey, subKeyPath string, f func(key registry.Key) error) error {
+	key, _, err := registry.CreateKey(registry.Key(rootKey.hKey), subKeyPath, registry.SET_VALUE)
+	if err != nil {
+		return wrapError(err)
+	}
+	defer key.Close()
+
+	if err := f(key); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+// RegistryKeyStrings returns the multi-string value valueName of the subkey
+// subKeyPath of rootKey.
+func RegistryKeyStrings(rootKey *RegistryKey, subKeyPath, valueName string) (value []string, err error) {
+	err = withRegistryValue(rootKey, subKeyPath, func(key registry.Key) error {
+		value, _, err = key.GetStringsValue(valueName)
+		return err
+	})
+
+	return
+}
+
+// RegistryKeyUint64 returns the DWORD or QWORD value valueName of the subkey
+// subKeyPath of rootKey.
+func RegistryKeyUint64(rootKey *RegistryKey, subKeyPath, valueName string) (value uint64, err error) {
+	err = withRegistryValue(rootKey, subKeyPath, func(key registry.Key) error {
+		value, _, err = key.GetIntegerValue(valueName)
+		return err
+	})
+
+	return
+}
+
+// RegistryKeyBinary returns the binary value valueName of the subkey
+// subKeyPath of rootKey.
+func RegistryKeyBinary(rootKey *RegistryKey, subKeyPath, valueName string) (value []byte, err error) {
+	err = withRegistryValue(rootKey, subKeyPath, func(key registry.Key) error {
+		value, _, err = key.GetBinaryValue(valueName)
+		return err
+	})
+
+	return
+}
+
+// SetRegistryKeyString sets the string value valueName of the subkey
+// subKeyPath of rootKey, which is created if it does not exist.
+func SetRegistryKeyString(rootKey *RegistryKey, subKeyPath, valueName, value string) error {
+	return withCreatedRegistryKey(rootKey, subKeyPath, func(key registry.Key) error {
+		return key.SetStringValue(valueName, value)
+	})
+}
+
+// SetRegistryKeyExpandString sets the string value valueName of the subkey
+// subKeyPath of rootKey, that contains unexpanded references to environment
+// variables like %PATH%. The subkey is created if it does not exist.
+func SetRegistryKeyExpandString(rootKey *RegistryKey, subKeyPath, valueName, value string) error {
+	return withCreatedRegistryKey(rootKey, subKeyPath, func(key registry.Key) error {
+		return key.SetExpandStringValue(valueName, value)
+	})
+}
+
+// SetRegistryKeyStrings sets the multi-string value valueName of the subkey
+// subKeyPath of rootKey, which is created if it does not exist.
+func SetRegistryKeyStrings(rootKey *RegistryKey, subKeyPath, valueName string, value []string) error {
+	return withCreatedRegistryKey(rootKey, subKeyPath, func(key registry.Key) error {
+		return key.SetStringsValue(valueName, value)
+	})
+}
+
+// SetRegistryKeyUint32 sets the DWORD value valueName of the subkey
+// subKeyPath of rootKey, which is created if it does not exist.
+func SetRegistryKeyUint32(rootKey *RegistryKey, subKeyPath, valueName string, value uint32) error {
+	return withCreatedRegistryKey(rootKey, subKeyPath, func(key registry.Key) error {
+		return key.SetDWordValue(valueName, value)
+	})
+}
+
+// SetRegistryKeyUint64 sets the QWORD value valueName of the subkey
+// subKeyPath of rootKey, which is created if it does not exist.
+func SetRegistryKeyUint64(rootKey *RegistryKey, subKeyPath, valueName string, value uint64) error {
+	return withCreatedRegistryKey(rootKey, subKeyPath, func(key registry.Key) error {
+		return key.SetQWordValue(valueName, value)
+	})
+}
+
+// SetRegistryKeyBinary sets the binary value valueName of the subkey
+// subKeyPath of rootKey, which is created if it does not exist.
+func SetRegistryKeyBinary(rootKey *RegistryKey, subKeyPath, valueName string, value []byte) error {
+	return withCreatedRegistryKey(rootKey, subKeyPath, func(key registry.Key) error {
+		return key.SetBinaryValue(valueName, value)
+	})
+}
+
+// DeleteRegistryKeyValue deletes the value valueName of the subkey subKeyPath
+// of rootKey.
+func DeleteRegistryKeyValue(rootKey *RegistryKey, subKeyPath, valueName string) error {
+	key, err := openRegistryKey(rootKey, subKeyPath, registry.SET_VALUE)
+	if err != nil {
+		return err
+	}
+	defer key.Close()
+
+	if err := key.DeleteValue(valueName); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+// CreateRegistryKey creates the subkey subKeyPath of rootKey and the keys on
+// its path, that do not exist.
+func CreateRegistryKey(rootKey *RegistryKey, subKeyPath string) error {
+	key, _, err := registry.CreateKey(registry.Key(rootKey.hKey), subKeyPath, registry.QUERY_VALUE)
+	if err != nil {
+		return wrapError(err)
+	}
+
+	key.Close()
+
+	return nil
+}
+
+// DeleteRegistryKey deletes the subkey subKeyPath of rootKey with all its
+// subkeys and values.
+func DeleteRegistryKey(rootKey *RegistryKey, subKeyPath string) error {
+	if err := deleteRegistryKeyTree(registry.Key(rootKey.hKey), subKeyPath); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+func deleteRegistryKeyTree(parent registry.Key, path string) error {
+	key, err := registry.OpenKey(parent, path, registry.ENUMERATE_SUB_KEYS|registry.QUERY_VALUE)
+	if err != nil {
+		return err
+	}
+
+	names, err := key.ReadSubKeyNames(0)
+	if err == nil {
+		for _, name := range names {
+			if err = deleteRegistryKeyTree(key, name); err != nil {
+				break
+			}
+		}
+	}
+
+	key.Close()
+
+	if err != nil {
+		return err
+	}
+
+	return registry.DeleteKey(parent, path)
+}
+
+// RegistryKeyValueNames returns the names of the values of the subkey
+// subKeyPath of rootKey.
+func RegistryKeyValueNames(rootKey *RegistryKey, subKeyPath string) (names []string, err error) {
+	err = withRegistryValue(rootKey, subKeyPath, func(key registry.Key) error {
+		names, err = key.ReadValueNames(0)
+		return err
+	})
+
+	return
+}
+
+// RegistryKeySubKeyNames returns the names of the subkeys of the subkey
+// subKeyPath of rootKey.
+func RegistryKeySubKeyNames(rootKey *RegistryKey, subKeyPath string) ([]string, error) {
+	key, err := openRegistryKey(rootKey, subKeyPath, registry.ENUMERATE_SUB_KEYS)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Close()
+
+	names, err := key.ReadSubKeyNames(0)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return names, nil
+}
+
+// RegistryKeyWatcher publishes an event on the UI thread, when a registry key
+// changes, e.g. when a policy is changed or a file association is updated.
+type RegistryKeyWatcher struct {
+	key              registry.Key
+	subtree          bool
+	changeEvent      windows.Handle
+	stopEvent        windows.Handle
+	stopOnAppExit    func() bool
+	watching         bool
+	disposed         bool
+	changedPublisher EventPublisher
+}
+
+// NewRegistryKeyWatcher returns a new RegistryKeyWatcher, that watches the
+// subkey subKeyPath of rootKey for added or deleted subkeys and changed values.
+// If subtree is true, it watches all subkeys below it, too.
+func NewRegistryKeyWatcher(rootKey *RegistryKey, subKeyPath string, subtree bool) (*RegistryKeyWatcher, error) {
+	key, err := openRegistryKey(rootKey, subKeyPath, registry.NOTIFY)
+	if err != nil {
+		return nil, err
+	}
+
+	rkw := &RegistryKeyWatcher{key: key, subtree: subtree}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			rkw.Dispose()
+		}
+	}()
+
+	if rkw.changeEvent, err = windows.CreateEvent(nil, 0, 0, nil); err != nil {
+		return nil, wrapError(err)
+	}
+	if rkw.stopEvent, err = windows.CreateEvent(nil, 1, 0, nil); err != nil {
+		return nil, wrapError(err)
+	}
+
+	// The first notification is requested here, so changes right after
+	// NewRegistryKeyWatcher returns are not missed.
+	if err := rkw.notify(); err != nil {
+		return nil, err
+	}
+
+	stopEvent := rkw.stopEvent
+	rkw.stopOnAppExit = context.AfterFunc(App().Context(), func() {
+		windows.SetEvent(stopEvent)
+	})
+
+	changeEvent := rkw.changeEvent
+	rkw.watching = true
+	App().Go(func(ctx context.Context) {
+		rkw.watch(key, changeEvent, stopEvent)
+	})
+
+	succeeded = true
+
+	return rkw, nil
+}
+
+// Changed returns the event that is published on the UI thread, when the
+// watched key changes.
+func (rkw *RegistryKeyWatcher) Changed() *Event {
+	return rkw.changedPublisher.Event()
+}
+
+// Dispose stops watching the key.
+func (rkw *RegistryKeyWatcher) Dispose() {
+	rkw.disposed = true
+
+	if rkw.stopOnAppExit != nil {
+		rkw.stopOnAppExit()
+		rkw.stopOnAppExit = nil
+	}
+
+	if rkw.watching {
+		// The watching goroutine closes the handles, once it stopped.
+		windows.SetEvent(rkw.stopEvent)
+		rkw.watching = false
+		rkw.stopEvent = 0
+		rkw.changeEvent = 0
+		rkw.key = 0
+		return
+	}
+
+	if rkw.stopEvent != 0 {
+		windows.CloseHandle(rkw.stopEvent)
+		rkw.stopEvent = 0
+	}
+
+	if rkw.changeEvent != 0 {
+		windows.CloseHandle(rkw.changeEvent)
+		rkw.changeEvent = 0
+	}
+
+	if rkw.key != 0 {
+		rkw.key.Close()
+		rkw.key = 0
+	}
+}
+
+// notify requests the next notification of changes of the key.
+func (rkw *RegistryKeyWatcher) notify() error {
+	if err := registryNotify(rkw.key, rkw.subtree, rkw.changeEvent); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+func registryNotify(key registry.Key, subtree bool, changeEvent windows.Handle) error {
+	const filter = windows.REG_NOTIFY_CHANGE_NAME | windows.REG_NOTIFY_CHANGE_LAST_SET | windows.REG_NOTIFY_THREAD_AGNOSTIC
+
+	return windows.RegNotifyChangeKeyValue(windows.Handle(key), subtree, filter, changeEvent, true)
+}
+
+// watch waits for changes of key on a goroutine, until stopEvent is set.
+func (rkw *RegistryKeyWatcher) watch(key registry.Key, changeEvent, stopEvent windows.Handle) {
+	// Before Windows 8, notifications end with the thread that requested them.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	defer func() {
+		key.Close()
+		windows.CloseHandle(changeEvent)
+		windows.CloseHandle(stopEvent)
+	}()
+
+	for {
+		event, err := windows.WaitForMultipleObjects([]windows.Handle{changeEvent, stopEvent}, false, windows.INFINITE)
+		if err != nil || event != windows.WAIT_OBJECT_0 {
+			return
+		}
+
+		if err := registryNotify(key, rkw.subtree, changeEvent); err != nil && !errors.Is(err, windows.ERROR_KEY_DELETED) {
+			return
+		}
+
+		App().Synchronize(func() {
+			// Changes may have been queued up before Dispose.
+			if !rkw.disposed {
+				rkw.changedPublisher.Publish()
+			}
+		})
+	}
+}
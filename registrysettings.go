@@ -0,0 +1,215 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/windows/registry"
+)
+
+// registrySettingsTimestampsKeyName is the name of the subkey, that stores the
+// times expiring values were put.
+const registrySettingsTimestampsKeyName = "Timestamps"
+
+// RegistrySettings is a Settings, that stores values in the registry, as
+// string values under CurrentUserKey()\Software\<OrganizationName>\<ProductName>
+// with the OrganizationName and ProductName of App().
+type RegistrySettings struct {
+	key2Record     map[string]registrySettingsRecord
+	removedKeys    map[string]bool
+	expireDuration time.Duration
+}
+
+type registrySettingsRecord struct {
+	value     string
+	timestamp time.Time
+}
+
+func NewRegistrySettings() *RegistrySettings {
+	return &RegistrySettings{
+		key2Record:  make(map[string]registrySettingsRecord),
+		removedKeys: make(map[string]bool),
+	}
+}
+
+func (rs *RegistrySettings) Get(key string) (string, bool) {
+	record, ok := rs.key2Record[key]
+	return record.value, ok
+}
+
+func (rs *RegistrySettings) Timestamp(key string) (time.Time, bool) {
+	record, ok := rs.key2Record[key]
+	return record.timestamp, ok
+}
+
+func (rs *RegistrySettings) Put(key, value string) error {
+	return rs.put(key, value, false)
+}
+
+func (rs *RegistrySettings) PutExpiring(key, value string) error {
+	return rs.put(key, value, true)
+}
+
+func (rs *RegistrySettings) put(key, value string, expiring bool) error {
+	if key == "" {
+		return newError("key must not be empty")
+	}
+
+	var timestamp time.Time
+	if expiring {
+		timestamp = time.Now()
+	}
+
+	rs.key2Record[key] = registrySettingsRecord{value, timestamp}
+	delete(rs.removedKeys, key)
+
+	return nil
+}
+
+func (rs *RegistrySettings) Remove(key string) error {
+	delete(rs.key2Record, key)
+	rs.removedKeys[key] = true
+
+	return nil
+}
+
+func (rs *RegistrySettings) ExpireDuration() time.Duration {
+	return rs.expireDuration
+}
+
+func (rs *RegistrySettings) SetExpireDuration(expireDuration time.Duration) {
+	rs.expireDuration = expireDuration
+}
+
+// KeyPath returns the path of the key below CurrentUserKey(), that the values
+// are stored under.
+func (rs *RegistrySettings) KeyPath() string {
+	names := []string{"Software"}
+
+	for _, name := range []string{App().OrganizationName(), App().ProductName()} {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, `\`)
+}
+
+// Load replaces all values by the ones in the registry.
+func (rs *RegistrySettings) Load() error {
+	rs.key2Record = make(map[string]registrySettingsRecord)
+	rs.removedKeys = make(map[string]bool)
+
+	key, err := registry.OpenKey(registry.CURRENT_USER, rs.KeyPath(), registry.QUERY_VALUE)
+	if errors.Is(err, registry.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return wrapError(err)
+	}
+	defer key.Close()
+
+	names, err := key.ReadValueNames(0)
+	if err != nil {
+		return wrapError(err)
+	}
+
+	for _, name := range names {
+		value, _, err := key.GetStringValue(name)
+		if err != nil {
+			// Values of other types are not ours.
+			continue
+		}
+
+		rs.key2Record[name] = registrySettingsRecord{value: value}
+	}
+
+	tsKey, err := registry.OpenKey(key, registrySettingsTimestampsKeyName, registry.QUERY_VALUE)
+	if errors.Is(err, registry.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return wrapError(err)
+	}
+	defer tsKey.Close()
+
+	for name, record := range rs.key2Record {
+		if s, _, err := tsKey.GetStringValue(name); err == nil {
+			if record.timestamp, _ = time.Parse(iniFileTimeStampFormat, s); record.timestamp.IsZero() {
+				record.timestamp = time.Now()
+			}
+			rs.key2Record[name] = record
+		}
+	}
+
+	return nil
+}
+
+// Save writes all values, that have not expired, to the registry and removes
+// the ones removed since they were loaded.
+func (rs *RegistrySettings) Save() error {
+	key, _, err := registry.CreateKey(registry.CURRENT_USER, rs.KeyPath(), registry.QUERY_VALUE|registry.SET_VALUE|registry.CREATE_SUB_KEY)
+	if err != nil {
+		return wrapError(err)
+	}
+	defer key.Close()
+
+	tsKey, _, err := registry.CreateKey(key, registrySettingsTimestampsKeyName, registry.SET_VALUE)
+	if err != nil {
+		return wrapError(err)
+	}
+	defer tsKey.Close()
+
+	deleteValue := func(k registry.Key, name string) error {
+		if err := k.DeleteValue(name); err != nil && !errors.Is(err, registry.ErrNotExist) {
+			return wrapError(err)
+		}
+
+		return nil
+	}
+
+	for name := range rs.removedKeys {
+		if err := deleteValue(key, name); err != nil {
+			return err
+		}
+		if err := deleteValue(tsKey, name); err != nil {
+			return err
+		}
+	}
+
+	for name, record := range rs.key2Record {
+		if rs.expireDuration > 0 && !record.timestamp.IsZero() && time.Since(record.timestamp) >= rs.expireDuration {
+			if err := deleteValue(key, name); err != nil {
+				return err
+			}
+			if err := deleteValue(tsKey, name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := key.SetStringValue(name, record.value); err != nil {
+			return wrapError(err)
+		}
+
+		if record.timestamp.IsZero() {
+			if err := deleteValue(tsKey, name); err != nil {
+				return err
+			}
+		} else if err := tsKey.SetStringValue(name, record.timestamp.Format(iniFileTimeStampFormat)); err != nil {
+			return wrapError(err)
+		}
+	}
+
+	rs.removedKeys = make(map[string]bool)
+
+	return nil
+}
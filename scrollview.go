@@ -91,6 +91,38 @@ func (sv *ScrollView) SetScrollbars(horizontal, vertical bool) {
 	sv.ensureStyleBits(win.WS_VSCROLL, vertical)
 }
 
+// RightToLeft returns whether the ScrollView lays out and displays its content
+// from right to left.
+func (sv *ScrollView) RightToLeft() bool {
+	if sv.composite != nil && sv.composite.rightToLeft != nil {
+		return *sv.composite.rightToLeft
+	}
+
+	return sv.WidgetBase.RightToLeft()
+}
+
+// SetRightToLeft sets whether the ScrollView and its descendants lay out and
+// display their content from right to left.
+func (sv *ScrollView) SetRightToLeft(rtl bool) error {
+	if err := sv.composite.SetRightToLeft(rtl); err != nil {
+		return err
+	}
+
+	return sv.applyRightToLeft(rtl)
+}
+
+func (sv *ScrollView) applyRightToLeft(rtl bool) error {
+	if err := sv.WidgetBase.applyRightToLeft(rtl); err != nil {
+		return err
+	}
+
+	if sv.composite != nil {
+		return sv.composite.applyRightToLeft(rtl)
+	}
+
+	return nil
+}
+
 func (sv *ScrollView) SetSuspended(suspend bool) {
 	sv.composite.SetSuspended(suspend)
 	sv.WidgetBase.SetSuspended(suspend)
@@ -288,6 +320,12 @@ func (sv *ScrollView) scroll(sb int32, cmd uint16) int {
 		pos = si.NTrackPos
 	}
 
+	return sv.scrollTo(sb, pos, &si)
+}
+
+// scrollTo scrolls to pos, limited to the range in si, and returns the new
+// position in native pixels.
+func (sv *ScrollView) scrollTo(sb int32, pos int32, si *win.SCROLLINFO) int {
 	if pos < 0 {
 		pos = 0
 	}
@@ -297,11 +335,54 @@ func (sv *ScrollView) scroll(sb int32, cmd uint16) int {
 
 	si.FMask = win.SIF_POS
 	si.NPos = pos
-	win.SetScrollInfo(sv.hWnd, sb, &si, true)
+	win.SetScrollInfo(sv.hWnd, sb, si, true)
 
 	return -int(pos)
 }
 
+// EnsureVisible scrolls, if necessary, so that as much as possible of widget,
+// which must be a descendant of sv, is visible.
+func (sv *ScrollView) EnsureVisible(widget Widget) {
+	var wr, cr win.RECT
+	if !win.GetWindowRect(widget.Handle(), &wr) || !win.GetClientRect(sv.hWnd, &cr) {
+		return
+	}
+
+	topLeft := win.POINT{X: wr.Left, Y: wr.Top}
+	bottomRight := win.POINT{X: wr.Right, Y: wr.Bottom}
+	win.ScreenToClient(sv.hWnd, &topLeft)
+	win.ScreenToClient(sv.hWnd, &bottomRight)
+
+	// delta returns how far to scroll to get [lo, hi) into [0, size).
+	delta := func(lo, hi, size int32) int32 {
+		switch {
+		case lo < 0:
+			return lo
+
+		case hi > size:
+			return min(hi-size, lo)
+		}
+		return 0
+	}
+
+	scrollBy := func(sb int32, d int32) int {
+		var si win.SCROLLINFO
+		si.CbSize = uint32(unsafe.Sizeof(si))
+		si.FMask = win.SIF_PAGE | win.SIF_POS | win.SIF_RANGE
+
+		win.GetScrollInfo(sv.hWnd, sb, &si)
+
+		return sv.scrollTo(sb, si.NPos+d, &si)
+	}
+
+	if d := delta(topLeft.X, bottomRight.X, cr.Right); d != 0 {
+		sv.composite.SetXPixels(scrollBy(win.SB_HORZ, d))
+	}
+	if d := delta(topLeft.Y, bottomRight.Y, cr.Bottom); d != 0 {
+		sv.composite.SetYPixels(scrollBy(win.SB_VERT, d))
+	}
+}
+
 func (sv *ScrollView) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	svli := new(scrollViewLayoutItem)
 	svli.ctx = ctx
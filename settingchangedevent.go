@@ -0,0 +1,74 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+type settingChangedEventHandlerInfo struct {
+	handler SettingChangedEventHandler
+	once    bool
+}
+
+// SettingChangedEventHandler handles the change of the value of the setting
+// with key.
+type SettingChangedEventHandler func(key string)
+
+// SettingChangedEvent is published when the value of a setting changes.
+type SettingChangedEvent struct {
+	handlers []settingChangedEventHandlerInfo
+}
+
+// Attach adds handler to e and will be invoked when the event associated with
+// e is triggered. It returns an integral handle to the event that may be used
+// with Detach.
+func (e *SettingChangedEvent) Attach(handler SettingChangedEventHandler) int {
+	handlerInfo := settingChangedEventHandlerInfo{handler, false}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+// Detach removes the handler specified by handle, which was obtained as the
+// result of a call to Attach.
+func (e *SettingChangedEvent) Detach(handle int) {
+	e.handlers[handle].handler = nil
+}
+
+// Once is similar to Attach, except that handler is attached as a "one-shot"
+// occurrence; handler will automatically be detached after its first invocation.
+func (e *SettingChangedEvent) Once(handler SettingChangedEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// SettingChangedEventPublisher is the event publisher used by any code that
+// supports SettingChangedEvent.
+type SettingChangedEventPublisher struct {
+	event SettingChangedEvent
+}
+
+// Event obtains a pointer to the SettingChangedEvent associated with p.
+func (p *SettingChangedEventPublisher) Event() *SettingChangedEvent {
+	return &p.event
+}
+
+// Publish dispatches the event to all registered handlers.
+func (p *SettingChangedEventPublisher) Publish(key string) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(key)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
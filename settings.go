@@ -0,0 +1,21 @@
+// Copyright 2011 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"time"
+)
+
+type Settings interface {
+	Get(key string) (string, bool)
+	Timestamp(key string) (time.Time, bool)
+	Put(key, value string) error
+	PutExpiring(key, value string) error
+	Remove(key string) error
+	ExpireDuration() time.Duration
+	SetExpireDuration(expireDuration time.Duration)
+	Load() error
+	Save() error
+}
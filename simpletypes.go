@@ -2,8 +2,6 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// +build windows
-
 package walk
 
 type Alignment1D uint
@@ -29,3 +27,43 @@ const (
 	AlignHCenterVFar
 	AlignHFarVFar
 )
+
+// mirrored returns the alignment with near and far swapped, as used from right
+// to left.
+func (a Alignment1D) mirrored() Alignment1D {
+	switch a {
+	case AlignNear:
+		return AlignFar
+
+	case AlignFar:
+		return AlignNear
+	}
+
+	return a
+}
+
+// mirrored returns the alignment with horizontal near and far swapped, as used
+// from right to left.
+func (a Alignment2D) mirrored() Alignment2D {
+	switch a {
+	case AlignHNearVNear:
+		return AlignHFarVNear
+
+	case AlignHFarVNear:
+		return AlignHNearVNear
+
+	case AlignHNearVCenter:
+		return AlignHFarVCenter
+
+	case AlignHFarVCenter:
+		return AlignHNearVCenter
+
+	case AlignHNearVFar:
+		return AlignHFarVFar
+
+	case AlignHFarVFar:
+		return AlignHNearVFar
+	}
+
+	return a
+}
@@ -2,13 +2,8 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build windows
-// +build windows
-
 package walk
 
-import "github.com/wuc656/win"
-
 // Size defines width and height in 1/96" units or native pixels, or dialog base units.
 //
 // When Size is used for DPI metrics, it defines a 1"x1" rectangle in native pixels.
@@ -20,13 +15,6 @@ func (s Size) IsZero() bool {
 	return s.Width == 0 && s.Height == 0
 }
 
-func (s Size) toSIZE() win.SIZE {
-	return win.SIZE{
-		CX: int32(s.Width),
-		CY: int32(s.Height),
-	}
-}
-
 func minSize(a, b Size) Size {
 	var s Size
 
@@ -47,16 +35,18 @@ func maxSize(a, b Size) Size {
 	return s
 }
 
-func sizeFromSIZE(s win.SIZE) Size {
-	return Size{
-		Width:  int(s.CX),
-		Height: int(s.CY),
+func maxi(a, b int) int {
+	if a > b {
+		return a
 	}
+
+	return b
 }
 
-func sizeFromRECT(r win.RECT) Size {
-	return Size{
-		Width:  int(r.Right - r.Left),
-		Height: int(r.Bottom - r.Top),
+func mini(a, b int) int {
+	if a < b {
+		return a
 	}
+
+	return b
 }
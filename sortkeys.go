@@ -0,0 +1,93 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"slices"
+)
+
+// SortOrder specifies the order by which items are sorted.
+type SortOrder int
+
+const (
+	// SortAscending specifies ascending sort order.
+	SortAscending SortOrder = iota
+
+	// SortDescending specifies descending sort order.
+	SortDescending
+)
+
+// SortKey is a column to sort by, together with the order to sort it in.
+type SortKey struct {
+	Column int
+	Order  SortOrder
+}
+
+// sortKeysFor returns the keys that sort by column col in order, or none if col
+// is -1.
+func sortKeysFor(col int, order SortOrder) []SortKey {
+	if col < 0 {
+		return nil
+	}
+
+	return []SortKey{{Column: col, Order: order}}
+}
+
+// toggledSortKeys returns the keys to sort by, after the header of column col
+// has been clicked while sorting by keys.
+//
+// A plain click makes col the only key, in ascending order, or in the opposite
+// order if col was the primary key already. If extend is true, like on
+// shift-click, col is added as the least significant key, or its order is
+// reversed if it is a key already.
+func toggledSortKeys(keys []SortKey, col int, extend bool) []SortKey {
+	i := slices.IndexFunc(keys, func(key SortKey) bool {
+		return key.Column == col
+	})
+
+	if !extend || len(keys) == 0 {
+		order := SortAscending
+		if i == 0 && keys[0].Order == SortAscending {
+			order = SortDescending
+		}
+
+		return []SortKey{{Column: col, Order: order}}
+	}
+
+	keys = slices.Clone(keys)
+
+	if i == -1 {
+		return append(keys, SortKey{Column: col, Order: SortAscending})
+	}
+
+	if keys[i].Order == SortAscending {
+		keys[i].Order = SortDescending
+	} else {
+		keys[i].Order = SortAscending
+	}
+
+	return keys
+}
+
+// lessByKeys returns whether row a sorts before row b by keys. compare returns
+// a negative number, zero or a positive number if the value of row a in column
+// col sorts before, like or after that of row b in ascending order.
+//
+// Rows that compare equal by all keys are not ordered, so they keep their
+// order when sorting stably.
+func lessByKeys[T any](keys []SortKey, a, b T, compare func(col int, a, b T) int) bool {
+	for _, key := range keys {
+		c := compare(key.Column, a, b)
+		if key.Order == SortDescending {
+			c = -c
+		}
+
+		if c != 0 {
+			return c < 0
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestToggledSortKeys(t *testing.T) {
+	asc := func(col int) SortKey { return SortKey{Column: col, Order: SortAscending} }
+	desc := func(col int) SortKey { return SortKey{Column: col, Order: SortDescending} }
+
+	tests := []struct {
+		keys   []SortKey
+		col    int
+		extend bool
+		want   []SortKey
+	}{
+		{nil, 2, false, []SortKey{asc(2)}},
+		{nil, 2, true, []SortKey{asc(2)}},
+		{[]SortKey{asc(2)}, 2, false, []SortKey{desc(2)}},
+		{[]SortKey{desc(2)}, 2, false, []SortKey{asc(2)}},
+		{[]SortKey{asc(2)}, 1, false, []SortKey{asc(1)}},
+		{[]SortKey{asc(2), desc(1)}, 2, false, []SortKey{desc(2)}},
+		{[]SortKey{asc(2), desc(1)}, 1, false, []SortKey{asc(1)}},
+		{[]SortKey{asc(2)}, 1, true, []SortKey{asc(2), asc(1)}},
+		{[]SortKey{asc(2), asc(1)}, 1, true, []SortKey{asc(2), desc(1)}},
+		{[]SortKey{asc(2), desc(1)}, 2, true, []SortKey{desc(2), desc(1)}},
+	}
+
+	for _, test := range tests {
+		keys := slices.Clone(test.keys)
+
+		if got := toggledSortKeys(keys, test.col, test.extend); !slices.Equal(got, test.want) {
+			t.Errorf("toggledSortKeys(%v, %d, %t) = %v, want %v", test.keys, test.col, test.extend, got, test.want)
+		}
+
+		if !slices.Equal(keys, test.keys) {
+			t.Errorf("toggledSortKeys(%v, %d, %t) modified keys", test.keys, test.col, test.extend)
+		}
+	}
+}
+
+func TestLessByKeys(t *testing.T) {
+	rows := [][2]int{
+		{1, 2},
+		{0, 1},
+		{1, 1},
+		{0, 2},
+		{1, 2},
+		{0, 1},
+	}
+
+	compare := func(col, a, b int) int {
+		return cmp.Compare(rows[a][col], rows[b][col])
+	}
+
+	tests := []struct {
+		keys []SortKey
+		want []int
+	}{
+		{nil, []int{0, 1, 2, 3, 4, 5}},
+		{[]SortKey{{0, SortAscending}}, []int{1, 3, 5, 0, 2, 4}},
+		{[]SortKey{{0, SortAscending}, {1, SortAscending}}, []int{1, 5, 3, 2, 0, 4}},
+		{[]SortKey{{0, SortDescending}, {1, SortAscending}}, []int{2, 0, 4, 1, 5, 3}},
+		{[]SortKey{{1, SortDescending}, {0, SortAscending}}, []int{3, 0, 4, 1, 5, 2}},
+	}
+
+	for _, test := range tests {
+		order := []int{0, 1, 2, 3, 4, 5}
+		sort.SliceStable(order, func(i, j int) bool {
+			return lessByKeys(test.keys, order[i], order[j], compare)
+		})
+
+		if !slices.Equal(order, test.want) {
+			t.Errorf("sorting by %v: got %v, want %v", test.keys, order, test.want)
+		}
+	}
+}
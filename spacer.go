@@ -21,12 +21,6 @@ type Spacer struct {
 	greedyLocallyOnly bool
 }
 
-type SpacerCfg struct {
-	LayoutFlags       LayoutFlags
-	SizeHint          Size // in 1/96" units
-	GreedyLocallyOnly bool
-}
-
 func NewSpacerWithCfg(parent Container, cfg *SpacerCfg) (*Spacer, error) {
 	return newSpacer(parent, cfg.LayoutFlags, cfg.SizeHint, cfg.GreedyLocallyOnly)
 }
@@ -73,22 +67,3 @@ func (s *Spacer) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 		greedyLocallyOnly: s.greedyLocallyOnly,
 	}
 }
-
-type spacerLayoutItem struct {
-	LayoutItemBase
-	idealSize96dpi    Size
-	layoutFlags       LayoutFlags
-	greedyLocallyOnly bool
-}
-
-func (li *spacerLayoutItem) LayoutFlags() LayoutFlags {
-	return li.layoutFlags
-}
-
-func (li *spacerLayoutItem) IdealSize() Size {
-	return SizeFrom96DPI(li.idealSize96dpi, li.ctx.dpi)
-}
-
-func (li *spacerLayoutItem) MinSize() Size {
-	return SizeFrom96DPI(li.idealSize96dpi, li.ctx.dpi)
-}
@@ -89,7 +89,7 @@ func (sb *SplitButton) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr
 
 			win.TrackPopupMenuEx(
 				sb.menu.hMenu,
-				win.TPM_NOANIMATION,
+				sb.menu.popupFlags(sb, sb.hWnd),
 				p.X,
 				p.Y,
 				sb.hWnd,
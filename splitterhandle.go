@@ -84,34 +84,3 @@ func (sh *splitterHandle) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 		handleWidth: handleWidth,
 	}
 }
-
-type splitterHandleLayoutItem struct {
-	LayoutItemBase
-	orientation Orientation
-	handleWidth int
-}
-
-func (li *splitterHandleLayoutItem) LayoutFlags() LayoutFlags {
-	if li.orientation == Horizontal {
-		return ShrinkableVert | GrowableVert | GreedyVert
-	}
-
-	return ShrinkableHorz | GrowableHorz | GreedyHorz
-}
-
-func (li *splitterHandleLayoutItem) IdealSize() Size {
-	var size Size
-	dpi := int(win.GetDpiForWindow(li.handle))
-
-	if li.orientation == Horizontal {
-		size.Width = IntFrom96DPI(li.handleWidth, dpi)
-	} else {
-		size.Height = IntFrom96DPI(li.handleWidth, dpi)
-	}
-
-	return size
-}
-
-func (li *splitterHandleLayoutItem) MinSize() Size {
-	return li.IdealSize()
-}
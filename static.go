@@ -70,6 +70,9 @@ func (s *static) init(widget Widget, parent Container, style uint32) error {
 	}
 
 	s.applyFont(s.Font())
+	if err := s.applyRightToLeft(s.RightToLeft()); err != nil {
+		return err
+	}
 
 	s.SetBackground(nullBrushSingleton)
 
@@ -103,6 +106,22 @@ func (s *static) applyFont(font *Font) {
 	SetWindowFont(s.hwndStatic, font)
 }
 
+func (s *static) applyRightToLeft(rtl bool) error {
+	if err := s.WidgetBase.applyRightToLeft(rtl); err != nil {
+		return err
+	}
+
+	// The static control swaps its near and far text alignments itself when
+	// mirrored.
+	if err := ensureWindowLongBits(s.hwndStatic, win.GWL_EXSTYLE, win.WS_EX_LAYOUTRTL|win.WS_EX_RTLREADING, rtl); err != nil {
+		return err
+	}
+
+	win.InvalidateRect(s.hwndStatic, nil, true)
+
+	return nil
+}
+
 func (s *static) textAlignment1D() Alignment1D {
 	switch s.textAlignment {
 	case AlignHCenterVNear, AlignHCenterVCenter, AlignHCenterVFar:
@@ -319,14 +338,28 @@ func (s *static) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 		idealSize.Height += border * 2
 	}
 
+	var textVAlignment Alignment1D
+	switch s.textAlignment {
+	case AlignHNearVCenter, AlignHCenterVCenter, AlignHFarVCenter:
+		textVAlignment = AlignCenter
+
+	case AlignHNearVFar, AlignHCenterVFar, AlignHFarVFar:
+		textVAlignment = AlignFar
+
+	default:
+		textVAlignment = AlignNear
+	}
+
 	return &staticLayoutItem{
-		layoutFlags: layoutFlags,
-		idealSize:   idealSize,
+		textBaseline: s.newTextBaseline(s.calculateTextSize().Height, textVAlignment),
+		layoutFlags:  layoutFlags,
+		idealSize:    idealSize,
 	}
 }
 
 type staticLayoutItem struct {
 	LayoutItemBase
+	textBaseline
 	layoutFlags LayoutFlags
 	idealSize   Size // in native pixels
 }
@@ -42,6 +42,7 @@ func init() {
 const (
 	tableViewCurrentIndexChangedTimerId = 1 + iota
 	tableViewSelectedIndexesChangedTimerId
+	tableViewFilterTimerId
 )
 
 type TableViewCfg struct {
@@ -111,8 +112,7 @@ type TableView struct {
 	alternatingRowTextColor            Color
 	alternatingRowBG                   bool
 	delayedCurrentIndexChangedCanceled bool
-	sortedColumnIndex                  int
-	sortOrder                          SortOrder
+	sortKeys                           []SortKey
 	formActivatingHandle               int
 	customHeaderHeight                 int // in native pixels?
 	customRowHeight                    int // in native pixels?
@@ -129,6 +129,22 @@ type TableView struct {
 	currentItemChangedPublisher        EventPublisher
 	currentItemID                      any
 	restoringCurrentItemOnReset        bool
+	filterEdit                         *LineEdit
+	filterModel                        *FilterTableModel
+	filterText                         string
+	filterNeedle                       string
+	filterColumn                       int
+	filterChangedPublisher             EventPublisher
+	remappingRows                      bool
+	groupingEnabled                    bool
+	groupModel                         *groupTableModel
+	editable                           bool
+	edit                               *tableViewEdit
+	editToolTip                        *ToolTip
+	tree                               *TreeTableView
+	itemsReorderable                   bool
+	dropPosition                       int
+	dropIndex                          int
 }
 
 // NewTableView creates and returns a *TableView as child of the specified
@@ -154,6 +170,9 @@ func NewTableViewWithCfg(parent Container, cfg *TableViewCfg) (*TableView, error
 		customRowHeight:             cfg.CustomRowHeight,
 		scrollbarOrientation:        Horizontal | Vertical,
 		restoringCurrentItemOnReset: true,
+		filterColumn:                -1,
+		sortKeys:                    sortKeysFor(0, SortAscending),
+		dropIndex:                   -1,
 	}
 
 	tv.columns = newTableViewColumnList(tv)
@@ -295,6 +314,10 @@ func NewTableViewWithCfg(parent Container, cfg *TableViewCfg) (*TableView, error
 
 	tv.MustRegisterProperty("CurrentItem", NewReadOnlyProperty(
 		func() any {
+			if tv.tree != nil {
+				return tv.tree.CurrentItem()
+			}
+
 			if i := tv.CurrentIndex(); i > -1 {
 				if rm, ok := tv.providedModel.(reflectModel); ok {
 					return reflect.ValueOf(rm.Items()).Index(i).Interface()
@@ -338,6 +361,8 @@ func (tv *TableView) asTableView() *TableView {
 // Dispose releases the operating system resources, associated with the
 // *TableView.
 func (tv *TableView) Dispose() {
+	tv.CancelEditing()
+
 	tv.columns.unsetColumnsTV()
 
 	tv.disposeImageListAndCaches()
@@ -349,6 +374,19 @@ func (tv *TableView) Dispose() {
 		if !win.KillTimer(tv.hWnd, tableViewSelectedIndexesChangedTimerId) {
 			lastError("KillTimer")
 		}
+		if !win.KillTimer(tv.hWnd, tableViewFilterTimerId) {
+			lastError("KillTimer")
+		}
+	}
+
+	if tv.filterEdit != nil {
+		tv.filterEdit.Dispose()
+		tv.filterEdit = nil
+	}
+
+	if tv.editToolTip != nil {
+		tv.editToolTip.Dispose()
+		tv.editToolTip = nil
 	}
 
 	if tv.hwndFrozenLV != 0 {
@@ -378,9 +416,17 @@ func (tv *TableView) applyEnabled(enabled bool) {
 
 	win.EnableWindow(tv.hwndFrozenLV, enabled)
 	win.EnableWindow(tv.hwndNormalLV, enabled)
+
+	if tv.filterEdit != nil {
+		tv.filterEdit.applyEnabled(enabled)
+	}
 }
 
 func (tv *TableView) applyFont(font *Font) {
+	if tv.filterEdit != nil {
+		tv.filterEdit.applyFont(font)
+	}
+
 	if tv.customHeaderHeight > 0 || tv.customRowHeight > 0 {
 		return
 	}
@@ -681,8 +727,16 @@ func (tv *TableView) redrawItems() {
 //
 // If the model supports sorting, it will be resorted.
 func (tv *TableView) UpdateItem(index int) error {
-	if s, ok := tv.model.(Sorter); ok {
-		if err := s.Sort(s.SortedColumn(), s.SortOrder()); err != nil {
+	if index = tv.fromModelIndex(index); index == -1 {
+		return nil
+	}
+
+	return tv.updateItem(index)
+}
+
+func (tv *TableView) updateItem(index int) error {
+	if s, ok := passedThrough[Sorter](tv.model); ok {
+		if err := sortByKeys(s, sortKeysOf(s)); err != nil {
 			return err
 		}
 	} else {
@@ -715,36 +769,45 @@ func (tv *TableView) attachModel() {
 
 		count := tv.model.RowCount()
 		for i := range count {
-			if ip.ID(i) == tv.currentItemID {
-				tv.SetCurrentIndex(i)
+			if row := tv.toModelIndex(i); row > -1 && ip.ID(row) == tv.currentItemID {
+				tv.setCurrentIndex(i)
 				return
 			}
 		}
 
-		tv.SetCurrentIndex(0)
+		tv.setCurrentIndex(0)
 	}
 
 	tv.rowsResetHandlerHandle = tv.model.RowsReset().Attach(func() {
+		tv.CancelEditing()
+
 		tv.setItemCount()
 
+		if tv.remappingRows {
+			// applyFilter and regroup restore the current item and selection
+			// themselves.
+			tv.itemCountChangedPublisher.Publish()
+			return
+		}
+
 		if ip, ok := tv.providedModel.(IDProvider); ok && tv.restoringCurrentItemOnReset {
-			if _, ok := tv.model.(Sorter); !ok {
+			if _, ok := passedThrough[Sorter](tv.model); !ok {
 				restoreCurrentItemOrFallbackToFirst(ip)
 			}
 		} else {
-			tv.SetCurrentIndex(-1)
+			tv.setCurrentIndex(-1)
 		}
 
 		tv.itemCountChangedPublisher.Publish()
 	})
 
 	tv.rowChangedHandlerHandle = tv.model.RowChanged().Attach(func(row int) {
-		tv.UpdateItem(row)
+		tv.updateItem(row)
 	})
 
 	tv.rowsChangedHandlerHandle = tv.model.RowsChanged().Attach(func(from, to int) {
-		if s, ok := tv.model.(Sorter); ok {
-			s.Sort(s.SortedColumn(), s.SortOrder())
+		if s, ok := passedThrough[Sorter](tv.model); ok {
+			sortByKeys(s, sortKeysOf(s))
 		} else {
 			first, last := uintptr(from), uintptr(to)
 			win.SendMessage(tv.hwndFrozenLV, win.LVM_REDRAWITEMS, first, last)
@@ -753,6 +816,8 @@ func (tv *TableView) attachModel() {
 	})
 
 	tv.rowsInsertedHandlerHandle = tv.model.RowsInserted().Attach(func(from, to int) {
+		tv.CancelEditing()
+
 		i := tv.currentIndex
 
 		tv.setItemCount()
@@ -760,13 +825,15 @@ func (tv *TableView) attachModel() {
 		if from <= i {
 			i += 1 + to - from
 
-			tv.SetCurrentIndex(i)
+			tv.setCurrentIndex(i)
 		}
 
 		tv.itemCountChangedPublisher.Publish()
 	})
 
 	tv.rowsRemovedHandlerHandle = tv.model.RowsRemoved().Attach(func(from, to int) {
+		tv.CancelEditing()
+
 		i := tv.currentIndex
 
 		tv.setItemCount()
@@ -780,20 +847,22 @@ func (tv *TableView) attachModel() {
 		}
 
 		if index != i {
-			tv.SetCurrentIndex(index)
+			tv.setCurrentIndex(index)
 		}
 
 		tv.itemCountChangedPublisher.Publish()
 	})
 
-	if sorter, ok := tv.model.(Sorter); ok {
+	if sorter, ok := passedThrough[Sorter](tv.model); ok {
 		tv.sortChangedHandlerHandle = sorter.SortChanged().Attach(func() {
+			tv.CancelEditing()
+
 			if ip, ok := tv.providedModel.(IDProvider); ok && tv.restoringCurrentItemOnReset {
 				restoreCurrentItemOrFallbackToFirst(ip)
 			}
 
-			col := sorter.SortedColumn()
-			tv.setSortIcon(col, sorter.SortOrder())
+			tv.sortKeys = sortKeysOf(sorter)
+			tv.setSortIcons(tv.sortKeys)
 
 			tv.redrawItems()
 		})
@@ -801,13 +870,24 @@ func (tv *TableView) attachModel() {
 }
 
 func (tv *TableView) detachModel() {
+	tv.CancelEditing()
+
 	tv.model.RowsReset().Detach(tv.rowsResetHandlerHandle)
 	tv.model.RowChanged().Detach(tv.rowChangedHandlerHandle)
 	tv.model.RowsInserted().Detach(tv.rowsInsertedHandlerHandle)
 	tv.model.RowsRemoved().Detach(tv.rowsRemovedHandlerHandle)
-	if sorter, ok := tv.model.(Sorter); ok {
+	if sorter, ok := passedThrough[Sorter](tv.model); ok {
 		sorter.SortChanged().Detach(tv.sortChangedHandlerHandle)
 	}
+
+	if tv.groupModel != nil {
+		tv.groupModel.detach()
+		tv.groupModel = nil
+	}
+	if tv.filterModel != nil {
+		tv.filterModel.detach()
+		tv.filterModel = nil
+	}
 }
 
 // ItemCountChanged returns the event that is published when the number of items
@@ -830,7 +910,10 @@ func (tv *TableView) Model() any {
 // free. To support item check boxes and icons, mdl must implement
 // walk.ItemChecker and walk.ImageProvider, respectively. On-demand model
 // population for a walk.ReflectTableModel or slice requires mdl to implement
-// walk.Populator.
+// walk.Populator. To filter or sort any walk.TableModel without modifying it,
+// wrap it in a walk.FilterTableModel or walk.SortTableModel. To show its rows
+// in groups, mdl must implement walk.GroupingTableModel and grouping must be
+// enabled with SetGroupingEnabled.
 func (tv *TableView) SetModel(mdl any) error {
 	model, ok := mdl.(TableModel)
 	if !ok && mdl != nil {
@@ -852,19 +935,14 @@ func (tv *TableView) SetModel(mdl any) error {
 	}
 
 	oldProvidedModelStyler, _ := tv.providedModel.(CellStyler)
-	if styler, ok := mdl.(CellStyler); ok || tv.styler == oldProvidedModelStyler {
+	if styler, ok := passedThrough[CellStyler](mdl); ok || tv.styler == oldProvidedModelStyler {
 		tv.styler = styler
 	}
 
 	tv.providedModel = mdl
 	tv.model = model
 
-	tv.itemChecker, _ = model.(ItemChecker)
-	tv.imageProvider, _ = model.(ImageProvider)
-
 	if model != nil {
-		tv.attachModel()
-
 		if dms, ok := model.(dataMembersSetter); ok {
 			// FIXME: This depends on columns to be initialized before
 			// calling this method.
@@ -885,17 +963,36 @@ func (tv *TableView) SetModel(mdl any) error {
 			lfs.setLessFuncs(lessFuncs)
 		}
 
-		if sorter, ok := tv.model.(Sorter); ok {
-			if tv.sortedColumnIndex >= tv.visibleColumnCount() {
-				tv.sortedColumnIndex = maxi(-1, mini(0, tv.visibleColumnCount()-1))
-				tv.sortOrder = SortAscending
+		if cfs, ok := model.(compareFuncsSetter); ok {
+			compareFuncs := make([]func(a, b any) int, tv.columns.Len())
+			for i, c := range tv.columns.items {
+				compareFuncs[i] = c.compareFunc
+			}
+			cfs.setCompareFuncs(compareFuncs)
+		}
+
+		tv.model = tv.groupedModel(model, tv.filteredModel(model))
+
+		tv.attachModel()
+
+		if sorter, ok := passedThrough[Sorter](tv.model); ok {
+			visibleCount := tv.visibleColumnCount()
+
+			tv.sortKeys = slices.DeleteFunc(tv.sortKeys, func(key SortKey) bool {
+				return key.Column >= visibleCount
+			})
+			if len(tv.sortKeys) == 0 {
+				tv.sortKeys = sortKeysFor(mini(0, visibleCount-1), SortAscending)
 			}
 
-			sorter.Sort(tv.sortedColumnIndex, tv.sortOrder)
+			sortByKeys(sorter, tv.sortKeys)
 		}
 	}
 
-	tv.SetCurrentIndex(-1)
+	tv.itemChecker, _ = passedThrough[ItemChecker](tv.model)
+	tv.imageProvider, _ = passedThrough[ImageProvider](tv.model)
+
+	tv.setCurrentIndex(-1)
 
 	tv.setItemCount()
 
@@ -906,6 +1003,13 @@ func (tv *TableView) SetModel(mdl any) error {
 
 // TableModel returns the TableModel of the TableView.
 func (tv *TableView) TableModel() TableModel {
+	if tv.filterModel != nil {
+		return tv.filterModel.SourceModel()
+	}
+	if tv.groupModel != nil {
+		return tv.groupModel.SourceModel()
+	}
+
 	return tv.model
 }
 
@@ -1061,6 +1165,54 @@ func (tv *TableView) visibleColumns() []*TableViewColumn {
 	return cols
 }
 
+// formatValue returns the text the TableView shows for value in column col.
+func (tv *TableView) formatValue(col int, value any) string {
+	if format := tv.columns.items[col].formatFunc; format != nil {
+		return format(value)
+	}
+
+	switch val := value.(type) {
+	case string:
+		return val
+
+	case float32:
+		prec := tv.columns.items[col].precision
+		if prec == 0 {
+			prec = 2
+		}
+		return FormatFloatGrouped(float64(val), prec)
+
+	case float64:
+		prec := tv.columns.items[col].precision
+		if prec == 0 {
+			prec = 2
+		}
+		return FormatFloatGrouped(val, prec)
+
+	case time.Time:
+		if val.Year() > 1601 {
+			return val.Format(tv.columns.items[col].format)
+		}
+
+	case bool:
+		if val {
+			return checkmark
+		}
+
+	case *big.Rat:
+		prec := tv.columns.items[col].precision
+		if prec == 0 {
+			prec = 2
+		}
+		return formatBigRatGrouped(val, prec)
+
+	default:
+		return fmt.Sprintf(tv.columns.items[col].format, val)
+	}
+
+	return ""
+}
+
 /*func (tv *TableView) selectedColumnIndex() int {
 	return tv.fromLVColIdx(tv.SendMessage(LVM_GETSELECTEDCOLUMN, 0, 0))
 }*/
@@ -1069,9 +1221,9 @@ func (tv *TableView) visibleColumns() []*TableViewColumn {
 // 	tv.SendMessage(win.LVM_SETSELECTEDCOLUMN, uintptr(tv.toLVColIdx(index)), 0)
 // }
 
-func (tv *TableView) setSortIcon(index int, order SortOrder) error {
-	idx := int(tv.toLVColIdx(index))
-
+// setSortIcons shows the sort order of each of keys in the header of its
+// column.
+func (tv *TableView) setSortIcons(keys []SortKey) error {
 	frozenCount := tv.visibleFrozenColumnCount()
 
 	for i, col := range tv.visibleColumns() {
@@ -1079,6 +1231,8 @@ func (tv *TableView) setSortIcon(index int, order SortOrder) error {
 			Mask: win.HDI_FORMAT,
 		}
 
+		colIndex := tv.columns.Index(col)
+
 		var headerHwnd win.HWND
 		var offset int
 		if col.frozen {
@@ -1095,18 +1249,16 @@ func (tv *TableView) setSortIcon(index int, order SortOrder) error {
 			return newError("SendMessage(HDM_GETITEM)")
 		}
 
-		if i == idx {
-			switch order {
+		item.Fmt &^= win.HDF_SORTDOWN | win.HDF_SORTUP
+
+		if k := slices.IndexFunc(keys, func(key SortKey) bool { return key.Column == colIndex }); k > -1 {
+			switch keys[k].Order {
 			case SortAscending:
-				item.Fmt &^= win.HDF_SORTDOWN
 				item.Fmt |= win.HDF_SORTUP
 
 			case SortDescending:
-				item.Fmt &^= win.HDF_SORTUP
 				item.Fmt |= win.HDF_SORTDOWN
 			}
-		} else {
-			item.Fmt &^= win.HDF_SORTDOWN | win.HDF_SORTUP
 		}
 
 		if win.SendMessage(headerHwnd, win.HDM_SETITEM, iPtr, itemPtr) == 0 {
@@ -1161,13 +1313,17 @@ func (tv *TableView) CurrentItemChanged() *Event {
 // CurrentIndex returns the index of the current item, or -1 if there is no
 // current item.
 func (tv *TableView) CurrentIndex() int {
-	return tv.currentIndex
+	return tv.toModelIndex(tv.currentIndex)
 }
 
 // SetCurrentIndex sets the index of the current item.
 //
 // Call this with a value of -1 to have no current item.
 func (tv *TableView) SetCurrentIndex(index int) error {
+	return tv.setCurrentIndex(tv.fromModelIndex(index))
+}
+
+func (tv *TableView) setCurrentIndex(index int) error {
 	if tv.inSetCurrentIndex {
 		return nil
 	}
@@ -1216,8 +1372,9 @@ func (tv *TableView) SetCurrentIndex(index int) error {
 			return newError("SendMessage(LVM_ENSUREVISIBLE)")
 		}
 
-		if ip, ok := tv.providedModel.(IDProvider); ok && tv.restoringCurrentItemOnReset {
-			if id := ip.ID(index); id != tv.currentItemID {
+		// Group headers have no ID, so the current item ID stays.
+		if ip, ok := tv.providedModel.(IDProvider); ok && tv.restoringCurrentItemOnReset && tv.toModelIndex(index) > -1 {
+			if id := ip.ID(tv.toModelIndex(index)); id != tv.currentItemID {
 				tv.currentItemID = id
 				if tv.itemStateChangedEventDelay == 0 {
 					defer tv.currentItemChangedPublisher.Publish()
@@ -1272,16 +1429,24 @@ func (tv *TableView) IndexAt(x, y int) int {
 
 	win.SendMessage(hwnd, win.LVM_HITTEST, 0, uintptr(unsafe.Pointer(&hti)))
 
-	return int(hti.IItem)
+	return tv.toModelIndex(int(hti.IItem))
 }
 
 // ItemVisible returns whether the item at position index is visible.
 func (tv *TableView) ItemVisible(index int) bool {
+	if index = tv.fromModelIndex(index); index == -1 {
+		return false
+	}
+
 	return win.SendMessage(tv.hwndNormalLV, win.LVM_ISITEMVISIBLE, uintptr(index), 0) != 0
 }
 
 // EnsureItemVisible ensures the item at position index is visible, scrolling if necessary.
 func (tv *TableView) EnsureItemVisible(index int) {
+	if index = tv.fromModelIndex(index); index == -1 {
+		return
+	}
+
 	win.SendMessage(tv.hwndNormalLV, win.LVM_ENSUREVISIBLE, uintptr(index), 0)
 }
 
@@ -1330,15 +1495,38 @@ func (tv *TableView) SetMultiSelection(multiSel bool) error {
 
 // SelectedIndexes returns the indexes of the currently selected items.
 func (tv *TableView) SelectedIndexes() []int {
-	indexes := make([]int, len(tv.selectedIndexes))
+	indexes := make([]int, 0, len(tv.selectedIndexes))
 
-	copy(indexes, tv.selectedIndexes)
+	for _, index := range tv.selectedIndexes {
+		// Group headers have no index in the model.
+		if index = tv.toModelIndex(index); index > -1 {
+			indexes = append(indexes, index)
+		}
+	}
 
 	return indexes
 }
 
 // SetSelectedIndexes sets the indexes of the currently selected items.
+//
+// While the filter bar is visible, items the filter hides are not selected.
+// Neither are items of collapsed groups.
 func (tv *TableView) SetSelectedIndexes(indexes []int) error {
+	if tv.filterModel != nil || tv.groupModel != nil {
+		shown := make([]int, 0, len(indexes))
+		for _, index := range indexes {
+			if index != -1 {
+				if index = tv.fromModelIndex(index); index == -1 {
+					continue
+				}
+			}
+
+			shown = append(shown, index)
+		}
+
+		indexes = shown
+	}
+
 	tv.inSetSelectedIndexes = true
 	defer func() {
 		tv.inSetSelectedIndexes = false
@@ -1602,10 +1790,16 @@ func (tv *TableView) SetIgnoreNowhere(value bool) {
 type tableViewState struct {
 	SortColumnName     string
 	SortOrder          SortOrder
+	SortKeys           []*tableViewSortKeyState
 	ColumnDisplayOrder []string
 	Columns            []*tableViewColumnState
 }
 
+type tableViewSortKeyState struct {
+	ColumnName string
+	Order      SortOrder
+}
+
 type tableViewColumnState struct {
 	Name         string
 	Title        string
@@ -1621,14 +1815,45 @@ func (tv *TableView) SaveState() error {
 		return nil
 	}
 
+	if err := tv.updateState(); err != nil {
+		return err
+	}
+
+	state, err := json.Marshal(tv.state)
+	if err != nil {
+		return err
+	}
+
+	return tv.WriteState(string(state))
+}
+
+// updateState updates tv.state to the UI state of the *TableView.
+func (tv *TableView) updateState() error {
 	if tv.state == nil {
 		tv.state = new(tableViewState)
 	}
 
 	tvs := tv.state
 
-	tvs.SortColumnName = tv.columns.items[tv.sortedColumnIndex].name
-	tvs.SortOrder = tv.sortOrder
+	tvs.SortColumnName = ""
+	tvs.SortOrder = SortAscending
+	tvs.SortKeys = nil
+
+	for _, key := range tv.sortKeys {
+		if key.Column < 0 || key.Column >= tv.columns.Len() {
+			continue
+		}
+
+		name := tv.columns.items[key.Column].name
+
+		if tvs.SortKeys == nil {
+			// Keep the primary key where older versions look for it.
+			tvs.SortColumnName = name
+			tvs.SortOrder = key.Order
+		}
+
+		tvs.SortKeys = append(tvs.SortKeys, &tableViewSortKeyState{ColumnName: name, Order: key.Order})
+	}
 
 	// tvs.Columns = make([]tableViewColumnState, tv.columns.Len())
 
@@ -1684,12 +1909,7 @@ func (tv *TableView) SaveState() error {
 		tvs.ColumnDisplayOrder[i] = visibleCols[j].name
 	}
 
-	state, err := json.Marshal(tvs)
-	if err != nil {
-		return err
-	}
-
-	return tv.WriteState(string(state))
+	return nil
 }
 
 // RestoreState restores the UI state of the *TableView from the settings.
@@ -1709,12 +1929,17 @@ func (tv *TableView) RestoreState() error {
 		tv.state = new(tableViewState)
 	}
 
-	tvs := tv.state
-
-	if err := json.Unmarshal(([]byte)(state), tvs); err != nil {
+	if err := json.Unmarshal(([]byte)(state), tv.state); err != nil {
 		return err
 	}
 
+	return tv.applyState()
+}
+
+// applyState applies the UI state in tv.state to the *TableView.
+func (tv *TableView) applyState() error {
+	tvs := tv.state
+
 	name2tvc := make(map[string]*TableViewColumn)
 
 	for _, tvc := range tv.columns.items {
@@ -1807,25 +2032,44 @@ func (tv *TableView) RestoreState() error {
 		}
 	}
 
-	for i, c := range tvs.Columns {
-		if c.Name == tvs.SortColumnName && i < visibleCount {
-			tv.sortedColumnIndex = i
-			tv.sortOrder = tvs.SortOrder
-			break
+	keyStates := tvs.SortKeys
+	if len(keyStates) == 0 && tvs.SortColumnName != "" {
+		// Saved by an older version.
+		keyStates = []*tableViewSortKeyState{{ColumnName: tvs.SortColumnName, Order: tvs.SortOrder}}
+	}
+
+	sorter, _ := passedThrough[Sorter](tv.model)
+
+	var keys []SortKey
+	for _, ks := range keyStates {
+		col := slices.IndexFunc(tv.columns.items, func(tvc *TableViewColumn) bool {
+			return tvc.name == ks.ColumnName
+		})
+		if col < 0 || col >= visibleCount || sorter != nil && !sorter.ColumnSortable(col) {
+			continue
 		}
+		if slices.ContainsFunc(keys, func(key SortKey) bool { return key.Column == col }) {
+			continue
+		}
+
+		keys = append(keys, SortKey{Column: col, Order: ks.Order})
+	}
+
+	if len(keys) > 0 {
+		tv.sortKeys = keys
 	}
 
-	if sorter, ok := tv.model.(Sorter); ok {
-		if !sorter.ColumnSortable(tv.sortedColumnIndex) {
+	if sorter != nil {
+		if len(keys) == 0 {
 			for i := range tvs.Columns {
 				if sorter.ColumnSortable(i) {
-					tv.sortedColumnIndex = i
+					tv.sortKeys = sortKeysFor(i, SortAscending)
 					break
 				}
 			}
 		}
 
-		sorter.Sort(tv.sortedColumnIndex, tvs.SortOrder)
+		sortByKeys(sorter, tv.sortKeys)
 	}
 
 	return nil
@@ -1908,7 +2152,13 @@ func tableViewFrozenLVWndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr
 		tableViewNormalLVWndProc(tv.hwndNormalLV, msg, wp, lp)
 	}
 
-	return tv.lvWndProc(tv.frozenLVOrigWndProcPtr, hwnd, msg, wp, lp)
+	result := tv.lvWndProc(tv.frozenLVOrigWndProcPtr, hwnd, msg, wp, lp)
+
+	if msg == win.WM_PAINT {
+		tv.drawDropIndicator(hwnd)
+	}
+
+	return result
 }
 
 func tableViewNormalLVWndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr {
@@ -1930,6 +2180,10 @@ func tableViewNormalLVWndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr
 
 	result := tv.lvWndProc(tv.normalLVOrigWndProcPtr, hwnd, msg, wp, lp)
 
+	if msg == win.WM_PAINT {
+		tv.drawDropIndicator(hwnd)
+	}
+
 	var off uint32 = win.WS_HSCROLL | win.WS_VSCROLL
 	if tv.scrollbarOrientation&Horizontal != 0 {
 		off &^= win.WS_HSCROLL
@@ -1979,13 +2233,21 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 
 		tv.itemIndexOfLastMouseButtonDown = int(hti.IItem)
 
+		if (msg == win.WM_LBUTTONDOWN || msg == win.WM_LBUTTONDBLCLK) && tv.groupHeaderClicked(int(hti.IItem)) {
+			return 0
+		}
+
+		if (msg == win.WM_LBUTTONDOWN || msg == win.WM_LBUTTONDBLCLK) && tv.tree != nil && tv.tree.glyphClicked(hwnd, int(hti.IItem), int(hti.Pt.X)) {
+			return 0
+		}
+
 		if hti.Flags == win.LVHT_NOWHERE {
 			if tv.MultiSelection() {
 				tv.publishNextSelClear = true
 			} else {
 				if tv.CheckBoxes() {
 					if tv.currentIndex > -1 {
-						tv.SetCurrentIndex(-1)
+						tv.setCurrentIndex(-1)
 					}
 				} else {
 					// We keep the current item, if in single item selection mode without check boxes.
@@ -2014,6 +2276,10 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 				tv.currentIndexChangedPublisher.Publish()
 				tv.currentItemChangedPublisher.Publish()
 			}
+
+			if msg == win.WM_LBUTTONDBLCLK && tv.editCellAt(hwnd, lp) {
+				return 0
+			}
 		}
 
 	case win.WM_LBUTTONUP, win.WM_RBUTTONUP:
@@ -2036,6 +2302,23 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 		win.SendMessage(hwndOther, msg, wp, lp)
 
 	case win.WM_KEYDOWN:
+		if tv.handleGroupKeyDown(Key(wp)) {
+			return 0
+		}
+
+		if tv.tree != nil && tv.tree.handleKeyDown(Key(wp)) {
+			return 0
+		}
+
+		if Key(wp) == KeyF2 && ModifiersDown() == 0 && tv.editCurrentItem() {
+			return 0
+		}
+
+		if Key(wp) == KeyC && ModifiersDown() == ModControl {
+			tv.CopySelection()
+			return 0
+		}
+
 		if wp == win.VK_SPACE &&
 			tv.currentIndex > -1 &&
 			tv.itemChecker != nil &&
@@ -2049,6 +2332,13 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 	case win.WM_KEYUP:
 		tv.handleKeyUp(wp, lp)
 
+	case win.WM_CHAR:
+		// Typing starts editing the current item with the typed character.
+		if wp > ' ' && ModifiersDown()&(ModControl|ModAlt) == 0 && tv.editCurrentItem() {
+			win.SendMessage(win.GetFocus(), msg, wp, lp)
+			return 0
+		}
+
 	case win.WM_NOTIFY:
 		nmh := ((*win.NMHDR)(unsafe.Pointer(lp)))
 		switch nmh.HwndFrom {
@@ -2059,6 +2349,9 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 		}
 
 		switch nmh.Code {
+		case win.LVN_BEGINDRAG:
+			tv.beginDrag()
+
 		case win.LVN_GETDISPINFO:
 			di := (*win.NMLVDISPINFO)(unsafe.Pointer(lp))
 
@@ -2068,50 +2361,14 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 				break
 			}
 
+			group, isGroupHeader := tv.groupHeader(row)
+
 			if di.Item.Mask&win.LVIF_TEXT > 0 {
-				value := tv.model.Value(row, col)
 				var text string
-				if format := tv.columns.items[col].formatFunc; format != nil {
-					text = format(value)
-				} else {
-					switch val := value.(type) {
-					case string:
-						text = val
-
-					case float32:
-						prec := tv.columns.items[col].precision
-						if prec == 0 {
-							prec = 2
-						}
-						text = FormatFloatGrouped(float64(val), prec)
-
-					case float64:
-						prec := tv.columns.items[col].precision
-						if prec == 0 {
-							prec = 2
-						}
-						text = FormatFloatGrouped(val, prec)
-
-					case time.Time:
-						if val.Year() > 1601 {
-							text = val.Format(tv.columns.items[col].format)
-						}
-
-					case bool:
-						if val {
-							text = checkmark
-						}
-
-					case *big.Rat:
-						prec := tv.columns.items[col].precision
-						if prec == 0 {
-							prec = 2
-						}
-						text = formatBigRatGrouped(val, prec)
-
-					default:
-						text = fmt.Sprintf(tv.columns.items[col].format, val)
-					}
+				if !isGroupHeader {
+					text = tv.formatValue(col, tv.model.Value(row, col))
+				} else if di.Item.ISubItem == 0 {
+					text = tv.groupHeaderText(group)
 				}
 
 				utf16 := syscall.StringToUTF16(text)
@@ -2121,14 +2378,18 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 				(*buf)[max-1] = 0
 			}
 
-			if (tv.imageProvider != nil || tv.styler != nil) && di.Item.Mask&win.LVIF_IMAGE > 0 {
+			if isGroupHeader {
+				break
+			}
+
+			if (tv.imageProvider != nil || tv.cellStyler() != nil) && di.Item.Mask&win.LVIF_IMAGE > 0 {
 				var image any
 				if di.Item.ISubItem == 0 {
 					if ip := tv.imageProvider; ip != nil && image == nil {
 						image = ip.Image(row)
 					}
 				}
-				if styler := tv.styler; styler != nil && image == nil {
+				if styler := tv.cellStyler(); styler != nil && image == nil {
 					tv.style.row = row
 					tv.style.col = col
 					tv.style.bounds = Rectangle{}
@@ -2171,13 +2432,22 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 
 			if nmlvcd.IIconPhase == 0 {
 				row := int(nmlvcd.Nmcd.DwItemSpec)
+
+				if nmlvcd.Nmcd.DwDrawStage == win.CDDS_ITEMPREPAINT {
+					if group, ok := tv.groupHeader(row); ok {
+						tv.drawGroupHeader(hwnd, nmlvcd, group)
+
+						return win.CDRF_SKIPDEFAULT
+					}
+				}
+
 				col := tv.fromLVColIdx(hwnd == tv.hwndFrozenLV, nmlvcd.ISubItem)
 				if col == -1 {
 					break
 				}
 
 				applyCellStyle := func() int {
-					if tv.styler != nil {
+					if styler := tv.cellStyler(); styler != nil {
 						dpi := tv.DPI()
 
 						tv.style.row = row
@@ -2190,7 +2460,7 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 						tv.style.Font = nil
 						tv.style.Image = nil
 
-						tv.styler.StyleCell(&tv.style)
+						styler.StyleCell(&tv.style)
 
 						defer func() {
 							tv.style.bounds = Rectangle{}
@@ -2242,7 +2512,7 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 					tv.style.BackgroundColor = tv.itemBGColor
 					tv.style.TextColor = tv.itemTextColor
 
-					if tv.styler != nil {
+					if styler := tv.cellStyler(); styler != nil {
 						tv.style.row = row
 						tv.style.col = -1
 						tv.style.bounds = rectangleFromRECT(nmlvcd.Nmcd.Rc)
@@ -2251,7 +2521,7 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 						tv.style.Font = nil
 						tv.style.Image = nil
 
-						tv.styler.StyleCell(&tv.style)
+						styler.StyleCell(&tv.style)
 
 						tv.itemFont = tv.style.Font
 					}
@@ -2290,6 +2560,14 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 						win.SelectObject(nmlvcd.Nmcd.Hdc, win.HGDIOBJ(tv.itemFont.handleForDPI(tv.DPI())))
 					}
 
+					if tv.tree != nil && col == 0 {
+						if applyCellStyle() != win.CDRF_SKIPDEFAULT {
+							tv.tree.drawTreeCell(hwnd, nmlvcd, row)
+						}
+
+						return win.CDRF_SKIPDEFAULT
+					}
+
 					if applyCellStyle() == win.CDRF_SKIPDEFAULT && win.IsAppThemed() {
 						return win.CDRF_SKIPDEFAULT
 					}
@@ -2310,6 +2588,8 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 			return win.CDRF_SKIPPOSTPAINT
 
 		case win.LVN_BEGINSCROLL:
+			tv.finishEdit()
+
 			if tv.scrolling {
 				break
 			}
@@ -2329,21 +2609,21 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 
 			col := tv.fromLVColIdx(hwnd == tv.hwndFrozenLV, nmlv.ISubItem)
 
-			if sorter, ok := tv.model.(Sorter); ok && sorter.ColumnSortable(col) {
-				prevCol := sorter.SortedColumn()
-				var order SortOrder
-				if col != prevCol || sorter.SortOrder() == SortDescending {
-					order = SortAscending
-				} else {
-					order = SortDescending
-				}
-				tv.sortedColumnIndex = col
-				tv.sortOrder = order
-				sorter.Sort(col, order)
+			if sorter, ok := passedThrough[Sorter](tv.model); ok && sorter.ColumnSortable(col) {
+				// Shift-clicking adds a secondary sort key.
+				extend := ModifiersDown()&ModShift != 0
+
+				tv.sortKeys = toggledSortKeys(sortKeysOf(sorter), col, extend)
+				sortByKeys(sorter, tv.sortKeys)
 			}
 
 			tv.columnClickedPublisher.Publish(col)
 
+		case lvnColumnDropDown:
+			nmlv := (*win.NMLISTVIEW)(unsafe.Pointer(lp))
+
+			tv.showFilterColumnMenu(hwnd, nmlv.ISubItem)
+
 		case win.LVN_ITEMCHANGED:
 			nmlv := (*win.NMLISTVIEW)(unsafe.Pointer(lp))
 
@@ -2379,9 +2659,9 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 						lastError("SetTimer")
 					}
 
-					tv.SetCurrentIndex(int(nmlv.IItem))
+					tv.setCurrentIndex(int(nmlv.IItem))
 				} else {
-					tv.SetCurrentIndex(int(nmlv.IItem))
+					tv.setCurrentIndex(int(nmlv.IItem))
 				}
 			}
 
@@ -2412,7 +2692,7 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 			}
 
 			if int(nmia.IItem) != tv.currentIndex {
-				tv.SetCurrentIndex(int(nmia.IItem))
+				tv.setCurrentIndex(int(nmia.IItem))
 				tv.currentIndexChangedPublisher.Publish()
 				tv.currentItemChangedPublisher.Publish()
 			}
@@ -2421,6 +2701,9 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 
 		case win.HDN_ITEMCHANGING:
 			tv.updateLVSizes()
+
+		case win.HDN_ITEMCHANGED:
+			tv.layoutEdit()
 		}
 
 	case win.WM_UPDATEUISTATE:
@@ -2608,6 +2891,12 @@ func (tv *TableView) WndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr
 			return tableViewNormalLVWndProc(nmh.HwndFrom, msg, wp, lp)
 		}
 
+	case win.WM_COMMAND:
+		if tv.filterEdit != nil && win.HWND(lp) == tv.filterEdit.hWnd {
+			// The window that sent the notification shall handle it itself.
+			return tv.filterEdit.WndProc(hwnd, msg, wp, lp)
+		}
+
 	case win.WM_WINDOWPOSCHANGED:
 		wp := (*win.WINDOWPOS)(unsafe.Pointer(lp))
 
@@ -2626,6 +2915,7 @@ func (tv *TableView) WndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr
 		}
 
 		tv.updateLVSizes()
+		tv.layoutEdit()
 
 		// FIXME: The InvalidateRect and redrawItems calls below prevent
 		// painting glitches on resize. Though this seems to work reasonably
@@ -2658,6 +2948,11 @@ func (tv *TableView) WndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr
 
 		case tableViewSelectedIndexesChangedTimerId:
 			tv.selectedIndexesChangedPublisher.Publish()
+
+		case tableViewFilterTimerId:
+			if tv.filterEdit != nil {
+				tv.SetFilterText(tv.filterEdit.Text())
+			}
 		}
 
 	case win.WM_MEASUREITEM:
@@ -2709,14 +3004,23 @@ func (tv *TableView) updateLVSizesWithSpecialCare(needSpecialCare bool) {
 
 	cb := tv.ClientBoundsPixels()
 
-	win.MoveWindow(tv.hwndNormalLV, int32(widthPixels), 0, int32(cb.Width-widthPixels), int32(cb.Height), true)
+	if tv.filterEdit != nil {
+		height := tv.filterEdit.sizeHintForLimit(lineEditMinChars).Height
+
+		win.MoveWindow(tv.filterEdit.hWnd, 0, 0, int32(cb.Width), int32(height), true)
+
+		cb.Y += height
+		cb.Height -= height
+	}
+
+	win.MoveWindow(tv.hwndNormalLV, int32(widthPixels), int32(cb.Y), int32(cb.Width-widthPixels), int32(cb.Height), true)
 
 	var sbh int
 	if hasWindowLongBits(tv.hwndNormalLV, win.GWL_STYLE, win.WS_HSCROLL) {
 		sbh = int(win.GetSystemMetricsForDpi(win.SM_CYHSCROLL, uint32(dpi)))
 	}
 
-	win.MoveWindow(tv.hwndFrozenLV, 0, 0, int32(widthPixels), int32(cb.Height-sbh), true)
+	win.MoveWindow(tv.hwndFrozenLV, 0, int32(cb.Y), int32(widthPixels), int32(cb.Height-sbh), true)
 
 	if needSpecialCare {
 		tv.updateLVSizesNeedsSpecialCare = true
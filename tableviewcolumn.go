@@ -26,7 +26,10 @@ type TableViewColumn struct {
 	titleOverride string
 	width         int
 	lessFunc      func(i, j int) bool
+	compareFunc   func(a, b any) int
 	formatFunc    func(value any) string
+	editor        CellEditor
+	validator     Validator
 	visible       bool
 	frozen        bool
 }
@@ -344,6 +347,22 @@ func (tvc *TableViewColumn) SetLessFunc(lessFunc func(i, j int) bool) {
 	tvc.lessFunc = lessFunc
 }
 
+// CompareFunc returns the compare func of this TableViewColumn.
+func (tvc *TableViewColumn) CompareFunc() func(a, b any) int {
+	return tvc.compareFunc
+}
+
+// SetCompareFunc sets the compare func of this TableViewColumn.
+//
+// The func compares two values of the column and returns a negative number,
+// zero or a positive number if a sorts before, like or after b in ascending
+// order. It is used for sorting slices, ReflectTableModels, maps and
+// SortTableModels, for which the TableView sets the model. A LessFunc takes
+// precedence.
+func (tvc *TableViewColumn) SetCompareFunc(compareFunc func(a, b any) int) {
+	tvc.compareFunc = compareFunc
+}
+
 // FormatFunc returns the custom format func of this TableViewColumn.
 func (tvc *TableViewColumn) FormatFunc() func(value any) string {
 	return tvc.formatFunc
@@ -354,6 +373,30 @@ func (tvc *TableViewColumn) SetFormatFunc(formatFunc func(value any) string) {
 	tvc.formatFunc = formatFunc
 }
 
+// Editor returns the CellEditor the cells of the TableViewColumn are edited
+// with.
+func (tvc *TableViewColumn) Editor() CellEditor {
+	return tvc.editor
+}
+
+// SetEditor sets the CellEditor the cells of the TableViewColumn are edited
+// with. The cells of a column without an editor are not editable.
+func (tvc *TableViewColumn) SetEditor(editor CellEditor) {
+	tvc.editor = editor
+}
+
+// Validator returns the Validator of the values entered into the cells of the
+// TableViewColumn.
+func (tvc *TableViewColumn) Validator() Validator {
+	return tvc.validator
+}
+
+// SetValidator sets the Validator of the values entered into the cells of the
+// TableViewColumn.
+func (tvc *TableViewColumn) SetValidator(validator Validator) {
+	tvc.validator = validator
+}
+
 func (tvc *TableViewColumn) indexInListView() int32 {
 	if tvc.tv == nil {
 		return -1
@@ -464,6 +507,11 @@ func (tvc *TableViewColumn) getLVCOLUMN() *win.LVCOLUMN {
 		lvc.Fmt = 1
 	}
 
+	if tvc.tv != nil && tvc.tv.filterEdit != nil {
+		// The drop-down restricts the filter to the column.
+		lvc.Fmt |= lvcfmtSplitButton
+	}
+
 	return &lvc
 }
 
@@ -0,0 +1,228 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"slices"
+	"strings"
+	"unsafe"
+
+	"github.com/wuc656/win"
+)
+
+// ItemsReorderable returns whether the user can reorder the rows of the
+// TableView by drag and drop.
+func (tv *TableView) ItemsReorderable() bool {
+	return tv.itemsReorderable
+}
+
+// SetItemsReorderable sets whether the user can reorder the rows of the
+// TableView by drag and drop. The model must implement ItemsMover. While the
+// rows are filtered, grouped or sorted, they cannot be reordered, as sorting
+// would undo the move. The sort is not cleared for a drop; sort by no column to
+// allow reordering again.
+func (tv *TableView) SetItemsReorderable(reorderable bool) error {
+	tv.itemsReorderable = reorderable
+
+	if reorderable {
+		return tv.ensureDropTarget().register()
+	}
+
+	return nil
+}
+
+// DropIndex returns the index of the row, in front of which a drag over the
+// TableView would drop, or -1 if there is no drag over it. Handlers of
+// DragEnter, DragOver and Drop can use it to insert between rows. It is equal
+// to the row count for drops after the last row.
+func (tv *TableView) DropIndex() int {
+	return tv.dropIndex
+}
+
+// itemsMover returns the ItemsMover rows can be reordered with, or nil.
+func (tv *TableView) itemsMover() ItemsMover {
+	if !tv.itemsReorderable || tv.filterModel != nil || tv.groupModel != nil {
+		return nil
+	}
+
+	if sorter, ok := passedThrough[Sorter](tv.model); ok && len(sortKeysOf(sorter)) > 0 {
+		return nil
+	}
+
+	mover, _ := tv.model.(ItemsMover)
+
+	return mover
+}
+
+// beginDrag lets the user drag the selected rows to reorder them. They can be
+// dropped on other applications as text.
+func (tv *TableView) beginDrag() {
+	if tv.itemsMover() == nil {
+		return
+	}
+
+	var text strings.Builder
+	if table := tv.exportTable(true); len(table.rows) > 0 {
+		table.crlf = true
+		table.write(&text, ExportTSV)
+	}
+
+	tv.DoDragDrop(ClipboardContent{Text: text.String()}, DropEffectCopy|DropEffectMove)
+}
+
+func (tv *TableView) isReorderDrag(args *DragDropEventArgs) bool {
+	return args != nil && args.Source() == tv.window && tv.itemsMover() != nil
+}
+
+func (tv *TableView) setDropPosition(p Point) {
+	pt := win.POINT{X: int32(p.X), Y: int32(p.Y)}
+	win.ClientToScreen(tv.hWnd, &pt)
+	win.ScreenToClient(tv.hwndNormalLV, &pt)
+
+	count := tv.rowCount()
+	top := int(win.SendMessage(tv.hwndNormalLV, win.LVM_GETTOPINDEX, 0, 0))
+
+	if count == 0 {
+		tv.dropPosition = 0
+		return
+	}
+
+	rc := win.RECT{Left: win.LVIR_BOUNDS}
+	win.SendMessage(tv.hwndNormalLV, win.LVM_GETITEMRECT, uintptr(top), uintptr(unsafe.Pointer(&rc)))
+
+	height := rc.Bottom - rc.Top
+	if height <= 0 {
+		tv.dropPosition = top
+		return
+	}
+
+	// Drops go to the gap between rows nearest to the mouse.
+	offset := pt.Y - rc.Top + height/2
+	if offset < 0 {
+		offset = 0
+	}
+	tv.dropPosition = min(top+int(offset/height), count)
+
+	// Scroll, while the mouse is over the first or last visible row.
+	var rcClient win.RECT
+	win.GetClientRect(tv.hwndNormalLV, &rcClient)
+
+	if pt.Y < rc.Top+height/2 && top > 0 {
+		win.SendMessage(tv.hwndNormalLV, win.LVM_SCROLL, 0, uintptr(-height))
+	} else if pt.Y > rcClient.Bottom-height/2 && pt.Y <= rcClient.Bottom {
+		win.SendMessage(tv.hwndNormalLV, win.LVM_SCROLL, 0, uintptr(height))
+	}
+}
+
+func (tv *TableView) dropEffect(args *DragDropEventArgs, effect DropEffect) DropEffect {
+	if tv.isReorderDrag(args) {
+		effect = DropEffectMove
+	}
+
+	index := -1
+	if effect != DropEffectNone {
+		index = tv.dropPosition
+	}
+	tv.setDropIndex(index)
+
+	return effect
+}
+
+func (tv *TableView) endDrag(args *DragDropEventArgs, effect DropEffect, dropped bool) DropEffect {
+	index := tv.dropIndex
+	tv.setDropIndex(-1)
+
+	if !dropped || !tv.isReorderDrag(args) || index == -1 {
+		return effect
+	}
+
+	indexes := tv.SelectedIndexes()
+	if len(indexes) == 0 && tv.currentIndex != -1 {
+		indexes = []int{tv.currentIndex}
+	}
+	if len(indexes) == 0 {
+		return DropEffectNone
+	}
+
+	if err := tv.itemsMover().MoveItems(indexes, index); err != nil {
+		return DropEffectNone
+	}
+
+	start := movedItemsStart(indexes, index)
+	moved := make([]int, len(slices.Compact(slices.Sorted(slices.Values(indexes)))))
+	for i := range moved {
+		moved[i] = start + i
+	}
+
+	tv.SetSelectedIndexes(moved)
+	tv.SetCurrentIndex(start)
+
+	return DropEffectMove
+}
+
+func (tv *TableView) rowCount() int {
+	if tv.model == nil {
+		return 0
+	}
+
+	return tv.model.RowCount()
+}
+
+func (tv *TableView) setDropIndex(index int) {
+	if index == tv.dropIndex {
+		return
+	}
+
+	tv.dropIndex = index
+
+	win.InvalidateRect(tv.hwndFrozenLV, nil, false)
+	win.InvalidateRect(tv.hwndNormalLV, nil, false)
+}
+
+// drawDropIndicator draws the line between rows, where a drag would drop, on
+// top of the list view hwnd.
+func (tv *TableView) drawDropIndicator(hwnd win.HWND) {
+	if tv.dropIndex == -1 {
+		return
+	}
+
+	count := tv.rowCount()
+	top := int(win.SendMessage(hwnd, win.LVM_GETTOPINDEX, 0, 0))
+
+	var rcClient win.RECT
+	win.GetClientRect(hwnd, &rcClient)
+
+	// Rows start below the header.
+	var rcTop win.RECT
+	if hwndHdr := win.HWND(win.SendMessage(hwnd, win.LVM_GETHEADER, 0, 0)); hwndHdr != 0 && win.IsWindowVisible(hwndHdr) {
+		win.GetWindowRect(hwndHdr, &rcTop)
+		rcTop.Top = rcTop.Bottom - rcTop.Top
+	}
+	if count > 0 {
+		rcTop = win.RECT{Left: win.LVIR_BOUNDS}
+		win.SendMessage(hwnd, win.LVM_GETITEMRECT, uintptr(top), uintptr(unsafe.Pointer(&rcTop)))
+	}
+
+	var y int32
+	switch {
+	case count == 0:
+		y = rcTop.Top
+
+	case tv.dropIndex < count:
+		rc := win.RECT{Left: win.LVIR_BOUNDS}
+		win.SendMessage(hwnd, win.LVM_GETITEMRECT, uintptr(tv.dropIndex), uintptr(unsafe.Pointer(&rc)))
+		y = rc.Top
+
+	default:
+		rc := win.RECT{Left: win.LVIR_BOUNDS}
+		win.SendMessage(hwnd, win.LVM_GETITEMRECT, uintptr(count-1), uintptr(unsafe.Pointer(&rc)))
+		y = rc.Bottom
+	}
+
+	drawDropIndicator(hwnd, rcClient.Left, rcClient.Right, y, rcTop.Top, tv.DPI())
+}
@@ -0,0 +1,525 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"unsafe"
+
+	"github.com/wuc656/win"
+)
+
+// tableViewEdit is the state of the cell a TableView is editing.
+type tableViewEdit struct {
+	row        int // the row in the list views
+	modelRow   int
+	col        int
+	editor     CellEditor
+	host       *Composite
+	widget     Widget
+	errorShown bool
+}
+
+// Editable returns whether the cells of the TableView can be edited in place.
+func (tv *TableView) Editable() bool {
+	return tv.editable
+}
+
+// SetEditable sets whether the cells of the TableView can be edited in place.
+//
+// The cells of a column can be edited, if it has an editor and the model
+// implements EditableTableModel. Pressing F2, double-clicking a cell or typing
+// starts editing. Enter commits the value, Tab and Shift+Tab commit it and edit
+// the next or previous cell, Escape cancels editing. Values that the Validator
+// of the column or SetValue of the model return an error for are not committed,
+// the error is shown instead.
+func (tv *TableView) SetEditable(editable bool) {
+	if !editable {
+		tv.CancelEditing()
+	}
+
+	tv.editable = editable
+}
+
+// Editing returns whether the TableView is editing a cell.
+func (tv *TableView) Editing() bool {
+	return tv.edit != nil
+}
+
+// EditCell starts editing the cell at row in the model and column col.
+func (tv *TableView) EditCell(row, col int) error {
+	index := tv.fromModelIndex(row)
+	if index == -1 {
+		return newError("row not shown")
+	}
+
+	if !tv.cellEditable(index, col) {
+		return newError("cell not editable")
+	}
+
+	return tv.beginEdit(index, col)
+}
+
+// CommitEditing commits the value of the cell the TableView is editing.
+func (tv *TableView) CommitEditing() error {
+	if tv.edit == nil {
+		return nil
+	}
+
+	return tv.commitEdit()
+}
+
+// CancelEditing stops editing a cell without committing its value.
+func (tv *TableView) CancelEditing() {
+	if tv.edit == nil {
+		return
+	}
+
+	tv.endEdit()
+}
+
+// cellEditable returns whether the cell at row in the list views and column col
+// can be edited.
+func (tv *TableView) cellEditable(row, col int) bool {
+	if !tv.editable || tv.model == nil || row < 0 || row >= tv.model.RowCount() || col < 0 || col >= tv.columns.Len() {
+		return false
+	}
+
+	if tvc := tv.columns.At(col); !tvc.visible || tvc.editor == nil {
+		return false
+	}
+
+	if _, ok := tv.groupHeader(row); ok {
+		return false
+	}
+
+	_, ok := passedThrough[EditableTableModel](tv.TableModel())
+
+	return ok
+}
+
+// firstEditableColumn returns the first column in display order, whose cell at
+// row in the list views can be edited, or -1 if there is none.
+func (tv *TableView) firstEditableColumn(row int) int {
+	for _, tvc := range tv.VisibleColumnsInDisplayOrder() {
+		if col := tv.columns.Index(tvc); tv.cellEditable(row, col) {
+			return col
+		}
+	}
+
+	return -1
+}
+
+func (tv *TableView) beginEdit(row, col int) error {
+	if tv.edit != nil {
+		if err := tv.commitEdit(); err != nil {
+			return err
+		}
+	}
+
+	tv.setCurrentIndex(row)
+	tv.ensureColumnVisible(row, col)
+
+	edit := &tableViewEdit{
+		row:      row,
+		modelRow: tv.toModelIndex(row),
+		col:      col,
+		editor:   tv.columns.At(col).editor,
+	}
+
+	host, err := NewCompositeWithStyle(tv, 0)
+	if err != nil {
+		return err
+	}
+	host.SetFont(tv.Font())
+
+	widget, err := edit.editor.CreateEditor(host, tv.model.Value(row, col))
+	if err != nil {
+		host.Dispose()
+		return err
+	}
+
+	edit.host = host
+	edit.widget = widget
+	tv.edit = edit
+
+	tv.layoutEdit()
+
+	focusChanged := func() {
+		App().Synchronize(func() {
+			if tv.edit == edit && !tv.editFocused() {
+				tv.finishEdit()
+			}
+		})
+	}
+
+	walkDescendants(widget, func(w Window) bool {
+		w.FocusedChanged().Attach(focusChanged)
+		return true
+	})
+
+	widget.SetFocus()
+
+	return nil
+}
+
+// cellRect returns the bounds of the cell at row and column col in the list
+// view showing the column.
+func (tv *TableView) cellRect(row, col int) (hwnd win.HWND, rc win.RECT, ok bool) {
+	tvc := tv.columns.At(col)
+
+	hwnd = tv.hwndNormalLV
+	if tvc.frozen {
+		hwnd = tv.hwndFrozenLV
+	}
+
+	subItem := tvc.indexInListView()
+
+	// For the first column, LVIR_BOUNDS would be the whole row.
+	rc = win.RECT{Top: subItem, Left: win.LVIR_BOUNDS}
+	if subItem == 0 {
+		rc.Left = win.LVIR_LABEL
+	}
+
+	ok = win.SendMessage(hwnd, win.LVM_GETSUBITEMRECT, uintptr(row), uintptr(unsafe.Pointer(&rc))) != 0
+
+	return
+}
+
+// ensureColumnVisible scrolls the list view showing column col horizontally, so
+// that its cell at row is in view.
+func (tv *TableView) ensureColumnVisible(row, col int) {
+	hwnd, rc, ok := tv.cellRect(row, col)
+	if !ok || hwnd != tv.hwndNormalLV {
+		return
+	}
+
+	var rcClient win.RECT
+	win.GetClientRect(hwnd, &rcClient)
+
+	var dx int32
+	if rc.Right > rcClient.Right {
+		dx = rc.Right - rcClient.Right
+	}
+	if rc.Left-dx < 0 {
+		dx = rc.Left
+	}
+
+	if dx != 0 {
+		win.SendMessage(hwnd, win.LVM_SCROLL, uintptr(dx), 0)
+	}
+}
+
+// layoutEdit moves the editor over the cell it edits.
+func (tv *TableView) layoutEdit() {
+	edit := tv.edit
+	if edit == nil {
+		return
+	}
+
+	if !tv.columns.At(edit.col).visible {
+		tv.endEdit()
+		return
+	}
+
+	hwnd, rc, ok := tv.cellRect(edit.row, edit.col)
+	if !ok {
+		return
+	}
+
+	topLeft := win.POINT{X: rc.Left, Y: rc.Top}
+	win.ClientToScreen(hwnd, &topLeft)
+	win.ScreenToClient(tv.hWnd, &topLeft)
+
+	bounds := Rectangle{X: int(topLeft.X), Y: int(topLeft.Y), Width: int(rc.Right - rc.Left), Height: int(rc.Bottom - rc.Top)}
+
+	edit.widget.SetBoundsPixels(Rectangle{Width: bounds.Width, Height: bounds.Height})
+
+	// Some widgets, like ComboBox, are higher than a row.
+	bounds.Height = maxi(bounds.Height, edit.widget.BoundsPixels().Height)
+
+	win.SetWindowPos(
+		edit.host.hWnd,
+		win.HWND_TOP,
+		int32(bounds.X),
+		int32(bounds.Y),
+		int32(bounds.Width),
+		int32(bounds.Height),
+		win.SWP_NOACTIVATE)
+
+	if edit.errorShown {
+		tv.editToolTip.track(edit.widget)
+	}
+}
+
+// editFocused returns whether the editor or a window outside the form the
+// TableView is in has the keyboard focus, like the drop-down of a DateEdit.
+func (tv *TableView) editFocused() bool {
+	hwndFocus := win.GetFocus()
+
+	if hwndFocus == tv.edit.host.hWnd || win.IsChild(tv.edit.host.hWnd, hwndFocus) {
+		return true
+	}
+
+	form := tv.Form()
+
+	return form == nil || hwndFocus != form.Handle() && !win.IsChild(form.Handle(), hwndFocus)
+}
+
+// commitEdit passes the value of the editor to the model and stops editing. If
+// the value is not valid, the error is shown and editing continues.
+func (tv *TableView) commitEdit() error {
+	edit := tv.edit
+
+	value, err := edit.editor.EditorValue(edit.widget)
+
+	if err == nil {
+		if validator := tv.columns.At(edit.col).validator; validator != nil {
+			err = validator.Validate(value)
+		}
+	}
+
+	if err == nil {
+		if model, ok := passedThrough[EditableTableModel](tv.TableModel()); ok {
+			err = model.SetValue(edit.modelRow, edit.col, value)
+		} else {
+			err = newError("model does not implement EditableTableModel")
+		}
+	}
+
+	if err != nil {
+		if tv.edit == edit {
+			tv.showEditError(err)
+		}
+
+		return err
+	}
+
+	// SetValue may have changed the rows, which stops editing.
+	if tv.edit == edit {
+		tv.endEdit()
+	}
+
+	return nil
+}
+
+// finishEdit commits the value of the editor, or discards it if it is not
+// valid.
+func (tv *TableView) finishEdit() {
+	if tv.edit == nil {
+		return
+	}
+
+	if err := tv.commitEdit(); err != nil {
+		tv.endEdit()
+	}
+}
+
+// endEdit stops editing without committing the value of the editor.
+func (tv *TableView) endEdit() {
+	edit := tv.edit
+	if edit == nil {
+		return
+	}
+
+	tv.edit = nil
+
+	tv.hideEditError(edit)
+
+	if hwndFocus := win.GetFocus(); hwndFocus == edit.host.hWnd || win.IsChild(edit.host.hWnd, hwndFocus) {
+		win.SetFocus(tv.hwndFrozenLV)
+	}
+
+	edit.host.Dispose()
+}
+
+// moveEdit commits the value of the editor and edits the next or previous cell
+// in display order, that can be edited.
+func (tv *TableView) moveEdit(backward bool) {
+	modelRow, col := tv.edit.modelRow, tv.edit.col
+
+	ip, hasIDs := tv.providedModel.(IDProvider)
+	var id any
+	if hasIDs {
+		id = ip.ID(modelRow)
+	}
+
+	if err := tv.commitEdit(); err != nil {
+		return
+	}
+
+	// Committing may have sorted the rows, so the edited row is looked up
+	// again. Without IDs, the model has to keep its index.
+	row := -1
+	if hasIDs {
+		for i := range tv.model.RowCount() {
+			if r := tv.toModelIndex(i); r > -1 && ip.ID(r) == id {
+				row = i
+				break
+			}
+		}
+	} else {
+		row = tv.fromModelIndex(modelRow)
+	}
+
+	cols := tv.VisibleColumnsInDisplayOrder()
+	pos := -1
+	for i, tvc := range cols {
+		if tv.columns.Index(tvc) == col {
+			pos = i
+			break
+		}
+	}
+
+	step := 1
+	if backward {
+		step = -1
+	}
+
+	count := tv.model.RowCount()
+
+	for row >= 0 && row < count {
+		for pos += step; pos >= 0 && pos < len(cols); pos += step {
+			if c := tv.columns.Index(cols[pos]); tv.cellEditable(row, c) {
+				tv.beginEdit(row, c)
+				return
+			}
+		}
+
+		row += step
+		if backward {
+			pos = len(cols)
+		} else {
+			pos = -1
+		}
+	}
+}
+
+// handleEditorKeyDown handles the keys that commit or cancel editing, if hwnd
+// is the editor or a window in it, and reports whether it handled key.
+func (tv *TableView) handleEditorKeyDown(hwnd win.HWND, key Key, mods Modifiers) bool {
+	edit := tv.edit
+	if edit == nil || hwnd != edit.host.hWnd && !win.IsChild(edit.host.hWnd, hwnd) {
+		return false
+	}
+
+	// Drop-downs handle their keys themselves.
+	switch w := edit.widget.(type) {
+	case *ComboBox:
+		if w.SendMessage(win.CB_GETDROPPEDSTATE, 0, 0) != 0 {
+			return false
+		}
+
+	case *DateEdit:
+		if w.SendMessage(win.DTM_GETMONTHCAL, 0, 0) != 0 {
+			return false
+		}
+	}
+
+	switch {
+	case key == KeyEscape && mods == 0:
+		tv.endEdit()
+
+	case key == KeyReturn && mods == 0:
+		tv.commitEdit()
+
+	case key == KeyTab && (mods == 0 || mods == ModShift):
+		tv.moveEdit(mods == ModShift)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// editCellAt starts editing the cell at the client coordinates in lp of the list
+// view hwnd and reports whether it can be edited.
+func (tv *TableView) editCellAt(hwnd win.HWND, lp uintptr) bool {
+	if !tv.editable {
+		return false
+	}
+
+	hti := win.LVHITTESTINFO{Pt: win.POINT{X: win.GET_X_LPARAM(lp), Y: win.GET_Y_LPARAM(lp)}}
+	if int32(win.SendMessage(hwnd, win.LVM_SUBITEMHITTEST, 0, uintptr(unsafe.Pointer(&hti)))) == -1 {
+		return false
+	}
+
+	row := int(hti.IItem)
+	col := tv.fromLVColIdx(hwnd == tv.hwndFrozenLV, hti.ISubItem)
+
+	if !tv.cellEditable(row, col) {
+		return false
+	}
+
+	return tv.beginEdit(row, col) == nil
+}
+
+// editCurrentItem starts editing the first cell of the current item, that can
+// be edited, and reports whether there is one.
+func (tv *TableView) editCurrentItem() bool {
+	if !tv.editable {
+		return false
+	}
+
+	col := tv.firstEditableColumn(tv.currentIndex)
+	if col == -1 {
+		return false
+	}
+
+	return tv.beginEdit(tv.currentIndex, col) == nil
+}
+
+func (tv *TableView) showEditError(err error) {
+	edit := tv.edit
+
+	if tv.editToolTip == nil {
+		tt, err := newToolTip(win.TTS_BALLOON)
+		if err != nil {
+			return
+		}
+
+		tv.editToolTip = tt
+	}
+
+	tt := tv.editToolTip
+
+	if !edit.errorShown {
+		if err := tt.addTrackedTool(edit.widget); err != nil {
+			return
+		}
+
+		edit.errorShown = true
+	}
+
+	if ve, ok := err.(*ValidationError); ok {
+		tt.SetErrorTitle(ve.title)
+		tt.SetText(edit.widget, ve.message)
+	} else {
+		tt.SetErrorTitle(tr("Invalid Input"))
+		tt.SetText(edit.widget, err.Error())
+	}
+
+	tt.track(edit.widget)
+
+	if ValidationErrorEffect != nil {
+		if effects := edit.widget.GraphicsEffects(); !effects.Contains(ValidationErrorEffect) {
+			effects.Add(ValidationErrorEffect)
+		}
+	}
+}
+
+func (tv *TableView) hideEditError(edit *tableViewEdit) {
+	if !edit.errorShown {
+		return
+	}
+
+	tv.editToolTip.untrack(edit.widget)
+	tv.editToolTip.RemoveTool(edit.widget)
+
+	edit.errorShown = false
+}
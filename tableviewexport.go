@@ -0,0 +1,101 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"io"
+	"slices"
+	"strings"
+)
+
+// Export writes the rows of the TableView to w in format, as the user sees
+// them: the visible columns in display order, with their titles and formatted
+// like the cells, and the rows sorted and filtered like shown. Group headers are
+// not exported. opts may be nil.
+func (tv *TableView) Export(w io.Writer, format ExportFormat, opts *ExportOptions) error {
+	if opts == nil {
+		opts = new(ExportOptions)
+	}
+
+	table := tv.exportTable(opts.SelectedOnly)
+	table.header = !opts.NoHeader
+
+	return table.write(w, format)
+}
+
+// CopySelection copies the selected rows to the clipboard, as tab separated
+// values and as HTML table, so they can be pasted into spreadsheets. Pressing
+// Ctrl+C does the same.
+func (tv *TableView) CopySelection() error {
+	table := tv.exportTable(true)
+	if len(table.rows) == 0 {
+		return nil
+	}
+	table.crlf = true
+
+	var text, html strings.Builder
+	if err := table.write(&text, ExportTSV); err != nil {
+		return err
+	}
+	if err := table.write(&html, ExportHTML); err != nil {
+		return err
+	}
+
+	return Clipboard().SetContent(ClipboardContent{Text: text.String(), HTML: html.String()})
+}
+
+// exportTable returns the cells of the rows shown, or of the selected rows only,
+// formatted for display.
+func (tv *TableView) exportTable(selectedOnly bool) *exportTable {
+	cols := tv.VisibleColumnsInDisplayOrder()
+
+	table := &exportTable{titles: make([]string, len(cols))}
+	indexes := make([]int, len(cols))
+	for i, tvc := range cols {
+		table.titles[i] = tvc.TitleEffective()
+		indexes[i] = tv.columns.Index(tvc)
+	}
+
+	if tv.model == nil {
+		return table
+	}
+
+	count := tv.model.RowCount()
+
+	var rows []int
+	switch {
+	case !selectedOnly:
+		for row := range count {
+			rows = append(rows, row)
+		}
+
+	case tv.MultiSelection():
+		rows = slices.Sorted(slices.Values(tv.selectedIndexes))
+
+	case tv.currentIndex > -1:
+		rows = []int{tv.currentIndex}
+	}
+
+	for _, row := range rows {
+		if row < 0 || row >= count {
+			continue
+		}
+		if _, ok := tv.groupHeader(row); ok {
+			continue
+		}
+
+		cells := make([]string, len(cols))
+		for i, col := range indexes {
+			cells[i] = tv.formatValue(col, tv.model.Value(row, col))
+		}
+
+		table.rows = append(table.rows, cells)
+	}
+
+	return table
+}
@@ -0,0 +1,451 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"slices"
+	"strings"
+	"unsafe"
+
+	"github.com/wuc656/win"
+)
+
+// Not defined by package win.
+const (
+	lvcfmtSplitButton = 0x1000000
+	lvnColumnDropDown = win.LVN_FIRST - 64
+)
+
+// tableViewFilterDelay is the time in milliseconds, that typing in the filter
+// bar must pause, before the TableView is filtered.
+const tableViewFilterDelay = 200
+
+var tableViewFilterMatchBGColor = RGB(0xFF, 0xEC, 0x8C)
+
+// FilterBarVisible returns whether the filter bar of the TableView is visible.
+func (tv *TableView) FilterBarVisible() bool {
+	return tv.filterEdit != nil
+}
+
+// SetFilterBarVisible sets whether the filter bar of the TableView is visible.
+//
+// The filter bar is a LineEdit above the header. The TableView shows only the
+// items, that contain the text typed into it in one of their visible columns,
+// ignoring case. The drop-down of a column header restricts the search to that
+// column. Matching cells are highlighted.
+//
+// Indexes, like those of CurrentIndex, SelectedIndexes and CellStyle.Row, stay
+// indexes into the model. Filtering does not change the current item and the
+// selection, unless the filter hides them.
+func (tv *TableView) SetFilterBarVisible(visible bool) error {
+	if visible == tv.FilterBarVisible() {
+		return nil
+	}
+
+	if visible {
+		le, err := newLineEdit(tv)
+		if err != nil {
+			return err
+		}
+
+		le.applyFont(tv.Font())
+		le.applyEnabled(tv.Enabled())
+
+		if err := le.SetCueBanner(tr("Filter", "walk")); err != nil {
+			le.Dispose()
+			return err
+		}
+
+		le.TextChanged().Attach(func() {
+			if win.SetTimer(tv.hWnd, tableViewFilterTimerId, tableViewFilterDelay, 0) == 0 {
+				lastError("SetTimer")
+			}
+		})
+
+		le.KeyDown().Attach(func(key Key) {
+			switch key {
+			case KeyEscape:
+				le.SetText("")
+
+			case KeyDown:
+				win.SetFocus(tv.hwndFrozenLV)
+			}
+		})
+
+		tv.filterEdit = le
+	} else {
+		tv.filterEdit.Dispose()
+		tv.filterEdit = nil
+
+		if tv.filterText != "" {
+			tv.filterText = ""
+			tv.filterNeedle = ""
+
+			defer tv.filterChangedPublisher.Publish()
+		}
+	}
+
+	for _, tvc := range tv.columns.items {
+		if err := tvc.update(); err != nil {
+			return err
+		}
+	}
+
+	if err := tv.remodel(); err != nil {
+		return err
+	}
+
+	tv.updateLVSizes()
+
+	return nil
+}
+
+// remodel sets the model of the TableView again, keeping the current item and
+// the selection, after the proxies the list views show it through changed.
+func (tv *TableView) remodel() error {
+	if tv.model == nil {
+		return nil
+	}
+
+	current, selected := tv.CurrentIndex(), tv.SelectedIndexes()
+
+	if err := tv.SetModel(tv.providedModel); err != nil {
+		return err
+	}
+
+	if tv.MultiSelection() {
+		if err := tv.SetSelectedIndexes(selected); err != nil {
+			return err
+		}
+	}
+
+	return tv.SetCurrentIndex(current)
+}
+
+// FilterText returns the text the items of the TableView are filtered by.
+func (tv *TableView) FilterText() string {
+	return tv.filterText
+}
+
+// SetFilterText sets the text the items of the TableView are filtered by and
+// shows it in the filter bar.
+func (tv *TableView) SetFilterText(text string) error {
+	if tv.filterEdit == nil {
+		return newError("filter bar not visible")
+	}
+
+	if text != tv.filterEdit.Text() {
+		if err := tv.filterEdit.SetText(text); err != nil {
+			return err
+		}
+	}
+
+	if text == tv.filterText {
+		return nil
+	}
+
+	tv.filterText = text
+	tv.filterNeedle = strings.ToLower(text)
+
+	tv.applyFilter()
+
+	return nil
+}
+
+// FilterColumn returns the index of the column the filter text is searched in,
+// or -1 if it is searched in all visible columns.
+func (tv *TableView) FilterColumn() int {
+	return tv.filterColumn
+}
+
+// SetFilterColumn sets the index of the column the filter text is searched in.
+// Use -1 to search in all visible columns.
+func (tv *TableView) SetFilterColumn(col int) error {
+	if col < -1 || col >= tv.columns.Len() {
+		return newError("col out of range")
+	}
+
+	if col == tv.filterColumn {
+		return nil
+	}
+
+	tv.filterColumn = col
+
+	if tv.filterNeedle == "" {
+		tv.filterChangedPublisher.Publish()
+	} else {
+		tv.applyFilter()
+	}
+
+	return nil
+}
+
+// FilterChanged returns the event that is published after the text or column
+// the items of the TableView are filtered by changed.
+func (tv *TableView) FilterChanged() *Event {
+	return tv.filterChangedPublisher.Event()
+}
+
+// filteredModel returns the model the list views show for model. While the
+// filter bar is visible, that is a FilterTableModel wrapping model.
+func (tv *TableView) filteredModel(model TableModel) TableModel {
+	if tv.filterEdit == nil {
+		return model
+	}
+
+	tv.filterModel = NewFilterTableModel(model)
+	tv.updateFilter()
+
+	return tv.filterModel
+}
+
+// toModelIndex returns the index into the model of the item at index in the
+// list views, or -1 for group headers.
+func (tv *TableView) toModelIndex(index int) int {
+	if gm := tv.groupModel; gm != nil && index > -1 && index < gm.RowCount() {
+		if index = gm.MapToSource(index); index == -1 {
+			return -1
+		}
+	}
+
+	if tv.filterModel == nil || index < 0 || index >= tv.filterModel.RowCount() {
+		return index
+	}
+
+	return tv.filterModel.MapToSource(index)
+}
+
+// fromModelIndex returns the index in the list views of the item at index in
+// the model, or -1 if the filter or a collapsed group hides it.
+func (tv *TableView) fromModelIndex(index int) int {
+	if tv.filterModel != nil && index > -1 {
+		index = tv.filterModel.MapFromSource(index)
+	}
+
+	if tv.groupModel != nil && index > -1 {
+		index = tv.groupModel.MapFromSource(index)
+	}
+
+	return index
+}
+
+func (tv *TableView) updateFilter() {
+	if tv.filterNeedle == "" {
+		tv.filterModel.SetFilter(nil)
+		return
+	}
+
+	source := tv.filterModel.SourceModel()
+	cols := tv.filterColumnIndexes()
+
+	tv.filterModel.SetFilter(func(row int) bool {
+		for _, col := range cols {
+			if tv.cellMatchesFilter(source, row, col) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+func (tv *TableView) applyFilter() {
+	defer tv.filterChangedPublisher.Publish()
+
+	if tv.filterModel == nil {
+		return
+	}
+
+	current, selected := tv.CurrentIndex(), tv.SelectedIndexes()
+
+	tv.remappingRows = true
+	tv.updateFilter()
+	tv.remappingRows = false
+
+	tv.restoreSelection(current, selected, -1)
+
+	tv.Invalidate()
+}
+
+// restoreSelection makes the items at the model indexes current and selected
+// again, as far as they are shown, and publishes what changed. If the current
+// item is not shown, the item at the list view index fallback becomes current,
+// if that is not -1.
+func (tv *TableView) restoreSelection(current int, selected []int, fallback int) {
+	// The LVN_ITEMCHANGED notifications must not look like caused by the
+	// user.
+	itemIndexOfLastMouseButtonDown := tv.itemIndexOfLastMouseButtonDown
+	tv.itemIndexOfLastMouseButtonDown = -1
+	tv.inSetCurrentIndex = true
+	tv.inSetSelectedIndexes = true
+	defer func() {
+		tv.itemIndexOfLastMouseButtonDown = itemIndexOfLastMouseButtonDown
+		tv.inSetCurrentIndex = false
+		tv.inSetSelectedIndexes = false
+	}()
+
+	lvi := &win.LVITEM{StateMask: win.LVIS_FOCUSED | win.LVIS_SELECTED}
+	lp := uintptr(unsafe.Pointer(lvi))
+
+	setItemState := func(index int) {
+		win.SendMessage(tv.hwndFrozenLV, win.LVM_SETITEMSTATE, uintptr(index), lp)
+		win.SendMessage(tv.hwndNormalLV, win.LVM_SETITEMSTATE, uintptr(index), lp)
+	}
+
+	setItemState(-1)
+
+	multiSelection := tv.MultiSelection()
+
+	if multiSelection {
+		lvi.StateMask = win.LVIS_SELECTED
+		lvi.State = win.LVIS_SELECTED
+
+		tv.selectedIndexes = tv.selectedIndexes[:0]
+		for _, index := range selected {
+			if index = tv.fromModelIndex(index); index > -1 {
+				setItemState(index)
+				tv.selectedIndexes = append(tv.selectedIndexes, index)
+			}
+		}
+	}
+
+	shown := tv.fromModelIndex(current)
+	if tv.currentIndex = shown; shown == -1 {
+		tv.currentIndex = fallback
+	}
+
+	if tv.currentIndex > -1 {
+		lvi.StateMask = win.LVIS_FOCUSED | win.LVIS_SELECTED
+		lvi.State = win.LVIS_FOCUSED | win.LVIS_SELECTED
+		setItemState(tv.currentIndex)
+
+		win.SendMessage(tv.hwndFrozenLV, win.LVM_ENSUREVISIBLE, uintptr(tv.currentIndex), 0)
+		win.SendMessage(tv.hwndNormalLV, win.LVM_ENSUREVISIBLE, uintptr(tv.currentIndex), 0)
+	}
+	if shown == -1 && current > -1 {
+		tv.currentItemID = nil
+		tv.currentIndexChangedPublisher.Publish()
+		tv.currentItemChangedPublisher.Publish()
+	}
+
+	if multiSelection && !slices.Equal(tv.SelectedIndexes(), selected) {
+		tv.publishSelectedIndexesChanged()
+	}
+}
+
+// filterColumnIndexes returns the indexes of the columns the filter text is
+// searched in.
+func (tv *TableView) filterColumnIndexes() []int {
+	if col := tv.filterColumn; col > -1 && col < tv.columns.Len() && tv.columns.items[col].visible {
+		return []int{col}
+	}
+
+	var cols []int
+	for i, tvc := range tv.columns.items {
+		if tvc.visible {
+			cols = append(cols, i)
+		}
+	}
+
+	return cols
+}
+
+func (tv *TableView) cellMatchesFilter(source TableModel, row, col int) bool {
+	return strings.Contains(strings.ToLower(tv.formatValue(col, source.Value(row, col))), tv.filterNeedle)
+}
+
+// cellStyler returns the CellStyler the TableView styles its cells with.
+func (tv *TableView) cellStyler() CellStyler {
+	if tv.filterModel == nil && tv.groupModel == nil {
+		return tv.styler
+	}
+
+	return tableViewFilterStyler{tv}
+}
+
+// tableViewFilterStyler passes model indexes to the CellStyler of a filtered or
+// grouped TableView and highlights the cells that match the filter.
+type tableViewFilterStyler struct {
+	tv *TableView
+}
+
+func (s tableViewFilterStyler) StyleCell(style *CellStyle) {
+	tv := s.tv
+	row := style.row
+
+	if styler := tv.styler; styler != nil {
+		style.row = tv.toModelIndex(row)
+		styler.StyleCell(style)
+		style.row = row
+	}
+
+	if row < 0 || style.col < 0 || tv.filterNeedle == "" {
+		return
+	}
+	if tv.filterColumn > -1 && tv.filterColumn != style.col {
+		return
+	}
+	if win.SendMessage(tv.hwndNormalLV, win.LVM_GETITEMSTATE, uintptr(row), win.LVIS_SELECTED)&win.LVIS_SELECTED != 0 {
+		return
+	}
+
+	if tv.cellMatchesFilter(tv.filterModel.SourceModel(), tv.toModelIndex(row), style.col) {
+		style.BackgroundColor = tableViewFilterMatchBGColor
+	}
+}
+
+// showFilterColumnMenu shows the menu of the header drop-down of the list view
+// column at lvColIndex, that restricts the filter to a column.
+func (tv *TableView) showFilterColumnMenu(hwndLV win.HWND, lvColIndex int32) {
+	menu, err := NewMenu()
+	if err != nil {
+		return
+	}
+	defer menu.Dispose()
+
+	addAction := func(text string, col int) {
+		action := NewAction()
+		action.SetText(text)
+		action.SetChecked(col == tv.filterColumn)
+		action.Triggered().Attach(func() {
+			tv.SetFilterColumn(col)
+		})
+
+		menu.Actions().Add(action)
+	}
+
+	addAction(tr("All Columns", "walk"), -1)
+	for i, tvc := range tv.columns.items {
+		if tvc.visible {
+			addAction(tvc.TitleEffective(), i)
+		}
+	}
+
+	hwndHdr := win.HWND(win.SendMessage(hwndLV, win.LVM_GETHEADER, 0, 0))
+
+	var rc win.RECT
+	win.SendMessage(hwndHdr, win.HDM_GETITEMDROPDOWNRECT, uintptr(lvColIndex), uintptr(unsafe.Pointer(&rc)))
+
+	p := win.POINT{X: rc.Left, Y: rc.Bottom}
+	if tv.RightToLeft() {
+		p.X = rc.Right
+	}
+	win.ClientToScreen(hwndHdr, &p)
+
+	actionId := uint16(win.TrackPopupMenuEx(
+		menu.hMenu,
+		menu.popupFlags(tv, tv.hWnd)|win.TPM_RETURNCMD,
+		p.X,
+		p.Y,
+		tv.hWnd,
+		nil))
+
+	if action, ok := actionsById[actionId]; ok && actionId != 0 {
+		action.raiseTriggered()
+	}
+}
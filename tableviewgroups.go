@@ -0,0 +1,300 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+
+	"github.com/wuc656/win"
+)
+
+// Not defined by package win.
+const (
+	glpsClosed = 1
+	glpsOpened = 2
+)
+
+// GroupingEnabled returns whether the TableView shows the rows of a
+// GroupingTableModel in groups.
+func (tv *TableView) GroupingEnabled() bool {
+	return tv.groupingEnabled
+}
+
+// SetGroupingEnabled sets whether the TableView shows the rows of its model in
+// groups, if the model implements GroupingTableModel.
+//
+// Each group is shown below a header row with its title and number of rows.
+// Clicking a header, or pressing Enter or Space while it is current, collapses
+// or expands its group. Left collapses the group of the current item and makes
+// the header current, Right expands it again.
+//
+// Indexes, like those of CurrentIndex, SelectedIndexes and CellStyle.Row, stay
+// indexes into the model. While a header is current, CurrentIndex returns -1.
+func (tv *TableView) SetGroupingEnabled(enabled bool) error {
+	if enabled == tv.groupingEnabled {
+		return nil
+	}
+
+	tv.groupingEnabled = enabled
+
+	return tv.remodel()
+}
+
+// GroupCollapsed returns whether the group with key is collapsed.
+func (tv *TableView) GroupCollapsed(key any) bool {
+	if gm, ok := tv.TableModel().(GroupingTableModel); ok {
+		return gm.GroupCollapsed(key)
+	}
+
+	return false
+}
+
+// SetGroupCollapsed collapses or expands the group with key. If the current
+// item is in a group that is collapsed, its header becomes current.
+func (tv *TableView) SetGroupCollapsed(key any, collapsed bool) error {
+	gm, ok := tv.TableModel().(GroupingTableModel)
+	if !ok {
+		return newError("model does not implement GroupingTableModel")
+	}
+
+	if collapsed == gm.GroupCollapsed(key) {
+		return nil
+	}
+
+	gm.SetGroupCollapsed(key, collapsed)
+
+	tv.regroup(key)
+
+	return nil
+}
+
+// groupedModel returns the model the list views show for model, if they would
+// show shown otherwise. With grouping enabled, that is a groupTableModel
+// wrapping shown.
+func (tv *TableView) groupedModel(model, shown TableModel) TableModel {
+	gm, ok := model.(GroupingTableModel)
+	if !ok || !tv.groupingEnabled {
+		return shown
+	}
+
+	filterModel := tv.filterModel
+
+	tv.groupModel = newGroupTableModel(shown, func(row int) any {
+		if filterModel != nil {
+			row = filterModel.MapToSource(row)
+		}
+
+		return gm.GroupKey(row)
+	}, gm.GroupCollapsed)
+
+	return tv.groupModel
+}
+
+// regroup updates the list views after the group with key was collapsed or
+// expanded.
+func (tv *TableView) regroup(key any) {
+	if tv.groupModel == nil {
+		return
+	}
+
+	current, selected := tv.CurrentIndex(), tv.SelectedIndexes()
+
+	var focusHeader bool
+	if group, ok := tv.groupHeader(tv.currentIndex); ok {
+		focusHeader = group.key == key
+	} else if current > -1 {
+		focusHeader = tv.TableModel().(GroupingTableModel).GroupKey(current) == key
+	}
+
+	tv.remappingRows = true
+	tv.groupModel.reset()
+	tv.remappingRows = false
+
+	fallback := -1
+	if g := tv.groupModel.groupRows.groupIndex(key); g > -1 && focusHeader {
+		fallback = tv.groupModel.groupRows.groups[g].header
+	}
+
+	tv.restoreSelection(current, selected, fallback)
+
+	tv.Invalidate()
+}
+
+// groupHeader returns the group, whose header is at index in the list views.
+func (tv *TableView) groupHeader(index int) (*rowGroup, bool) {
+	if tv.groupModel == nil {
+		return nil, false
+	}
+
+	return tv.groupModel.header(index)
+}
+
+func (tv *TableView) groupHeaderText(group *rowGroup) string {
+	title := tv.TableModel().(GroupingTableModel).GroupTitle(group.key)
+
+	return fmt.Sprintf("%s (%d)", title, group.count)
+}
+
+// groupHeaderClicked collapses or expands the group whose header is at index in
+// the list views and reports whether there is one.
+func (tv *TableView) groupHeaderClicked(index int) bool {
+	group, ok := tv.groupHeader(index)
+	if !ok {
+		return false
+	}
+
+	key, collapsed := group.key, group.collapsed
+
+	win.SetFocus(tv.hwndFrozenLV)
+	tv.setCurrentIndex(index)
+
+	tv.SetGroupCollapsed(key, !collapsed)
+
+	return true
+}
+
+// handleGroupKeyDown collapses and expands groups with the keyboard and reports
+// whether it handled key.
+func (tv *TableView) handleGroupKeyDown(key Key) bool {
+	if tv.groupModel == nil || tv.currentIndex < 0 || tv.currentIndex >= tv.groupModel.RowCount() {
+		return false
+	}
+	if ModifiersDown() != 0 {
+		return false
+	}
+
+	g, isHeader := tv.groupModel.groupRows.groupAt(tv.currentIndex)
+	group := tv.groupModel.groupRows.groups[g]
+
+	switch key {
+	case KeyLeft:
+		if !isHeader {
+			tv.setCurrentIndex(group.header)
+			return true
+		}
+		if !group.collapsed {
+			tv.SetGroupCollapsed(group.key, true)
+			return true
+		}
+
+	case KeyRight:
+		if isHeader && group.collapsed {
+			tv.SetGroupCollapsed(group.key, false)
+			return true
+		}
+
+	case KeyReturn, KeySpace:
+		if isHeader {
+			tv.SetGroupCollapsed(group.key, !group.collapsed)
+			return true
+		}
+	}
+
+	return false
+}
+
+// drawGroupHeader draws the header of group across the row of a list view. The
+// list view showing the first column shows the title, so it stays in view when
+// the other one is scrolled horizontally.
+func (tv *TableView) drawGroupHeader(hwnd win.HWND, nmlvcd *win.NMLVCUSTOMDRAW, group *rowGroup) {
+	hdc := nmlvcd.Nmcd.Hdc
+
+	canvas, err := newCanvasFromHDC(hdc)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	dpi := tv.DPI()
+	bounds := rectangleFromRECT(nmlvcd.Nmcd.Rc)
+
+	var rc win.RECT
+	win.GetClientRect(hwnd, &rc)
+	bounds.X, bounds.Width = 0, int(rc.Right)
+
+	bgColor, textColor := tv.themeNormalBGColor, tv.themeNormalTextColor
+	if win.SendMessage(hwnd, win.LVM_GETITEMSTATE, nmlvcd.Nmcd.DwItemSpec, win.LVIS_SELECTED)&win.LVIS_SELECTED != 0 {
+		if tv.Focused() {
+			bgColor, textColor = tv.themeSelectedBGColor, tv.themeSelectedTextColor
+		} else {
+			bgColor = tv.themeSelectedNotFocusedBGColor
+		}
+	}
+
+	if brush, _ := NewSolidColorBrush(bgColor); brush != nil {
+		defer brush.Dispose()
+
+		canvas.FillRectanglePixels(brush, rectangleFromRECT(nmlvcd.Nmcd.Rc))
+	}
+
+	padding := IntFrom96DPI(4, dpi)
+	lineY := bounds.Y + bounds.Height/2
+	lineX := bounds.X
+
+	var hwndTitle win.HWND
+	if tv.hasFrozenColumn {
+		hwndTitle = tv.hwndFrozenLV
+	} else {
+		hwndTitle = tv.hwndNormalLV
+	}
+
+	if hwnd == hwndTitle {
+		glyphSize := IntFrom96DPI(16, dpi)
+		glyphBounds := Rectangle{X: bounds.X + padding, Y: bounds.Y + (bounds.Height-glyphSize)/2, Width: glyphSize, Height: glyphSize}
+
+		font := tv.Font()
+		if bold, err := NewFont(font.Family(), font.PointSize(), font.Style()|FontBold); err == nil {
+			font = bold
+		}
+
+		if hTheme := win.OpenThemeData(hwnd, CachedStringToUTF16Ptr("TreeView")); hTheme != 0 {
+			defer win.CloseThemeData(hTheme)
+
+			state := int32(glpsOpened)
+			if group.collapsed {
+				state = glpsClosed
+			}
+
+			rcGlyph := glyphBounds.toRECT()
+			win.DrawThemeBackground(hTheme, hdc, win.TVP_GLYPH, state, &rcGlyph, nil)
+		} else {
+			glyph := "-"
+			if group.collapsed {
+				glyph = "+"
+			}
+
+			canvas.DrawTextPixels(glyph, font, textColor, glyphBounds, TextCenter|TextVCenter|TextSingleLine)
+		}
+
+		textBounds := bounds
+		textBounds.X = glyphBounds.X + glyphBounds.Width + padding
+		textBounds.Width = maxi(0, bounds.X+bounds.Width-textBounds.X-padding)
+
+		text := tv.groupHeaderText(group)
+		format := TextLeft | TextVCenter | TextSingleLine | TextEndEllipsis
+
+		canvas.DrawTextPixels(text, font, textColor, textBounds, format)
+
+		measured, _, err := canvas.MeasureTextPixels(text, font, textBounds, format)
+		if err != nil {
+			return
+		}
+
+		lineX = textBounds.X + measured.Width + padding
+	}
+
+	if lineX >= bounds.X+bounds.Width-padding {
+		return
+	}
+
+	if pen, _ := NewCosmeticPen(PenSolid, tv.themeSelectedBGColor); pen != nil {
+		defer pen.Dispose()
+
+		canvas.DrawLinePixels(pen, Point{X: lineX, Y: lineY}, Point{X: bounds.X + bounds.Width - padding, Y: lineY})
+	}
+}
@@ -104,6 +104,20 @@ func (tp *TabPage) Font() *Font {
 	return defaultFont
 }
 
+// RightToLeft returns whether the TabPage lays out and displays its content
+// from right to left.
+//
+// By default this is inherited from the TabWidget.
+func (tp *TabPage) RightToLeft() bool {
+	if tp.rightToLeft != nil {
+		return *tp.rightToLeft
+	} else if tp.tabWidget != nil {
+		return tp.tabWidget.RightToLeft()
+	}
+
+	return false
+}
+
 func (tp *TabPage) Image() Image {
 	return tp.image
 }
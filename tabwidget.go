@@ -73,6 +73,9 @@ func NewTabWidget(parent Container) (*TabWidget, error) {
 	win.SendMessage(tw.hWndTab, win.WM_SETFONT, uintptr(defaultFont.handleForDPI(dpi)), 1)
 
 	tw.applyFont(tw.Font())
+	if err := tw.applyRightToLeft(tw.RightToLeft()); err != nil {
+		return nil, err
+	}
 
 	tw.MustRegisterProperty("HasCurrentPage", NewReadOnlyBoolProperty(
 		func() bool {
@@ -120,6 +123,19 @@ func (tw *TabWidget) applyFont(font *Font) {
 	// applyFontToDescendants(tw, font)
 }
 
+func (tw *TabWidget) applyRightToLeft(rtl bool) error {
+	if err := tw.WidgetBase.applyRightToLeft(rtl); err != nil {
+		return err
+	}
+
+	if err := ensureWindowLongBits(tw.hWndTab, win.GWL_EXSTYLE, win.WS_EX_LAYOUTRTL|win.WS_EX_RTLREADING, rtl); err != nil {
+		return err
+	}
+	win.InvalidateRect(tw.hWndTab, nil, true)
+
+	return nil
+}
+
 func (tw *TabWidget) ApplyDPI(dpi int) {
 	tw.WidgetBase.ApplyDPI(dpi)
 
@@ -561,6 +577,10 @@ func (tw *TabWidget) onInsertedPage(index int, page *TabPage) (err error) {
 
 	page.applyFont(tw.Font())
 
+	if err = applyRightToLeftToDescendants(page); err != nil {
+		return
+	}
+
 	tw.Invalidate()
 
 	return
@@ -321,7 +321,7 @@ func (td *taskDialog) Show(opts TaskDialogOpts) (result TaskDialogResult, err er
 		ownerHWND = opts.Owner.Handle()
 		if opts.ForceRTLLayout == nil {
 			// Use the owner to determine RTL
-			rtl = opts.Owner.RightToLeftReading()
+			rtl = windowRightToLeft(opts.Owner) || opts.Owner.RightToLeftReading()
 		}
 	}
 
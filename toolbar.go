@@ -298,7 +298,7 @@ func (tb *ToolBar) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) ui
 
 				win.TrackPopupMenuEx(
 					action.menu.hMenu,
-					win.TPM_NOANIMATION,
+					action.menu.popupFlags(tb, tb.hWnd),
 					p.X,
 					p.Y,
 					tb.hWnd,
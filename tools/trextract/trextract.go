@@ -0,0 +1,275 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command trextract extracts the messages of calls of tr functions from the Go
+// source files of a module and merges them into its .tr translation catalogs.
+//
+// It recognizes calls of tr and l10n.Translate, with a source text and an
+// optional context, and of trn and l10n.TranslatePlural, with a source text,
+// a plural text, a number and an optional context. Texts must be string
+// literals, or concatenations of them, like the ones ui2walk generates.
+//
+// Usage, run in the directory of the catalogs:
+//
+//	trextract -name=walk -dir=.. -locales=de,fr,ko
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/wuc656/walk/l10n"
+)
+
+var (
+	name    = flag.String("name", "", "name of the catalogs, like walk for walk-de.tr")
+	dir     = flag.String("dir", ".", "root directory of the Go source files to extract messages from")
+	locales = flag.String("locales", "", "comma separated locales of the catalogs to update, like de,fr,ko")
+	out     = flag.String("out", ".", "directory of the catalogs")
+)
+
+// extractor collects the messages of the source files of a directory tree.
+type extractor struct {
+	fset        *token.FileSet
+	outDir      string
+	messages    []*l10n.Message
+	key2Message map[string]*l10n.Message
+}
+
+func newExtractor(outDir string) *extractor {
+	return &extractor{
+		fset:        token.NewFileSet(),
+		outDir:      outDir,
+		key2Message: make(map[string]*l10n.Message),
+	}
+}
+
+func (e *extractor) extractDir(root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		base := d.Name()
+
+		if d.IsDir() {
+			if path != root && (strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_") || base == "testdata" || base == "vendor") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(base, ".go") || strings.HasSuffix(base, "_test.go") {
+			return nil
+		}
+
+		return e.extractFile(path)
+	})
+}
+
+func (e *extractor) extractFile(path string) error {
+	file, err := parser.ParseFile(e.fset, path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return err
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		// Calls with texts, that are not known before run time, like the
+		// one in the tr function itself, are skipped.
+		if err := e.extractCall(call); err != nil {
+			log.Printf("%s: %v, skipped", e.fset.Position(call.Pos()), err)
+		}
+
+		return true
+	})
+
+	return nil
+}
+
+// extractCall extracts the message of call, if it is a call of a tr function.
+func (e *extractor) extractCall(call *ast.CallExpr) error {
+	var plural bool
+
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		switch fun.Name {
+		case "tr":
+		case "trn":
+			plural = true
+
+		default:
+			return nil
+		}
+
+	case *ast.SelectorExpr:
+		if pkg, ok := fun.X.(*ast.Ident); !ok || pkg.Name != "l10n" {
+			return nil
+		}
+
+		switch fun.Sel.Name {
+		case "Translate":
+		case "TranslatePlural":
+			plural = true
+
+		default:
+			return nil
+		}
+
+	default:
+		return nil
+	}
+
+	args := call.Args
+	if call.Ellipsis.IsValid() {
+		// The context is passed as a slice, which can not be extracted.
+		args = args[:len(args)-1]
+	}
+
+	minArgs := 1
+	if plural {
+		minArgs = 3
+	}
+	if len(args) < minArgs {
+		return nil
+	}
+
+	msg := new(l10n.Message)
+
+	var err error
+	if msg.Source, err = stringValue(args[0]); err != nil {
+		return err
+	}
+	args = args[1:]
+
+	if plural {
+		if msg.Plural, err = stringValue(args[0]); err != nil {
+			return err
+		}
+		args = args[2:]
+	}
+
+	for _, arg := range args {
+		c, err := stringValue(arg)
+		if err != nil {
+			return err
+		}
+
+		msg.Context = append(msg.Context, c)
+	}
+
+	pos := e.fset.Position(call.Pos())
+
+	file, err := filepath.Rel(e.outDir, pos.Filename)
+	if err != nil {
+		file = pos.Filename
+	}
+
+	e.add(msg, l10n.Location{File: filepath.ToSlash(file), Line: strconv.Itoa(pos.Line)})
+
+	return nil
+}
+
+func (e *extractor) add(msg *l10n.Message, location l10n.Location) {
+	k := msg.Source + "\x04" + strings.Join(msg.Context, "\x04")
+
+	if m, ok := e.key2Message[k]; ok {
+		m.Locations = append(m.Locations, location)
+		if m.Plural == "" {
+			m.Plural = msg.Plural
+		}
+		return
+	}
+
+	msg.Locations = []l10n.Location{location}
+	e.key2Message[k] = msg
+	e.messages = append(e.messages, msg)
+}
+
+// stringValue returns the value of expr, if it is a string literal or a
+// concatenation of string literals.
+func stringValue(expr ast.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind == token.STRING {
+			return strconv.Unquote(expr.Value)
+		}
+
+	case *ast.ParenExpr:
+		return stringValue(expr.X)
+
+	case *ast.BinaryExpr:
+		if expr.Op == token.ADD {
+			x, err := stringValue(expr.X)
+			if err != nil {
+				return "", err
+			}
+
+			y, err := stringValue(expr.Y)
+			if err != nil {
+				return "", err
+			}
+
+			return x + y, nil
+		}
+	}
+
+	return "", errors.New("argument is not a string literal")
+}
+
+func updateCatalog(path, locale string, messages []*l10n.Message) error {
+	f, err := l10n.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		f, err = new(l10n.File), nil
+	}
+	if err != nil {
+		return err
+	}
+
+	f.Merge(messages, locale)
+
+	return f.WriteFile(path)
+}
+
+func main() {
+	flag.Parse()
+
+	if *name == "" || *locales == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	e := newExtractor(*out)
+
+	if err := e.extractDir(*dir); err != nil {
+		log.Fatal(err)
+	}
+
+	for _, locale := range strings.Split(*locales, ",") {
+		locale = strings.TrimSpace(locale)
+		if locale == "" {
+			continue
+		}
+
+		path := filepath.Join(*out, fmt.Sprintf("%s-%s.tr", *name, locale))
+
+		if err := updateCatalog(path, locale, e.messages); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
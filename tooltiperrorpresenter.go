@@ -61,7 +61,8 @@ func (ttep *ToolTipErrorPresenter) Dispose() {
 }
 
 func (ttep *ToolTipErrorPresenter) PresentError(err error, widget Widget) {
-	if ttep.toolTip == nil {
+	// Errors without a widget have nothing to show a tool tip at.
+	if ttep.toolTip == nil || widget == nil {
 		return
 	}
 
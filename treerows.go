@@ -0,0 +1,163 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"slices"
+)
+
+// treeRowMap shows the nodes of a tree as rows, each expanded node followed by
+// its children. Only the roots and the children of expanded nodes are asked
+// for, so trees can be populated lazily. Nodes must be comparable.
+type treeRowMap struct {
+	children    func(node any) []any // the roots for nil
+	parent      func(node any) any   // nil for roots
+	hasChildren func(node any) bool
+	less        func(a, b any) bool // sorts siblings, nil keeps their order
+	expanded    map[any]bool        // keeps the state of nodes below collapsed ones
+	rows        []treeRow
+	indexes     map[any]int
+}
+
+// treeRow is a row of a treeRowMap.
+type treeRow struct {
+	node        any
+	parent      int // the row of the parent, -1 for roots
+	depth       int
+	hasChildren bool
+	expanded    bool
+}
+
+func (m *treeRowMap) build() {
+	m.rows = m.rows[:0]
+	m.indexes = make(map[any]int, len(m.indexes))
+
+	m.appendChildren(nil, -1, 0)
+}
+
+func (m *treeRowMap) appendChildren(parent any, parentRow, depth int) {
+	children := m.children(parent)
+	if m.less != nil {
+		children = slices.Clone(children)
+		slices.SortStableFunc(children, func(a, b any) int {
+			switch {
+			case m.less(a, b):
+				return -1
+
+			case m.less(b, a):
+				return 1
+			}
+
+			return 0
+		})
+	}
+
+	for _, node := range children {
+		row := len(m.rows)
+		hasChildren := m.hasChildren(node)
+		expanded := hasChildren && m.expanded[node]
+
+		m.rows = append(m.rows, treeRow{
+			node:        node,
+			parent:      parentRow,
+			depth:       depth,
+			hasChildren: hasChildren,
+			expanded:    expanded,
+		})
+		m.indexes[node] = row
+
+		if expanded {
+			m.appendChildren(node, row, depth+1)
+		}
+	}
+}
+
+func (m *treeRowMap) reset() {
+	if m.expanded == nil {
+		m.expanded = make(map[any]bool)
+	}
+
+	m.build()
+}
+
+func (m *treeRowMap) len() int {
+	return len(m.rows)
+}
+
+// index returns the row of node, or -1 if it is not shown.
+func (m *treeRowMap) index(node any) int {
+	if row, ok := m.indexes[node]; ok {
+		return row
+	}
+
+	return -1
+}
+
+// update rebuilds the rows after the tree changed and returns the events that
+// turn the old rows into the new ones. Rows of nodes, whose children appeared,
+// disappeared or were expanded or collapsed, are changed.
+func (m *treeRowMap) update() []rowEvent {
+	oldRows := slices.Clone(m.rows)
+	oldIDs := make([]any, len(oldRows))
+	for i, r := range oldRows {
+		oldIDs[i] = r.node
+	}
+
+	m.build()
+
+	newIDs := make([]any, len(m.rows))
+	for j, r := range m.rows {
+		newIDs[j] = r.node
+	}
+
+	oldIndexes := make(map[any]int, len(oldRows))
+	for i, node := range oldIDs {
+		oldIndexes[node] = i
+	}
+
+	return diffRows(oldIDs, newIDs, func(j int) bool {
+		old, now := oldRows[oldIndexes[newIDs[j]]], m.rows[j]
+
+		return old.hasChildren != now.hasChildren || old.expanded != now.expanded || old.depth != now.depth
+	})
+}
+
+// setExpanded expands or collapses node and returns the events that show or
+// hide its descendants.
+func (m *treeRowMap) setExpanded(node any, expanded bool) []rowEvent {
+	if expanded {
+		m.expanded[node] = true
+	} else {
+		delete(m.expanded, node)
+	}
+
+	return m.update()
+}
+
+// prune forgets the expanded state of nodes that were removed from the tree,
+// together with their descendants.
+func (m *treeRowMap) prune() {
+	for node := range m.expanded {
+		if !m.contains(node) {
+			delete(m.expanded, node)
+		}
+	}
+}
+
+// contains returns whether node is still in the tree, i.e. each node on the
+// way up to its root is among the children of its parent.
+func (m *treeRowMap) contains(node any) bool {
+	for {
+		parent := m.parent(node)
+		if !slices.Contains(m.children(parent), node) {
+			return false
+		}
+
+		if parent == nil {
+			return true
+		}
+		node = parent
+	}
+}
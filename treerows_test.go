@@ -0,0 +1,219 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// treeNodes returns nodes as the children of a treeRowMap.
+func treeNodes(nodes []string) []any {
+	children := make([]any, len(nodes))
+	for i, node := range nodes {
+		children[i] = node
+	}
+
+	return children
+}
+
+func shownTreeRows(m *treeRowMap) []string {
+	var shown []string
+	for _, r := range m.rows {
+		shown = append(shown, r.node.(string))
+	}
+
+	return shown
+}
+
+func TestTreeRowMapExpand(t *testing.T) {
+	tree := map[string][]string{
+		"":    {"a", "b"},
+		"a":   {"a/x", "a/y"},
+		"a/y": {"a/y/z"},
+	}
+	asked := make(map[string]bool)
+
+	m := &treeRowMap{
+		children: func(node any) []any {
+			parent, _ := node.(string)
+			asked[parent] = true
+			return treeNodes(tree[parent])
+		},
+		hasChildren: func(node any) bool { return len(tree[node.(string)]) > 0 },
+	}
+	m.reset()
+
+	if got, want := shownTreeRows(m), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Fatalf("shown = %v, want %v", got, want)
+	}
+	if asked["a"] {
+		t.Error("children of collapsed node were asked for")
+	}
+
+	before := shownTreeRows(m)
+	events := m.setExpanded("a", true)
+
+	if got, want := shownTreeRows(m), []string{"a", "a/x", "a/y", "b"}; !slices.Equal(got, want) {
+		t.Fatalf("shown = %v, want %v", got, want)
+	}
+	if got := applyEvents(before, shownTreeRows(m), events); !slices.Equal(got, shownTreeRows(m)) {
+		t.Errorf("events turn %v into %v, want %v", before, got, shownTreeRows(m))
+	}
+	if !slices.Contains(events, rowEvent{kind: rowsChanged, from: 0, to: 0}) {
+		t.Errorf("events = %v, want the expanded row changed", events)
+	}
+
+	m.setExpanded("a/y", true)
+
+	if r := m.rows[m.index("a/y/z")]; r.depth != 2 || r.parent != m.index("a/y") {
+		t.Errorf("a/y/z depth, parent = %d, %d, want 2, %d", r.depth, r.parent, m.index("a/y"))
+	}
+
+	before = shownTreeRows(m)
+	events = m.setExpanded("a", false)
+
+	if got, want := shownTreeRows(m), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Fatalf("shown = %v, want %v", got, want)
+	}
+	if got := applyEvents(before, shownTreeRows(m), events); !slices.Equal(got, shownTreeRows(m)) {
+		t.Errorf("events turn %v into %v, want %v", before, got, shownTreeRows(m))
+	}
+	if m.index("a/y/z") != -1 {
+		t.Error("index of hidden node is not -1")
+	}
+
+	// Expanding a again shows a/y expanded, as it was.
+	m.setExpanded("a", true)
+
+	if got, want := shownTreeRows(m), []string{"a", "a/x", "a/y", "a/y/z", "b"}; !slices.Equal(got, want) {
+		t.Errorf("shown = %v, want %v", got, want)
+	}
+}
+
+func TestTreeRowMapUpdate(t *testing.T) {
+	tree := map[string][]string{
+		"":  {"a", "b"},
+		"a": {"a/x"},
+	}
+
+	m := &treeRowMap{
+		children: func(node any) []any {
+			parent, _ := node.(string)
+			return treeNodes(tree[parent])
+		},
+		hasChildren: func(node any) bool { return len(tree[node.(string)]) > 0 },
+	}
+	m.reset()
+	m.setExpanded("a", true)
+
+	steps := []struct {
+		name   string
+		change func()
+		want   []string
+	}{
+		{"insert child", func() { tree["a"] = append(tree["a"], "a/w") }, []string{"a", "a/x", "a/w", "b"}},
+		{"insert root", func() { tree[""] = append(tree[""], "c") }, []string{"a", "a/x", "a/w", "b", "c"}},
+		{"remove child", func() { tree["a"] = []string{"a/w"} }, []string{"a", "a/w", "b", "c"}},
+		{"first child of collapsed", func() { tree["b"] = []string{"b/q"} }, []string{"a", "a/w", "b", "c"}},
+		{"remove last child", func() { delete(tree, "a") }, []string{"a", "b", "c"}},
+	}
+
+	for _, step := range steps {
+		before := shownTreeRows(m)
+		step.change()
+		events := m.update()
+
+		if got := shownTreeRows(m); !slices.Equal(got, step.want) {
+			t.Fatalf("%s: shown = %v, want %v", step.name, got, step.want)
+		}
+		if got := applyEvents(before, shownTreeRows(m), events); !slices.Equal(got, step.want) {
+			t.Errorf("%s: events %v turn %v into %v, want %v", step.name, events, before, got, step.want)
+		}
+	}
+
+	if r := m.rows[m.index("b")]; !r.hasChildren || r.expanded {
+		t.Errorf("b hasChildren, expanded = %t, %t, want true, false", r.hasChildren, r.expanded)
+	}
+	if r := m.rows[m.index("a")]; r.hasChildren || r.expanded {
+		t.Errorf("a hasChildren, expanded = %t, %t, want false, false", r.hasChildren, r.expanded)
+	}
+}
+
+func TestTreeRowMapSortsSiblings(t *testing.T) {
+	tree := map[string][]string{
+		"":  {"b", "a", "c"},
+		"b": {"b/2", "b/1"},
+	}
+
+	m := &treeRowMap{
+		children: func(node any) []any {
+			parent, _ := node.(string)
+			return treeNodes(tree[parent])
+		},
+		hasChildren: func(node any) bool { return len(tree[node.(string)]) > 0 },
+		less:        func(a, b any) bool { return a.(string) < b.(string) },
+	}
+	m.reset()
+	m.setExpanded("b", true)
+
+	if got, want := shownTreeRows(m), []string{"a", "b", "b/1", "b/2", "c"}; !slices.Equal(got, want) {
+		t.Errorf("shown = %v, want %v", got, want)
+	}
+}
+
+func TestTreeRowMapPrune(t *testing.T) {
+	tree := map[string][]string{
+		"":    {"a", "b"},
+		"a":   {"a/x"},
+		"a/x": {"a/x/y"},
+		"b":   {"b/z"},
+	}
+
+	m := &treeRowMap{
+		children: func(node any) []any {
+			parent, _ := node.(string)
+			return treeNodes(tree[parent])
+		},
+		parent: func(node any) any {
+			if i := strings.LastIndex(node.(string), "/"); i != -1 {
+				return node.(string)[:i]
+			}
+
+			return nil
+		},
+		hasChildren: func(node any) bool { return len(tree[node.(string)]) > 0 },
+	}
+	m.reset()
+	m.setExpanded("a/x", true)
+	m.setExpanded("a", true)
+	m.setExpanded("b", true)
+	m.setExpanded("a", false)
+
+	expanded := func() []string {
+		var nodes []string
+		for node := range m.expanded {
+			nodes = append(nodes, node.(string))
+		}
+		slices.Sort(nodes)
+
+		return nodes
+	}
+
+	// a/x stays expanded below collapsed a.
+	m.prune()
+
+	if got, want := expanded(), []string{"a/x", "b"}; !slices.Equal(got, want) {
+		t.Errorf("expanded = %v, want %v", got, want)
+	}
+
+	tree[""] = []string{"b"}
+	m.prune()
+
+	if got, want := expanded(), []string{"b"}; !slices.Equal(got, want) {
+		t.Errorf("expanded after removing a = %v, want %v", got, want)
+	}
+}
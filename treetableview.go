@@ -0,0 +1,710 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"encoding/json"
+	"slices"
+	"unsafe"
+
+	"github.com/wuc656/win"
+)
+
+// TreeTableItem is a TreeItem, that provides values for the columns of a
+// TreeTableView.
+type TreeTableItem interface {
+	TreeItem
+
+	// Value returns the value to display in column col. Column 0 shows the
+	// tree.
+	Value(col int) any
+}
+
+// treeTableModel shows the items of a TreeModel as the rows of a TableModel.
+type treeTableModel struct {
+	TableModelBase
+	SorterBase
+	model                     TreeModel
+	tree                      treeRowMap
+	compareFuncs              []func(a, b any) int
+	itemsResetHandlerHandle   int
+	itemChangedHandlerHandle  int
+	itemInsertedHandlerHandle int
+	itemRemovedHandlerHandle  int
+}
+
+func newTreeTableModel(model TreeModel) *treeTableModel {
+	m := &treeTableModel{model: model}
+	m.col = -1
+
+	m.tree.children = func(node any) []any {
+		var children []any
+
+		if node == nil {
+			for i := range model.RootCount() {
+				children = append(children, model.RootAt(i))
+			}
+		} else {
+			item := node.(TreeItem)
+			for i := range item.ChildCount() {
+				children = append(children, item.ChildAt(i))
+			}
+		}
+
+		return children
+	}
+	m.tree.parent = func(node any) any {
+		if parent := node.(TreeItem).Parent(); parent != nil {
+			return parent
+		}
+
+		return nil
+	}
+	m.tree.hasChildren = func(node any) bool {
+		if hc, ok := node.(HasChilder); ok {
+			return hc.HasChild()
+		}
+
+		return node.(TreeItem).ChildCount() > 0
+	}
+	m.tree.reset()
+
+	update := func(TreeItem) {
+		m.publishRowEvents(m.tree.update())
+	}
+	pruneAndUpdate := func(item TreeItem) {
+		m.tree.prune()
+		update(item)
+	}
+
+	m.itemsResetHandlerHandle = model.ItemsReset().Attach(pruneAndUpdate)
+	m.itemChangedHandlerHandle = model.ItemChanged().Attach(func(item TreeItem) {
+		update(item)
+
+		if row := m.tree.index(item); row > -1 {
+			m.PublishRowChanged(row)
+		}
+	})
+	m.itemInsertedHandlerHandle = model.ItemInserted().Attach(update)
+	m.itemRemovedHandlerHandle = model.ItemRemoved().Attach(pruneAndUpdate)
+
+	return m
+}
+
+func (m *treeTableModel) detach() {
+	m.model.ItemsReset().Detach(m.itemsResetHandlerHandle)
+	m.model.ItemChanged().Detach(m.itemChangedHandlerHandle)
+	m.model.ItemInserted().Detach(m.itemInsertedHandlerHandle)
+	m.model.ItemRemoved().Detach(m.itemRemovedHandlerHandle)
+}
+
+// item returns the item shown at row.
+func (m *treeTableModel) item(row int) TreeItem {
+	return m.tree.rows[row].node.(TreeItem)
+}
+
+func (m *treeTableModel) RowCount() int {
+	return m.tree.len()
+}
+
+func (m *treeTableModel) Value(row, col int) any {
+	return treeTableItemValue(m.item(row), col)
+}
+
+func treeTableItemValue(item TreeItem, col int) any {
+	if tti, ok := item.(TreeTableItem); ok {
+		return tti.Value(col)
+	}
+
+	if col == 0 {
+		return item.Text()
+	}
+
+	return nil
+}
+
+func (m *treeTableModel) Image(row int) any {
+	if imager, ok := m.item(row).(Imager); ok {
+		return imager.Image()
+	}
+
+	return nil
+}
+
+func (m *treeTableModel) ID(row int) any {
+	return m.item(row)
+}
+
+func (m *treeTableModel) Sort(col int, order SortOrder) error {
+	return m.SortByKeys(sortKeysFor(col, order))
+}
+
+// SortByKeys sorts the children of each item, and the roots, by keys.
+func (m *treeTableModel) SortByKeys(keys []SortKey) error {
+	m.setSortKeys(keys)
+
+	if len(keys) == 0 {
+		m.tree.less = nil
+	} else {
+		keys := m.keys
+		m.tree.less = func(a, b any) bool {
+			return lessByKeys(keys, a.(TreeItem), b.(TreeItem), m.compare)
+		}
+	}
+
+	m.tree.reset()
+
+	m.changedPublisher.Publish()
+
+	return nil
+}
+
+func (m *treeTableModel) setCompareFuncs(compareFuncs []func(a, b any) int) {
+	m.compareFuncs = compareFuncs
+}
+
+func (m *treeTableModel) compare(col int, a, b TreeItem) int {
+	return compareValuesWith(m.compareFuncs, col, treeTableItemValue(a, col), treeTableItemValue(b, col))
+}
+
+// TreeTableView is a TableView that shows the items of a TreeModel, each
+// expanded item followed by its children, indented below it.
+//
+// Columns are configured like those of a TableView. Items that implement
+// TreeTableItem provide the values of the columns, column 0 shows the tree and
+// the Text of other items. Items that implement Imager show their image in
+// column 0.
+//
+// Children are only asked for when their parent is expanded, so models whose
+// LazyPopulation returns true can populate items on demand. Items that
+// implement HasChilder are not asked to count their children just to show an
+// expand glyph.
+//
+// Sorting sorts the roots and the children of each item separately. Indexes,
+// like those of CurrentIndex, SelectedIndexes and CellStyle.Row, are indexes
+// of the rows shown. Check boxes are not supported.
+type TreeTableView struct {
+	*TableView
+	treeModel                TreeModel
+	tableModel               *treeTableModel
+	expandedChangedPublisher TreeItemEventPublisher
+}
+
+// NewTreeTableView creates and returns a *TreeTableView as child of the
+// specified Container.
+func NewTreeTableView(parent Container) (*TreeTableView, error) {
+	return NewTreeTableViewWithCfg(parent, &TableViewCfg{Style: win.LVS_SHOWSELALWAYS})
+}
+
+// NewTreeTableViewWithCfg creates and returns a *TreeTableView as child of the
+// specified Container and with the provided additional configuration.
+func NewTreeTableViewWithCfg(parent Container, cfg *TableViewCfg) (*TreeTableView, error) {
+	tv, err := NewTableViewWithCfg(parent, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ttv := &TreeTableView{TableView: tv}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			ttv.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(ttv); err != nil {
+		return nil, err
+	}
+
+	tv.tree = ttv
+
+	succeeded = true
+
+	return ttv, nil
+}
+
+// Dispose releases the operating system resources, associated with the
+// *TreeTableView.
+func (ttv *TreeTableView) Dispose() {
+	ttv.TableView.Dispose()
+
+	if ttv.tableModel != nil {
+		ttv.tableModel.detach()
+		ttv.tableModel = nil
+	}
+}
+
+// Model returns the model of the TreeTableView.
+func (ttv *TreeTableView) Model() TreeModel {
+	return ttv.treeModel
+}
+
+// SetModel sets the model of the TreeTableView. Its roots are shown
+// collapsed.
+func (ttv *TreeTableView) SetModel(model TreeModel) error {
+	if ttv.tableModel != nil {
+		ttv.tableModel.detach()
+		ttv.tableModel = nil
+	}
+
+	ttv.treeModel = model
+
+	if model == nil {
+		return ttv.TableView.SetModel(nil)
+	}
+
+	ttv.tableModel = newTreeTableModel(model)
+
+	return ttv.TableView.SetModel(ttv.tableModel)
+}
+
+// Item returns the item shown at index.
+func (ttv *TreeTableView) Item(index int) TreeItem {
+	if ttv.tableModel == nil || index < 0 || index >= ttv.tableModel.RowCount() {
+		return nil
+	}
+
+	return ttv.tableModel.item(index)
+}
+
+// Index returns the index item is shown at, or -1 if it is not shown, because
+// an ancestor is collapsed.
+func (ttv *TreeTableView) Index(item TreeItem) int {
+	if ttv.tableModel == nil || item == nil {
+		return -1
+	}
+
+	return ttv.tableModel.tree.index(item)
+}
+
+// CurrentItem returns the current item, or nil if there is none.
+func (ttv *TreeTableView) CurrentItem() TreeItem {
+	return ttv.Item(ttv.CurrentIndex())
+}
+
+// SetCurrentItem makes item the current item, expanding its ancestors. Call
+// this with nil to have no current item.
+func (ttv *TreeTableView) SetCurrentItem(item TreeItem) error {
+	if item == nil {
+		return ttv.SetCurrentIndex(-1)
+	}
+
+	if err := ttv.expandAncestors(item); err != nil {
+		return err
+	}
+
+	index := ttv.Index(item)
+	if index == -1 {
+		return newError("invalid item")
+	}
+
+	return ttv.SetCurrentIndex(index)
+}
+
+func (ttv *TreeTableView) expandAncestors(item TreeItem) error {
+	var ancestors []TreeItem
+	for parent := item.Parent(); parent != nil; parent = parent.Parent() {
+		ancestors = append(ancestors, parent)
+	}
+
+	for _, ancestor := range slices.Backward(ancestors) {
+		if err := ttv.SetExpanded(ancestor, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Expanded returns whether item is expanded.
+func (ttv *TreeTableView) Expanded(item TreeItem) bool {
+	if ttv.tableModel == nil {
+		return false
+	}
+
+	return ttv.tableModel.tree.expanded[item]
+}
+
+// SetExpanded expands or collapses item. Expanding an item expands its
+// ancestors, too. If the current item is a descendant of an item that is
+// collapsed, the collapsed item becomes current.
+func (ttv *TreeTableView) SetExpanded(item TreeItem, expanded bool) error {
+	if ttv.tableModel == nil || item == nil {
+		return newError("invalid item")
+	}
+
+	if expanded == ttv.Expanded(item) {
+		return nil
+	}
+
+	if expanded {
+		if err := ttv.expandAncestors(item); err != nil {
+			return err
+		}
+	} else if ttv.Index(item) > -1 {
+		for current := ttv.CurrentItem(); current != nil; current = current.Parent() {
+			if current.Parent() == item {
+				if err := ttv.SetCurrentIndex(ttv.Index(item)); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+
+	ttv.tableModel.publishRowEvents(ttv.tableModel.tree.setExpanded(item, expanded))
+
+	ttv.expandedChangedPublisher.Publish(item)
+
+	return nil
+}
+
+// ExpandedChanged returns the event that is published after an item was
+// expanded or collapsed.
+func (ttv *TreeTableView) ExpandedChanged() *TreeItemEvent {
+	return ttv.expandedChangedPublisher.Event()
+}
+
+// treeRow returns the row of the tree shown at index in the list views.
+func (ttv *TreeTableView) treeRow(index int) (*treeRow, bool) {
+	if ttv.tableModel == nil {
+		return nil, false
+	}
+
+	row := ttv.toModelIndex(index)
+	if row < 0 || row >= ttv.tableModel.RowCount() {
+		return nil, false
+	}
+
+	return &ttv.tableModel.tree.rows[row], true
+}
+
+// handleKeyDown expands, collapses and navigates the tree with the keyboard like
+// a TreeView and reports whether it handled key.
+func (ttv *TreeTableView) handleKeyDown(key Key) bool {
+	if ModifiersDown() != 0 {
+		return false
+	}
+
+	r, ok := ttv.treeRow(ttv.currentIndex)
+	if !ok {
+		return false
+	}
+	item := r.node.(TreeItem)
+
+	switch key {
+	case KeyLeft:
+		if r.expanded {
+			ttv.SetExpanded(item, false)
+			return true
+		}
+		if parent := item.Parent(); parent != nil {
+			ttv.SetCurrentItem(parent)
+			return true
+		}
+
+	case KeyRight:
+		if !r.hasChildren {
+			break
+		}
+		if !r.expanded {
+			ttv.SetExpanded(item, true)
+			return true
+		}
+		if index := ttv.currentIndex + 1; index < ttv.model.RowCount() {
+			ttv.setCurrentIndex(index)
+			return true
+		}
+
+	case KeyAdd, KeySubtract:
+		if r.hasChildren {
+			ttv.SetExpanded(item, key == KeyAdd)
+			return true
+		}
+	}
+
+	return false
+}
+
+// treeCellLayout returns the bounds of the cell of column 0 at index in the list
+// view hwnd and those of its expand glyph, in native pixels.
+func (ttv *TreeTableView) treeCellLayout(hwnd win.HWND, index int) (cell, glyph Rectangle, ok bool) {
+	r, ok := ttv.treeRow(index)
+	if !ok || ttv.columns.Len() == 0 {
+		return
+	}
+
+	tvc := ttv.columns.At(0)
+	if !tvc.visible || tvc.frozen != (hwnd == ttv.hwndFrozenLV) {
+		return cell, glyph, false
+	}
+
+	subItem := tvc.indexInListView()
+
+	rc := win.RECT{Top: subItem, Left: win.LVIR_BOUNDS}
+	if win.SendMessage(hwnd, win.LVM_GETSUBITEMRECT, uintptr(index), uintptr(unsafe.Pointer(&rc))) == 0 {
+		return cell, glyph, false
+	}
+	if subItem == 0 {
+		// LVIR_BOUNDS is the whole row for the first column.
+		rc.Right = rc.Left + int32(win.SendMessage(hwnd, win.LVM_GETCOLUMNWIDTH, 0, 0))
+	}
+
+	cell = rectangleFromRECT(rc)
+
+	size := IntFrom96DPI(16, ttv.DPI())
+	glyph = Rectangle{
+		X:      cell.X + r.depth*size,
+		Y:      cell.Y + (cell.Height-size)/2,
+		Width:  size,
+		Height: size,
+	}
+
+	return cell, glyph, true
+}
+
+// glyphClicked expands or collapses the item, whose expand glyph in the list
+// view hwnd is at x, y, and reports whether there is one.
+func (ttv *TreeTableView) glyphClicked(hwnd win.HWND, index, x int) bool {
+	r, ok := ttv.treeRow(index)
+	if !ok || !r.hasChildren {
+		return false
+	}
+
+	_, glyph, ok := ttv.treeCellLayout(hwnd, index)
+	if !ok || x < glyph.X || x >= glyph.X+glyph.Width {
+		return false
+	}
+
+	win.SetFocus(ttv.hwndFrozenLV)
+	ttv.setCurrentIndex(index)
+
+	ttv.SetExpanded(r.node.(TreeItem), !r.expanded)
+
+	return true
+}
+
+// drawTreeCell draws the cell of column 0 at index with the indentation and
+// expand glyph of its item, using the colors and font of the cell style.
+func (ttv *TreeTableView) drawTreeCell(hwnd win.HWND, nmlvcd *win.NMLVCUSTOMDRAW, index int) {
+	r, _ := ttv.treeRow(index)
+
+	cell, glyph, ok := ttv.treeCellLayout(hwnd, index)
+	if !ok {
+		return
+	}
+
+	hdc := nmlvcd.Nmcd.Hdc
+
+	canvas, err := newCanvasFromHDC(hdc)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	dpi := ttv.DPI()
+
+	bgColor := ttv.style.BackgroundColor
+	if win.SendMessage(hwnd, win.LVM_GETITEMSTATE, uintptr(index), win.LVIS_SELECTED)&win.LVIS_SELECTED != 0 && !ttv.Focused() {
+		bgColor = ttv.themeSelectedNotFocusedBGColor
+	}
+	if bgColor != ttv.themeNormalBGColor {
+		if brush, _ := NewSolidColorBrush(bgColor); brush != nil {
+			defer brush.Dispose()
+
+			canvas.FillRectanglePixels(brush, cell)
+		}
+	}
+
+	font := ttv.style.Font
+	if font == nil {
+		font = ttv.itemFont
+	}
+	if font == nil {
+		font = ttv.Font()
+	}
+
+	if r.hasChildren {
+		if hTheme := win.OpenThemeData(hwnd, CachedStringToUTF16Ptr("TreeView")); hTheme != 0 {
+			defer win.CloseThemeData(hTheme)
+
+			state := int32(glpsClosed)
+			if r.expanded {
+				state = glpsOpened
+			}
+
+			rcGlyph := glyph.toRECT()
+			win.DrawThemeBackground(hTheme, hdc, win.TVP_GLYPH, state, &rcGlyph, nil)
+		} else {
+			text := "+"
+			if r.expanded {
+				text = "-"
+			}
+
+			canvas.DrawTextPixels(text, font, ttv.style.TextColor, glyph, TextCenter|TextVCenter|TextSingleLine)
+		}
+	}
+
+	padding := IntFrom96DPI(2, dpi)
+	x := glyph.X + glyph.Width + padding
+
+	var image any
+	if ip := ttv.imageProvider; ip != nil {
+		image = ip.Image(index)
+	}
+	if image == nil {
+		image = ttv.style.Image
+	}
+	if image != nil {
+		if ttv.hIml == 0 {
+			ttv.applyImageListForImage(image)
+		}
+
+		i := imageIndexMaybeAdd(
+			image,
+			ttv.hIml,
+			ttv.usingSysIml,
+			ttv.imageUintptr2Index,
+			ttv.filePath2IconIndex,
+			dpi)
+
+		size := int(win.GetSystemMetricsForDpi(win.SM_CXSMICON, uint32(dpi)))
+		y := cell.Y + (cell.Height-size)/2
+
+		win.ImageList_DrawEx(ttv.hIml, i, hdc, int32(x), int32(y), 0, 0, win.CLR_NONE, win.CLR_NONE, win.ILD_TRANSPARENT)
+
+		x += size + padding
+	}
+
+	bounds := Rectangle{X: x, Y: cell.Y, Width: maxi(0, cell.X+cell.Width-x-padding), Height: cell.Height}
+	text := ttv.formatValue(0, ttv.model.Value(index, 0))
+
+	canvas.DrawTextPixels(text, font, ttv.style.TextColor, bounds, TextLeft|TextVCenter|TextSingleLine|TextEndEllipsis)
+}
+
+type treeTableViewState struct {
+	*tableViewState
+	Expanded [][]string
+}
+
+// SaveState writes the UI state of the *TreeTableView to the settings. Besides
+// that of a TableView, it includes which items are expanded, identified by the
+// texts of the items along their paths.
+func (ttv *TreeTableView) SaveState() error {
+	if ttv.columns.Len() == 0 {
+		return nil
+	}
+
+	if err := ttv.updateState(); err != nil {
+		return err
+	}
+
+	ttvs := treeTableViewState{tableViewState: ttv.state}
+
+	if ttv.tableModel != nil {
+		for node := range ttv.tableModel.tree.expanded {
+			ttvs.Expanded = append(ttvs.Expanded, treeItemPath(node.(TreeItem)))
+		}
+
+		slices.SortFunc(ttvs.Expanded, slices.Compare)
+	}
+
+	state, err := json.Marshal(ttvs)
+	if err != nil {
+		return err
+	}
+
+	return ttv.WriteState(string(state))
+}
+
+// RestoreState restores the UI state of the *TreeTableView from the settings.
+func (ttv *TreeTableView) RestoreState() error {
+	state, err := ttv.ReadState()
+	if err != nil {
+		return err
+	}
+	if state == "" {
+		return nil
+	}
+
+	ttv.SetSuspended(true)
+	defer ttv.SetSuspended(false)
+
+	if ttv.state == nil {
+		ttv.state = new(tableViewState)
+	}
+
+	ttvs := treeTableViewState{tableViewState: ttv.state}
+
+	if err := json.Unmarshal(([]byte)(state), &ttvs); err != nil {
+		return err
+	}
+
+	if err := ttv.applyState(); err != nil {
+		return err
+	}
+
+	if ttv.treeModel == nil {
+		return nil
+	}
+
+	for _, path := range ttvs.Expanded {
+		if item := treeItemAtPath(ttv.treeModel, path); item != nil {
+			if err := ttv.SetExpanded(item, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// treeItemPath returns the texts of item and its ancestors, starting with the
+// root.
+func treeItemPath(item TreeItem) []string {
+	var path []string
+	for ; item != nil; item = item.Parent() {
+		path = append(path, item.Text())
+	}
+
+	slices.Reverse(path)
+
+	return path
+}
+
+// treeItemAtPath returns the item of model, whose path is path, or nil if there
+// is none.
+func treeItemAtPath(model TreeModel, path []string) TreeItem {
+	var item TreeItem
+
+	for _, text := range path {
+		var count int
+		var childAt func(i int) TreeItem
+		if item == nil {
+			count, childAt = model.RootCount(), model.RootAt
+		} else {
+			count, childAt = item.ChildCount(), item.ChildAt
+		}
+
+		var found TreeItem
+		for i := range count {
+			if child := childAt(i); child.Text() == text {
+				found = child
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+
+		item = found
+	}
+
+	return item
+}
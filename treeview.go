@@ -37,10 +37,14 @@ type TreeView struct {
 	expandedChangedPublisher       TreeItemEventPublisher
 	currentItemChangedPublisher    EventPublisher
 	itemActivatedPublisher         EventPublisher
+	itemsReorderable               bool
+	dragItem                       TreeItem
+	dropPosition                   treeViewDropPosition
+	drop                           treeViewDropPosition
 }
 
 func NewTreeView(parent Container) (*TreeView, error) {
-	tv := new(TreeView)
+	tv := &TreeView{drop: treeViewDropPosition{index: -1}}
 
 	if err := InitWidget(
 		tv,
@@ -624,6 +628,11 @@ func (tv *TreeView) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 		case win.NM_DBLCLK:
 			tv.itemActivatedPublisher.Publish()
 
+		case win.TVN_BEGINDRAG:
+			nmtv := (*win.NMTREEVIEW)(unsafe.Pointer(lParam))
+
+			tv.beginDrag(tv.handle2Item[nmtv.ItemNew.HItem])
+
 		case win.TVN_KEYDOWN:
 			nmtvkd := (*win.NMTVKEYDOWN)(unsafe.Pointer(lParam))
 			if nmtvkd.WVKey == uint16(KeyReturn) {
@@ -0,0 +1,234 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"unsafe"
+
+	"github.com/wuc656/win"
+)
+
+const (
+	tvgnDropHilite  = 0x0008
+	tvgnLastVisible = 0x000A
+)
+
+// treeViewDropPosition is where a drag over a TreeView would drop and how the
+// TreeView shows it.
+type treeViewDropPosition struct {
+	parent TreeItem
+	index  int           // -1 if there is no drag over the TreeView
+	mark   win.HTREEITEM // the item the insert mark is shown at
+	after  bool          // whether the insert mark is shown after mark
+	into   win.HTREEITEM // the item shown as drop target, if dropping into it
+}
+
+// ItemsReorderable returns whether the user can move the items of the
+// TreeView by drag and drop.
+func (tv *TreeView) ItemsReorderable() bool {
+	return tv.itemsReorderable
+}
+
+// SetItemsReorderable sets whether the user can move the items of the TreeView
+// by drag and drop, between other items or into them. The model must implement
+// TreeItemMover.
+func (tv *TreeView) SetItemsReorderable(reorderable bool) error {
+	tv.itemsReorderable = reorderable
+
+	if reorderable {
+		return tv.ensureDropTarget().register()
+	}
+
+	return nil
+}
+
+// DropParent returns the item, a drag over the TreeView would drop into, or nil
+// for the roots or if there is no drag over it.
+func (tv *TreeView) DropParent() TreeItem {
+	return tv.drop.parent
+}
+
+// DropIndex returns the index among the children of DropParent, in front of
+// which a drag over the TreeView would drop, or -1 if there is no drag over it.
+func (tv *TreeView) DropIndex() int {
+	return tv.drop.index
+}
+
+// itemMover returns the TreeItemMover items can be moved with, or nil.
+func (tv *TreeView) itemMover() TreeItemMover {
+	if !tv.itemsReorderable {
+		return nil
+	}
+
+	mover, _ := tv.model.(TreeItemMover)
+
+	return mover
+}
+
+// beginDrag lets the user drag item to move it. It can be dropped on other
+// applications as text.
+func (tv *TreeView) beginDrag(item TreeItem) {
+	if item == nil || tv.itemMover() == nil {
+		return
+	}
+
+	tv.dragItem = item
+	defer func() {
+		tv.dragItem = nil
+	}()
+
+	tv.DoDragDrop(ClipboardContent{Text: item.Text()}, DropEffectCopy|DropEffectMove)
+}
+
+func (tv *TreeView) isReorderDrag(args *DragDropEventArgs) bool {
+	return args != nil && args.Source() == tv.window && tv.dragItem != nil && tv.itemMover() != nil
+}
+
+// childCount returns the number of children of parent, or of roots if parent
+// is nil.
+func (tv *TreeView) childCount(parent TreeItem) int {
+	if parent == nil {
+		if tv.model == nil {
+			return 0
+		}
+
+		return tv.model.RootCount()
+	}
+
+	return parent.ChildCount()
+}
+
+// indexOf returns the index of item among its siblings, or -1.
+func (tv *TreeView) indexOf(item TreeItem) int {
+	parent := item.Parent()
+
+	for i := range tv.childCount(parent) {
+		var sibling TreeItem
+		if parent == nil {
+			sibling = tv.model.RootAt(i)
+		} else {
+			sibling = parent.ChildAt(i)
+		}
+
+		if sibling == item {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (tv *TreeView) setDropPosition(p Point) {
+	pos := treeViewDropPosition{index: tv.childCount(nil)}
+	if pos.index > 0 {
+		pos.mark = win.HTREEITEM(tv.SendMessage(win.TVM_GETNEXTITEM, tvgnLastVisible, 0))
+		pos.after = true
+	}
+
+	hti := win.TVHITTESTINFO{Pt: p.toPOINT()}
+	tv.SendMessage(win.TVM_HITTEST, 0, uintptr(unsafe.Pointer(&hti)))
+
+	if item, ok := tv.handle2Item[hti.HItem]; ok {
+		var rc win.RECT
+		*(*win.HTREEITEM)(unsafe.Pointer(&rc)) = hti.HItem
+		tv.SendMessage(win.TVM_GETITEMRECT, 0, uintptr(unsafe.Pointer(&rc)))
+
+		height := rc.Bottom - rc.Top
+		y := int32(p.Y)
+
+		switch {
+		case y < rc.Top+height/4:
+			// Over the top quarter of the item, drops go in front of it.
+			pos = treeViewDropPosition{parent: item.Parent(), index: tv.indexOf(item), mark: hti.HItem}
+
+		case y >= rc.Bottom-height/4 && !(tv.Expanded(item) && item.ChildCount() > 0):
+			// Over the bottom quarter, drops go after it, unless it shows
+			// its children, which come next then.
+			pos = treeViewDropPosition{parent: item.Parent(), index: tv.indexOf(item) + 1, mark: hti.HItem, after: true}
+
+		default:
+			pos = treeViewDropPosition{parent: item, index: item.ChildCount(), into: hti.HItem}
+		}
+	}
+
+	tv.dropPosition = pos
+
+	// Scroll, while the mouse is over the first or last visible item.
+	var rcClient win.RECT
+	win.GetClientRect(tv.hWnd, &rcClient)
+
+	height := int32(tv.ItemHeight())
+
+	if int32(p.Y) < rcClient.Top+height/2 {
+		tv.SendMessage(win.WM_VSCROLL, win.SB_LINEUP, 0)
+	} else if int32(p.Y) > rcClient.Bottom-height/2 && int32(p.Y) <= rcClient.Bottom {
+		tv.SendMessage(win.WM_VSCROLL, win.SB_LINEDOWN, 0)
+	}
+}
+
+// canDropInto returns whether the dragged item can be moved into parent, which
+// is neither the item itself nor one of its descendants.
+func (tv *TreeView) canDropInto(parent TreeItem) bool {
+	for ; parent != nil; parent = parent.Parent() {
+		if parent == tv.dragItem {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (tv *TreeView) dropEffect(args *DragDropEventArgs, effect DropEffect) DropEffect {
+	if tv.isReorderDrag(args) {
+		if tv.canDropInto(tv.dropPosition.parent) {
+			effect = DropEffectMove
+		} else {
+			effect = DropEffectNone
+		}
+	}
+
+	pos := treeViewDropPosition{index: -1}
+	if effect != DropEffectNone {
+		pos = tv.dropPosition
+	}
+	tv.setDrop(pos)
+
+	return effect
+}
+
+func (tv *TreeView) endDrag(args *DragDropEventArgs, effect DropEffect, dropped bool) DropEffect {
+	pos := tv.drop
+	tv.setDrop(treeViewDropPosition{index: -1})
+
+	if !dropped || !tv.isReorderDrag(args) || pos.index == -1 {
+		return effect
+	}
+
+	item := tv.dragItem
+
+	if err := tv.itemMover().MoveItem(item, pos.parent, pos.index); err != nil {
+		return DropEffectNone
+	}
+
+	tv.SetCurrentItem(item)
+
+	return DropEffectMove
+}
+
+// setDrop shows where a drag would drop, the insert mark between items or the
+// item it would drop into highlighted.
+func (tv *TreeView) setDrop(pos treeViewDropPosition) {
+	if pos.mark != tv.drop.mark || pos.after != tv.drop.after {
+		tv.SendMessage(win.TVM_SETINSERTMARK, uintptr(win.BoolToBOOL(pos.after)), uintptr(pos.mark))
+	}
+	if pos.into != tv.drop.into {
+		tv.SendMessage(win.TVM_SELECTITEM, tvgnDropHilite, uintptr(pos.into))
+	}
+
+	tv.drop = pos
+}
@@ -0,0 +1,374 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// TypedSettings stores values of Go types in a Settings, under keys registered
+// with MustRegisterSetting.
+//
+// Strings, bools, numbers and time.Duration values are stored as their text,
+// as are values implementing encoding.TextMarshaler, like time.Time. Values of
+// all other types, like slices and structs, are stored as JSON.
+type TypedSettings struct {
+	settings         Settings
+	key2Setting      map[string]typedSetting
+	changedPublisher SettingChangedEventPublisher
+}
+
+// typedSetting is implemented by all Setting types.
+type typedSetting interface {
+	validateStored() error
+}
+
+// NewTypedSettings returns a new TypedSettings, that stores values in settings.
+func NewTypedSettings(settings Settings) *TypedSettings {
+	return &TypedSettings{
+		settings:    settings,
+		key2Setting: make(map[string]typedSetting),
+	}
+}
+
+// Settings returns the Settings ts stores values in.
+func (ts *TypedSettings) Settings() Settings {
+	return ts.settings
+}
+
+// Keys returns the registered keys in ascending order.
+func (ts *TypedSettings) Keys() []string {
+	return slices.Sorted(maps.Keys(ts.key2Setting))
+}
+
+// Changed returns the event that is published, when the value of a setting is
+// changed through ts.
+func (ts *TypedSettings) Changed() *SettingChangedEvent {
+	return ts.changedPublisher.Event()
+}
+
+// Validate checks the stored values of all registered settings. It returns an
+// error for each value, that cannot be decoded or fails the validation of its
+// setting.
+func (ts *TypedSettings) Validate() error {
+	var errs []error
+
+	for _, key := range ts.Keys() {
+		if err := ts.key2Setting[key].validateStored(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SettingsMigration upgrades the settings an older version of an application
+// stored.
+type SettingsMigration struct {
+	// Version is the version of the settings after the migration.
+	Version int
+
+	// Renames maps the old keys to the keys their values move to. Values
+	// already stored under the new keys are kept.
+	Renames map[string]string
+
+	// Migrate, if not nil, is called after the renames to convert values.
+	Migrate func(settings Settings) error
+}
+
+// Migrate runs the migrations with a Version greater than the version stored
+// under versionKey in the order of their versions, and stores the Version of
+// the last one. Settings without a version are at version 0.
+func (ts *TypedSettings) Migrate(versionKey string, migrations ...SettingsMigration) error {
+	version := 0
+	if s, ok := ts.settings.Get(versionKey); ok {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("invalid settings version %q: %w", s, err)
+		}
+		version = v
+	}
+
+	migrations = slices.SortedStableFunc(slices.Values(migrations), func(a, b SettingsMigration) int {
+		return a.Version - b.Version
+	})
+
+	for _, m := range migrations {
+		if m.Version <= version {
+			continue
+		}
+
+		for _, oldKey := range slices.Sorted(maps.Keys(m.Renames)) {
+			if err := ts.rename(oldKey, m.Renames[oldKey]); err != nil {
+				return err
+			}
+		}
+
+		if m.Migrate != nil {
+			if err := m.Migrate(ts.settings); err != nil {
+				return fmt.Errorf("settings migration to version %d: %w", m.Version, err)
+			}
+		}
+
+		version = m.Version
+
+		if err := ts.settings.Put(versionKey, strconv.Itoa(version)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ts *TypedSettings) rename(oldKey, newKey string) error {
+	value, ok := ts.settings.Get(oldKey)
+	if !ok {
+		return nil
+	}
+
+	if _, ok := ts.settings.Get(newKey); !ok {
+		if err := ts.settings.Put(newKey, value); err != nil {
+			return err
+		}
+
+		ts.changedPublisher.Publish(newKey)
+	}
+
+	return ts.settings.Remove(oldKey)
+}
+
+// Setting is a setting with a value of type T, stored under a key of
+// TypedSettings.
+type Setting[T any] struct {
+	ts           *TypedSettings
+	key          string
+	defaultValue T
+	validate     func(value T) error
+}
+
+// MustRegisterSetting registers key with ts and returns the Setting to get and
+// set its value with. Get returns defaultValue while no valid value is stored.
+// If validate is not nil, values it returns an error for are neither stored nor
+// returned. MustRegisterSetting panics, if key is already registered.
+func MustRegisterSetting[T any](ts *TypedSettings, key string, defaultValue T, validate func(value T) error) *Setting[T] {
+	if _, ok := ts.key2Setting[key]; ok {
+		panic("setting already registered")
+	}
+
+	s := &Setting[T]{
+		ts:           ts,
+		key:          key,
+		defaultValue: defaultValue,
+		validate:     validate,
+	}
+
+	ts.key2Setting[key] = s
+
+	return s
+}
+
+// Key returns the key s stores its value under.
+func (s *Setting[T]) Key() string {
+	return s.key
+}
+
+// Default returns the value of s, while no valid value is stored.
+func (s *Setting[T]) Default() T {
+	return s.defaultValue
+}
+
+// Get returns the stored value of s, or the default value if there is none or
+// it is invalid.
+func (s *Setting[T]) Get() T {
+	value, err := s.Lookup()
+	if err != nil {
+		return s.defaultValue
+	}
+
+	return value
+}
+
+// Lookup returns the stored value of s, or the default value if there is none.
+// It returns an error, if the stored value is invalid.
+func (s *Setting[T]) Lookup() (T, error) {
+	str, ok := s.ts.settings.Get(s.key)
+	if !ok {
+		return s.defaultValue, nil
+	}
+
+	return s.decode(str)
+}
+
+// Set validates and stores value. The Changed event of the TypedSettings is
+// published, if the stored value changes.
+func (s *Setting[T]) Set(value T) error {
+	if s.validate != nil {
+		if err := s.validate(value); err != nil {
+			return fmt.Errorf("setting %q: %w", s.key, err)
+		}
+	}
+
+	str, err := encodeSettingValue(value)
+	if err != nil {
+		return fmt.Errorf("setting %q: %w", s.key, err)
+	}
+
+	if old, ok := s.ts.settings.Get(s.key); ok && old == str {
+		return nil
+	}
+
+	if err := s.ts.settings.Put(s.key, str); err != nil {
+		return err
+	}
+
+	s.ts.changedPublisher.Publish(s.key)
+
+	return nil
+}
+
+// Reset removes the stored value of s, so Get returns the default value.
+func (s *Setting[T]) Reset() error {
+	if _, ok := s.ts.settings.Get(s.key); !ok {
+		return nil
+	}
+
+	if err := s.ts.settings.Remove(s.key); err != nil {
+		return err
+	}
+
+	s.ts.changedPublisher.Publish(s.key)
+
+	return nil
+}
+
+func (s *Setting[T]) decode(str string) (T, error) {
+	var value T
+	if err := decodeSettingValue(str, &value); err != nil {
+		return s.defaultValue, fmt.Errorf("setting %q: %w", s.key, err)
+	}
+
+	if s.validate != nil {
+		if err := s.validate(value); err != nil {
+			return s.defaultValue, fmt.Errorf("setting %q: %w", s.key, err)
+		}
+	}
+
+	return value, nil
+}
+
+func (s *Setting[T]) validateStored() error {
+	_, err := s.Lookup()
+
+	return err
+}
+
+// encodeSettingValue returns the text value is stored as.
+func encodeSettingValue(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+
+	case time.Duration:
+		return v.String(), nil
+
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		return string(text), err
+	}
+
+	rv := reflect.ValueOf(value)
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits()), nil
+
+	case reflect.String:
+		return rv.String(), nil
+	}
+
+	data, err := json.Marshal(value)
+
+	return string(data), err
+}
+
+// decodeSettingValue decodes the text encodeSettingValue returned into the
+// value ptr points to.
+func decodeSettingValue(str string, ptr any) error {
+	switch p := ptr.(type) {
+	case *string:
+		*p = str
+		return nil
+
+	case *time.Duration:
+		d, err := time.ParseDuration(str)
+		if err != nil {
+			return err
+		}
+		*p = d
+		return nil
+
+	case encoding.TextUnmarshaler:
+		return p.UnmarshalText([]byte(str))
+	}
+
+	rv := reflect.ValueOf(ptr).Elem()
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(str)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(str, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(n)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(str, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(n)
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(str, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+		return nil
+
+	case reflect.String:
+		rv.SetString(str)
+		return nil
+	}
+
+	return json.Unmarshal([]byte(str), ptr)
+}
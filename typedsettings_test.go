@@ -0,0 +1,248 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package walk
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// mapSettings is a Settings that keeps its values in memory.
+type mapSettings map[string]string
+
+func (ms mapSettings) Get(key string) (string, bool) {
+	value, ok := ms[key]
+	return value, ok
+}
+
+func (ms mapSettings) Timestamp(key string) (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (ms mapSettings) Put(key, value string) error {
+	ms[key] = value
+	return nil
+}
+
+func (ms mapSettings) PutExpiring(key, value string) error {
+	return ms.Put(key, value)
+}
+
+func (ms mapSettings) Remove(key string) error {
+	delete(ms, key)
+	return nil
+}
+
+func (ms mapSettings) ExpireDuration() time.Duration {
+	return 0
+}
+
+func (ms mapSettings) SetExpireDuration(expireDuration time.Duration) {
+}
+
+func (ms mapSettings) Load() error {
+	return nil
+}
+
+func (ms mapSettings) Save() error {
+	return nil
+}
+
+type testWindowSettings struct {
+	Columns []string
+	Width   int
+}
+
+func TestSettingRoundTrip(t *testing.T) {
+	ms := mapSettings{}
+	ts := NewTypedSettings(ms)
+
+	count := MustRegisterSetting(ts, "Count", 3, nil)
+	enabled := MustRegisterSetting(ts, "Enabled", false, nil)
+	ratio := MustRegisterSetting(ts, "Ratio", 0.5, nil)
+	interval := MustRegisterSetting(ts, "Interval", time.Second, nil)
+	since := MustRegisterSetting(ts, "Since", time.Time{}, nil)
+	recent := MustRegisterSetting[[]string](ts, "Recent", nil, nil)
+	window := MustRegisterSetting(ts, "Window", testWindowSettings{Width: 100}, nil)
+
+	if got := count.Get(); got != 3 {
+		t.Errorf("count.Get() = %d, want default 3", got)
+	}
+	if got := window.Get(); got.Width != 100 {
+		t.Errorf("window.Get().Width = %d, want default 100", got.Width)
+	}
+
+	sinceValue := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	windowValue := testWindowSettings{Columns: []string{"Name", "Size"}, Width: 640}
+
+	for _, err := range []error{
+		count.Set(42),
+		enabled.Set(true),
+		ratio.Set(0.25),
+		interval.Set(90 * time.Second),
+		since.Set(sinceValue),
+		recent.Set([]string{"a.txt", "b.txt"}),
+		window.Set(windowValue),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stored := mapSettings{
+		"Count":    "42",
+		"Enabled":  "true",
+		"Ratio":    "0.25",
+		"Interval": "1m30s",
+		"Since":    "2026-01-02T03:04:05Z",
+		"Recent":   `["a.txt","b.txt"]`,
+		"Window":   `{"Columns":["Name","Size"],"Width":640}`,
+	}
+	if !reflect.DeepEqual(ms, stored) {
+		t.Errorf("stored %v, want %v", ms, stored)
+	}
+
+	ts = NewTypedSettings(ms)
+
+	if got := MustRegisterSetting(ts, "Count", 3, nil).Get(); got != 42 {
+		t.Errorf("Count = %d, want 42", got)
+	}
+	if got := MustRegisterSetting(ts, "Enabled", false, nil).Get(); !got {
+		t.Errorf("Enabled = %t, want true", got)
+	}
+	if got := MustRegisterSetting(ts, "Ratio", 0.5, nil).Get(); got != 0.25 {
+		t.Errorf("Ratio = %g, want 0.25", got)
+	}
+	if got := MustRegisterSetting(ts, "Interval", time.Second, nil).Get(); got != 90*time.Second {
+		t.Errorf("Interval = %s, want 1m30s", got)
+	}
+	if got := MustRegisterSetting(ts, "Since", time.Time{}, nil).Get(); !got.Equal(sinceValue) {
+		t.Errorf("Since = %s, want %s", got, sinceValue)
+	}
+	if got := MustRegisterSetting[[]string](ts, "Recent", nil, nil).Get(); !reflect.DeepEqual(got, []string{"a.txt", "b.txt"}) {
+		t.Errorf("Recent = %q, want [a.txt b.txt]", got)
+	}
+	if got := MustRegisterSetting(ts, "Window", testWindowSettings{}, nil).Get(); !reflect.DeepEqual(got, windowValue) {
+		t.Errorf("Window = %+v, want %+v", got, windowValue)
+	}
+}
+
+func TestSettingValidation(t *testing.T) {
+	ms := mapSettings{"Size": "12", "Broken": "abc"}
+	ts := NewTypedSettings(ms)
+
+	errTooSmall := errors.New("too small")
+	size := MustRegisterSetting(ts, "Size", 8, func(value int) error {
+		if value < 6 {
+			return errTooSmall
+		}
+		return nil
+	})
+	broken := MustRegisterSetting(ts, "Broken", 1, nil)
+
+	if err := size.Set(4); !errors.Is(err, errTooSmall) {
+		t.Errorf("size.Set(4) = %v, want %v", err, errTooSmall)
+	}
+	if got := ms["Size"]; got != "12" {
+		t.Errorf("stored Size %q after invalid Set, want 12", got)
+	}
+
+	ms["Size"] = "2"
+	if got := size.Get(); got != 8 {
+		t.Errorf("size.Get() = %d for invalid stored value, want default 8", got)
+	}
+	if _, err := size.Lookup(); !errors.Is(err, errTooSmall) {
+		t.Errorf("size.Lookup() error = %v, want %v", err, errTooSmall)
+	}
+
+	if got := broken.Get(); got != 1 {
+		t.Errorf("broken.Get() = %d, want default 1", got)
+	}
+
+	if err := ts.Validate(); err == nil {
+		t.Error("Validate() = nil, want errors for Broken and Size")
+	}
+
+	if err := broken.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if err := size.Set(10); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+}
+
+func TestSettingChanged(t *testing.T) {
+	ts := NewTypedSettings(mapSettings{})
+	name := MustRegisterSetting(ts, "Name", "", nil)
+
+	var changed []string
+	ts.Changed().Attach(func(key string) {
+		changed = append(changed, key)
+	})
+
+	name.Set("a")
+	name.Set("a")
+	name.Set("b")
+	name.Reset()
+	name.Reset()
+
+	if want := []string{"Name", "Name", "Name"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("changed %q, want %q", changed, want)
+	}
+}
+
+func TestMustRegisterSettingTwice(t *testing.T) {
+	ts := NewTypedSettings(mapSettings{})
+	MustRegisterSetting(ts, "Key", 0, nil)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("registering a key twice did not panic")
+		}
+	}()
+
+	MustRegisterSetting(ts, "Key", "", nil)
+}
+
+func TestTypedSettingsMigrate(t *testing.T) {
+	ms := mapSettings{"WindowWidth": "640", "Font": "Arial", "FontName": "Segoe UI"}
+	ts := NewTypedSettings(ms)
+
+	migrations := []SettingsMigration{
+		{
+			Version: 2,
+			Migrate: func(settings Settings) error {
+				width, _ := settings.Get("Window.Width")
+				return settings.Put("Window.Width", width+"0")
+			},
+		},
+		{
+			Version: 1,
+			Renames: map[string]string{"WindowWidth": "Window.Width", "Font": "FontName"},
+		},
+	}
+
+	if err := ts.Migrate("Version", migrations...); err != nil {
+		t.Fatal(err)
+	}
+
+	want := mapSettings{"Window.Width": "6400", "FontName": "Segoe UI", "Version": "2"}
+	if !reflect.DeepEqual(ms, want) {
+		t.Errorf("migrated to %v, want %v", ms, want)
+	}
+
+	// Migrations run once.
+	if err := ts.Migrate("Version", migrations...); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ms, want) {
+		t.Errorf("migrated again to %v, want %v", ms, want)
+	}
+}
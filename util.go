@@ -9,7 +9,6 @@ package walk
 
 import (
 	"bytes"
-	"math"
 	"math/big"
 	"strconv"
 	"strings"
@@ -18,7 +17,6 @@ import (
 	"unsafe"
 
 	"github.com/wuc656/win"
-	"golang.org/x/exp/constraints"
 )
 
 var (
@@ -46,22 +44,6 @@ func init() {
 	})
 }
 
-func maxi(a, b int) int {
-	if a > b {
-		return a
-	}
-
-	return b
-}
-
-func mini(a, b int) int {
-	if a < b {
-		return a
-	}
-
-	return b
-}
-
 func boolToInt(value bool) int {
 	if value {
 		return 1
@@ -318,6 +300,20 @@ func applyDPIToDescendants(window Window, dpi int) {
 	})
 }
 
+func applyRightToLeftToDescendants(window Window) error {
+	var err error
+
+	walkDescendants(window, func(w Window) bool {
+		if arl, ok := w.(applyRightToLefter); ok {
+			err = arl.applyRightToLeft(windowRightToLeft(w))
+		}
+
+		return err == nil
+	})
+
+	return err
+}
+
 func walkDescendants(window Window, f func(w Window) bool) bool {
 	window = window.AsWindowBase().window
 
@@ -512,31 +508,37 @@ func less(a, b any, order SortOrder) bool {
 	return false
 }
 
-func dpiForHDC(hdc win.HDC) int {
-	if hwnd := win.WindowFromDC(hdc); hwnd != 0 {
-		return int(win.GetDpiForWindow(hwnd))
+// compareValues compares a and b the way less does in ascending order.
+func compareValues(a, b any) int {
+	switch {
+	case less(a, b, SortAscending):
+		return -1
+
+	case less(b, a, SortAscending):
+		return 1
 	}
 
-	return int(win.GetDeviceCaps(hdc, win.LOGPIXELSX))
+	return 0
 }
 
-// IntFrom96DPI converts from 1/96" units to native pixels.
-func IntFrom96DPI[I constraints.Integer](value I, dpi int) I {
-	return scaleInt(value, float64(dpi)/96.0)
-}
+// compareValuesWith compares a and b, values of column col, with the compare
+// func for col, if there is one, or else with compareValues.
+func compareValuesWith(compareFuncs []func(a, b any) int, col int, a, b any) int {
+	if col < len(compareFuncs) {
+		if compare := compareFuncs[col]; compare != nil {
+			return compare(a, b)
+		}
+	}
 
-// IntTo96DPI converts from native pixels to 1/96" units.
-func IntTo96DPI[I constraints.Integer](value I, dpi int) I {
-	return scaleInt(value, 96.0/float64(dpi))
+	return compareValues(a, b)
 }
 
-func scaleInt[I constraints.Integer](value I, scale float64) I {
-	return I(math.Round(float64(value) * scale))
-}
+func dpiForHDC(hdc win.HDC) int {
+	if hwnd := win.WindowFromDC(hdc); hwnd != 0 {
+		return int(win.GetDpiForWindow(hwnd))
+	}
 
-// MarginsFrom96DPI converts from 1/96" units to native pixels.
-func MarginsFrom96DPI(value Margins, dpi int) Margins {
-	return scaleMargins(value, float64(dpi)/96.0)
+	return int(win.GetDeviceCaps(hdc, win.LOGPIXELSX))
 }
 
 // MARGINSFrom96DPI converts from 1/96" units to native pixels.
@@ -544,20 +546,6 @@ func MARGINSFrom96DPI(value win.MARGINS, dpi int) win.MARGINS {
 	return scaleMARGINS(value, float64(dpi)/96.0)
 }
 
-// MarginsTo96DPI converts from native pixels to 1/96" units.
-func MarginsTo96DPI(value Margins, dpi int) Margins {
-	return scaleMargins(value, 96.0/float64(dpi))
-}
-
-func scaleMargins(value Margins, scale float64) Margins {
-	return Margins{
-		HNear: scaleInt(value.HNear, scale),
-		VNear: scaleInt(value.VNear, scale),
-		HFar:  scaleInt(value.HFar, scale),
-		VFar:  scaleInt(value.VFar, scale),
-	}
-}
-
 func scaleMARGINS(value win.MARGINS, scale float64) win.MARGINS {
 	return win.MARGINS{
 		LeftWidth:    scaleInt(value.LeftWidth, scale),
@@ -567,64 +555,11 @@ func scaleMARGINS(value win.MARGINS, scale float64) win.MARGINS {
 	}
 }
 
-// PointFrom96DPI converts from 1/96" units to native pixels.
-func PointFrom96DPI(value Point, dpi int) Point {
-	return scalePoint(value, float64(dpi)/96.0)
-}
-
-// PointTo96DPI converts from native pixels to 1/96" units.
-func PointTo96DPI(value Point, dpi int) Point {
-	return scalePoint(value, 96.0/float64(dpi))
-}
-
-func scalePoint(value Point, scale float64) Point {
-	return Point{
-		X: scaleInt(value.X, scale),
-		Y: scaleInt(value.Y, scale),
-	}
-}
-
-// RectangleFrom96DPI converts from 1/96" units to native pixels.
-func RectangleFrom96DPI(value Rectangle, dpi int) Rectangle {
-	return scaleRectangle(value, float64(dpi)/96.0)
-}
-
-// RectangleTo96DPI converts from native pixels to 1/96" units.
-func RectangleTo96DPI(value Rectangle, dpi int) Rectangle {
-	return scaleRectangle(value, 96.0/float64(dpi))
-}
-
-func scaleRectangle(value Rectangle, scale float64) Rectangle {
-	return Rectangle{
-		X:      scaleInt(value.X, scale),
-		Y:      scaleInt(value.Y, scale),
-		Width:  scaleInt(value.Width, scale),
-		Height: scaleInt(value.Height, scale),
-	}
-}
-
-// SizeFrom96DPI converts from 1/96" units to native pixels.
-func SizeFrom96DPI(value Size, dpi int) Size {
-	return scaleSize(value, float64(dpi)/96.0)
-}
-
 // SIZEFrom96DPI converts from 1/96" units to native pixels.
 func SIZEFrom96DPI(value win.SIZE, dpi int) win.SIZE {
 	return scaleSIZE(value, float64(dpi)/96.0)
 }
 
-// SizeTo96DPI converts from native pixels to 1/96" units.
-func SizeTo96DPI(value Size, dpi int) Size {
-	return scaleSize(value, 96.0/float64(dpi))
-}
-
-func scaleSize(value Size, scale float64) Size {
-	return Size{
-		Width:  scaleInt(value.Width, scale),
-		Height: scaleInt(value.Height, scale),
-	}
-}
-
 func scaleSIZE(value win.SIZE, scale float64) win.SIZE {
 	return win.SIZE{
 		CX: scaleInt(value.CX, scale),
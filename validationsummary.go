@@ -0,0 +1,212 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wuc656/win"
+)
+
+// ValidationSummary is an ErrorPresenter that lists all errors of a DataBinder,
+// each with a link that focuses the widget with the error. This helps with long
+// forms, where widgets may be scrolled out of view. Errors that do not belong to
+// a widget are listed first, without a link.
+type ValidationSummary struct {
+	LinkLabel
+	dataBinder             *DataBinder
+	errorPresenter         ErrorPresenter
+	canSubmitChangedHandle int
+	formErrs               []error
+	widget2Error           map[Widget]error
+	widgets                []Widget // in the order of the links
+}
+
+func NewValidationSummary(parent Container) (*ValidationSummary, error) {
+	vs := &ValidationSummary{widget2Error: make(map[Widget]error)}
+
+	if err := InitWidget(
+		vs,
+		parent,
+		"SysLink",
+		win.WS_TABSTOP|win.WS_VISIBLE,
+		0); err != nil {
+		return nil, err
+	}
+
+	vs.LinkLabel.init()
+
+	vs.LinkActivated().Attach(func(link *LinkLabelLink) {
+		if i, err := strconv.Atoi(link.Id()); err == nil && i < len(vs.widgets) {
+			vs.focusWidget(vs.widgets[i])
+		}
+	})
+
+	return vs, nil
+}
+
+// DataBinder returns the DataBinder vs presents the errors of, if it has been
+// set with SetDataBinder.
+func (vs *ValidationSummary) DataBinder() *DataBinder {
+	return vs.dataBinder
+}
+
+// SetDataBinder makes vs the ErrorPresenter of dataBinder. Errors are passed on
+// to the ErrorPresenter dataBinder had before, so e.g. a ToolTipErrorPresenter
+// keeps working. While an AsyncValidator of dataBinder is running, vs says so.
+func (vs *ValidationSummary) SetDataBinder(dataBinder *DataBinder) {
+	if dataBinder == vs.dataBinder {
+		return
+	}
+
+	if vs.dataBinder != nil {
+		vs.dataBinder.CanSubmitChanged().Detach(vs.canSubmitChangedHandle)
+		vs.dataBinder.SetErrorPresenter(vs.errorPresenter)
+	}
+
+	vs.dataBinder = dataBinder
+	vs.errorPresenter = nil
+	vs.formErrs = nil
+	vs.widget2Error = make(map[Widget]error)
+
+	if dataBinder != nil {
+		vs.errorPresenter = dataBinder.ErrorPresenter()
+		dataBinder.SetErrorPresenter(vs)
+
+		vs.canSubmitChangedHandle = dataBinder.CanSubmitChanged().Attach(vs.update)
+	}
+
+	vs.update()
+}
+
+// Errors returns the errors presented, in the order they are listed.
+func (vs *ValidationSummary) Errors() []error {
+	errs := slices.Clone(vs.formErrs)
+	for _, widget := range vs.widgets {
+		errs = append(errs, vs.widget2Error[widget])
+	}
+
+	return errs
+}
+
+func (vs *ValidationSummary) PresentError(err error, widget Widget) {
+	if vs.errorPresenter != nil {
+		vs.errorPresenter.PresentError(err, widget)
+	}
+
+	if widget == nil {
+		vs.formErrs = nil
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			vs.formErrs = joined.Unwrap()
+		} else if err != nil {
+			vs.formErrs = []error{err}
+		}
+	} else if err == nil {
+		delete(vs.widget2Error, widget)
+	} else {
+		vs.widget2Error[widget] = err
+	}
+
+	vs.update()
+}
+
+func (vs *ValidationSummary) update() {
+	vs.widgets = vs.widgets[:0]
+	for widget := range vs.widget2Error {
+		if widget.IsDisposed() {
+			delete(vs.widget2Error, widget)
+			continue
+		}
+
+		vs.widgets = append(vs.widgets, widget)
+	}
+
+	// List errors in the order of the widgets in their form.
+	widget2Index := make(map[Widget]int)
+	if form := vs.Form(); form != nil {
+		walkDescendants(form.AsFormBase().clientComposite, func(w Window) bool {
+			if widget, ok := w.(Widget); ok {
+				widget2Index[widget] = len(widget2Index)
+			}
+
+			return true
+		})
+	}
+	sort.SliceStable(vs.widgets, func(i, j int) bool {
+		return widget2Index[vs.widgets[i]] < widget2Index[vs.widgets[j]]
+	})
+
+	lines := make([]string, 0, len(vs.formErrs)+len(vs.widgets)+1)
+
+	for _, err := range vs.formErrs {
+		title, message := validationErrorText(err)
+
+		lines = append(lines, fmt.Sprintf(`%s: %s`, escapeSysLinkText(title), escapeSysLinkText(message)))
+	}
+
+	for i, widget := range vs.widgets {
+		title, message := validationErrorText(vs.widget2Error[widget])
+
+		lines = append(lines, fmt.Sprintf(`<a id="%d">%s</a>: %s`, i, escapeSysLinkText(title), escapeSysLinkText(message)))
+	}
+
+	if vs.dataBinder != nil && vs.dataBinder.ValidationPending() {
+		lines = append(lines, tr("Validating…", "walk"))
+	}
+
+	vs.SetText(strings.Join(lines, "\r\n"))
+}
+
+// validationErrorText returns the title and message to list err with.
+func validationErrorText(err error) (title, message string) {
+	if ve, ok := err.(*ValidationError); ok {
+		return ve.Title(), ve.Message()
+	}
+
+	return tr("Invalid Input"), err.Error()
+}
+
+// escapeSysLinkText keeps text from being taken for SysLink markup. SysLink has
+// no character references like &lt;, so a word joiner is inserted after each <
+// instead, which is invisible but keeps <a and </a> from being recognized.
+func escapeSysLinkText(text string) string {
+	return strings.ReplaceAll(text, "<", "<\u2060")
+}
+
+// focusWidget focuses widget, after selecting the TabPage and scrolling the
+// ScrollView it may be in.
+func (vs *ValidationSummary) focusWidget(widget Widget) {
+	var ancestors []Container
+	for p := widget.Parent(); p != nil; {
+		ancestors = append(ancestors, p)
+
+		w, ok := p.(Widget)
+		if !ok {
+			break
+		}
+		p = w.Parent()
+	}
+
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		switch a := ancestors[i].(type) {
+		case *TabPage:
+			if tw := a.tabWidget; tw != nil {
+				tw.SetCurrentIndex(tw.Pages().Index(a))
+			}
+
+		case *ScrollView:
+			a.EnsureVisible(widget)
+		}
+	}
+
+	widget.SetFocus()
+}
@@ -8,6 +8,7 @@
 package walk
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -151,3 +152,69 @@ func (selectionRequiredValidator) Validate(v any) error {
 
 	return nil
 }
+
+// CrossFieldValidator validates values of a DataBinder that depend on each
+// other, like an end date that must not be before a start date, or a password
+// and its confirmation.
+type CrossFieldValidator interface {
+	// Paths returns the paths of the values to validate. An error is presented
+	// for the widget bound to the first of them that has a widget bound to it.
+	Paths() []string
+
+	// ValidateValues validates values, keyed by the paths returned by Paths.
+	// Values of bound properties include changes that have not been submitted
+	// yet, as reported by the properties. Other values are taken from the data
+	// source.
+	ValidateValues(values map[string]any) error
+}
+
+// AsyncValidator is like CrossFieldValidator, but validates in the background,
+// e.g. because it has to ask a server. A DataBinder calls ValidateAsync on
+// App().Go whenever the values have changed and cancels ctx if they change
+// again before it returns, or if the app exits. CanSubmit stays false while
+// validation is pending.
+//
+// ValidateAsync is not called as long as a widget an error would be presented
+// for already has an error.
+type AsyncValidator interface {
+	Paths() []string
+	ValidateAsync(ctx context.Context, values map[string]any) error
+}
+
+type crossFieldValidatorFunc struct {
+	paths    []string
+	validate func(values map[string]any) error
+}
+
+// NewCrossFieldValidator returns a CrossFieldValidator that calls validate with
+// the values at paths.
+func NewCrossFieldValidator(validate func(values map[string]any) error, paths ...string) CrossFieldValidator {
+	return &crossFieldValidatorFunc{paths: paths, validate: validate}
+}
+
+func (v *crossFieldValidatorFunc) Paths() []string {
+	return v.paths
+}
+
+func (v *crossFieldValidatorFunc) ValidateValues(values map[string]any) error {
+	return v.validate(values)
+}
+
+type asyncValidatorFunc struct {
+	paths    []string
+	validate func(ctx context.Context, values map[string]any) error
+}
+
+// NewAsyncValidator returns an AsyncValidator that calls validate with the
+// values at paths.
+func NewAsyncValidator(validate func(ctx context.Context, values map[string]any) error, paths ...string) AsyncValidator {
+	return &asyncValidatorFunc{paths: paths, validate: validate}
+}
+
+func (v *asyncValidatorFunc) Paths() []string {
+	return v.paths
+}
+
+func (v *asyncValidatorFunc) ValidateAsync(ctx context.Context, values map[string]any) error {
+	return v.validate(ctx, values)
+}
@@ -11,33 +11,6 @@ import (
 	"github.com/wuc656/win"
 )
 
-// LayoutFlags specify how a Widget wants to be treated when used with a Layout.
-//
-// These flags are interpreted in respect to Widget.SizeHint.
-type LayoutFlags byte
-
-const (
-	// ShrinkableHorz allows a Widget to be shrunk horizontally.
-	ShrinkableHorz LayoutFlags = 1 << iota
-
-	// ShrinkableVert allows a Widget to be shrunk vertically.
-	ShrinkableVert
-
-	// GrowableHorz allows a Widget to be enlarged horizontally.
-	GrowableHorz
-
-	// GrowableVert allows a Widget to be enlarged vertically.
-	GrowableVert
-
-	// GreedyHorz specifies that the widget prefers to take up as much space as
-	// possible, horizontally.
-	GreedyHorz
-
-	// GreedyVert specifies that the widget prefers to take up as much space as
-	// possible, vertically.
-	GreedyVert
-)
-
 type Widget interface {
 	Window
 
@@ -249,6 +222,39 @@ func (wb *WidgetBase) applyFont(font *Font) {
 	wb.RequestLayout()
 }
 
+// RightToLeft returns whether the WidgetBase lays out and displays its content
+// from right to left.
+//
+// Widgets inherit this from their parent, see RightToLeftSetter.
+func (wb *WidgetBase) RightToLeft() bool {
+	if wb.parent != nil {
+		return windowRightToLeft(wb.parent)
+	}
+
+	// Widgets hosted by other widgets, like the Composite of a GroupBox, have
+	// no parent Container.
+	if parent := windowFromHandle(win.GetAncestor(wb.hWnd, win.GA_PARENT)); parent != nil {
+		return windowRightToLeft(parent)
+	}
+
+	return false
+}
+
+func (wb *WidgetBase) applyRightToLeft(rtl bool) error {
+	exStyle := uint32(win.WS_EX_RTLREADING)
+	if wb.origWndProcPtr != 0 {
+		// Native controls mirror their content themselves. Our own windows
+		// are not mirrored, so they keep coordinates and bitmaps as they are.
+		exStyle |= win.WS_EX_LAYOUTRTL
+	}
+
+	if err := wb.ensureExtendedStyleBits(exStyle, rtl); err != nil {
+		return err
+	}
+
+	return wb.Invalidate()
+}
+
 // Alignment return the alignment ot the *WidgetBase.
 func (wb *WidgetBase) Alignment() Alignment2D {
 	return wb.alignment
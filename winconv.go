@@ -0,0 +1,68 @@
+// Copyright 2010 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import "github.com/wuc656/win"
+
+func (s Size) toSIZE() win.SIZE {
+	return win.SIZE{
+		CX: int32(s.Width),
+		CY: int32(s.Height),
+	}
+}
+
+func sizeFromSIZE(s win.SIZE) Size {
+	return Size{
+		Width:  int(s.CX),
+		Height: int(s.CY),
+	}
+}
+
+func sizeFromRECT(r win.RECT) Size {
+	return Size{
+		Width:  int(r.Right - r.Left),
+		Height: int(r.Bottom - r.Top),
+	}
+}
+
+// RectangleFromRECT converts r from a win.RECT to a Rectangle.
+func RectangleFromRECT(r win.RECT) Rectangle {
+	return rectangleFromRECT(r)
+}
+
+func rectangleFromRECT(r win.RECT) Rectangle {
+	return Rectangle{
+		X:      int(r.Left),
+		Y:      int(r.Top),
+		Width:  int(r.Right - r.Left),
+		Height: int(r.Bottom - r.Top),
+	}
+}
+
+func (r Rectangle) toRECT() win.RECT {
+	return win.RECT{
+		Left:   int32(r.X),
+		Top:    int32(r.Y),
+		Right:  int32(r.X + r.Width),
+		Bottom: int32(r.Y + r.Height),
+	}
+}
+
+func (p Point) toPOINT() win.POINT {
+	return win.POINT{
+		X: int32(p.X),
+		Y: int32(p.Y),
+	}
+}
+
+func pointPixelsFromPOINT(p win.POINT) Point {
+	return Point{
+		X: int(p.X),
+		Y: int(p.Y),
+	}
+}
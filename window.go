@@ -432,6 +432,7 @@ type WindowBase struct {
 	disposables                 []Disposable
 	disposingPublisher          EventPublisher
 	dropFilesPublisher          DropFilesEventPublisher
+	dropTarget                  *dropTarget
 	keyDownPublisher            KeyEventPublisher
 	keyPressPublisher           KeyEventPublisher
 	keyUpPublisher              KeyEventPublisher
@@ -962,6 +963,12 @@ func (wb *WindowBase) disposeInternal(hwnd win.HWND) {
 
 	wb.disposingPublisher.Publish()
 
+	if wb.dropTarget != nil {
+		wb.dropTarget.revoke()
+		wb.dropTarget.ref.release(unsafe.Pointer(wb.dropTarget))
+		wb.dropTarget = nil
+	}
+
 	// Non-walk controls handle their own a11y.
 	if wb.origWndProcPtr == 0 {
 		accClearHwndProps(hwnd)
@@ -1248,6 +1255,28 @@ type ApplyFonter interface {
 	ApplyFont(font *Font)
 }
 
+type applyRightToLefter interface {
+	applyRightToLeft(rtl bool) error
+}
+
+// RightToLefter may be implemented by a Window that can lay out and display
+// its content from right to left, e.g. for Arabic or Hebrew. All Windows of
+// this package implement it.
+type RightToLefter interface {
+	RightToLeft() bool
+}
+
+// windowRightToLeft returns whether window lays out and displays its content
+// from right to left. It returns false if window does not implement
+// RightToLefter.
+func windowRightToLeft(window Window) bool {
+	if rtler, ok := window.(RightToLefter); ok {
+		return rtler.RightToLeft()
+	}
+
+	return false
+}
+
 func (wb *WindowBase) applyFont(font *Font) {
 	if hFont := font.handleForDPI(wb.DPI()); hFont != wb.hFont {
 		wb.hFont = hFont
@@ -1647,6 +1676,61 @@ func (wb *WindowBase) dialogBaseUnits() Size {
 	return s
 }
 
+var fontInfoAndDPI2TextMetrics = make(map[fontInfoAndDPI]win.TEXTMETRIC)
+
+// textMetrics returns the metrics of the font of the window at its DPI.
+func (wb *WindowBase) textMetrics() (win.TEXTMETRIC, error) {
+	font := wb.window.Font()
+	fi := fontInfoAndDPI{
+		fontInfo: fontInfo{
+			family:    font.Family(),
+			pointSize: font.PointSize(),
+			style:     font.Style(),
+		},
+		dpi: wb.DPI()}
+	if tm, ok := fontInfoAndDPI2TextMetrics[fi]; ok {
+		return tm, nil
+	}
+
+	hdc := win.GetDC(wb.hWnd)
+	defer win.ReleaseDC(wb.hWnd, hdc)
+
+	hFont := font.handleForDPI(wb.DPI())
+	hFontOld := win.SelectObject(hdc, win.HGDIOBJ(hFont))
+	defer win.SelectObject(hdc, win.HGDIOBJ(hFontOld))
+
+	var tm win.TEXTMETRIC
+	if !win.GetTextMetrics(hdc, &tm) {
+		return win.TEXTMETRIC{}, newError("GetTextMetrics failed")
+	}
+
+	fontInfoAndDPI2TextMetrics[fi] = tm
+
+	return tm, nil
+}
+
+// newTextBaseline returns a textBaseline for text of height textHeight in the
+// font of the window, that is positioned vertically in its layout item as
+// described by alignment. If textHeight is 0, the height of a single line is
+// used. textHeight is in native pixels. If the font metrics are not available,
+// the textBaseline reports no baseline.
+func (wb *WindowBase) newTextBaseline(textHeight int, alignment Alignment1D) textBaseline {
+	tm, err := wb.textMetrics()
+	if err != nil {
+		return textBaseline{}
+	}
+
+	if textHeight == 0 {
+		textHeight = int(tm.TmHeight)
+	}
+
+	return textBaseline{
+		ascent:     int(tm.TmAscent),
+		textHeight: textHeight,
+		alignment:  alignment,
+	}
+}
+
 // dialogBaseUnitsToPixels returns size in dialog based units in native pixels.
 func (wb *WindowBase) dialogBaseUnitsToPixels(dlus Size) (pixels Size) {
 	base := wb.dialogBaseUnits()
@@ -2034,6 +2118,12 @@ func (wb *WindowBase) requestLayout(immediate bool, completionFunc func()) {
 	}
 }
 
+// RightToLeft returns whether the Window lays out and displays its content
+// from right to left.
+func (wb *WindowBase) RightToLeft() bool {
+	return wb.hasExtendedStyleBits(win.WS_EX_LAYOUTRTL)
+}
+
 // RightToLeftReading returns whether the reading order of the Window
 // is from right to left.
 func (wb *WindowBase) RightToLeftReading() bool {
@@ -2615,7 +2705,7 @@ func (wb *WindowBase) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr)
 
 			win.TrackPopupMenuEx(
 				contextMenu.hMenu,
-				win.TPM_NOANIMATION,
+				contextMenu.popupFlags(sourceWindow, handle),
 				x,
 				y,
 				handle,